@@ -0,0 +1,5085 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.12.4
+// source: github.com/kubernetes-csi/csi-proxy/client/api/disk/v2alpha1/api.proto
+
+package v2alpha1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ZeroMode selects the parts of a disk ClearDisk writes zeros to.
+type ZeroMode int32
+
+const (
+	// Nothing is zeroed, the data of the removed partitions stays on the disk.
+	ZeroMode_ZERO_MODE_NONE ZeroMode = 0
+	// The beginning and the end of the disk are zeroed, removing the partition tables
+	// and the file system signatures at the usual partition offsets.
+	ZeroMode_ZERO_MODE_EDGES ZeroMode = 1
+	// The entire disk is zeroed.
+	ZeroMode_ZERO_MODE_ENTIRE ZeroMode = 2
+)
+
+// Enum value maps for ZeroMode.
+var (
+	ZeroMode_name = map[int32]string{
+		0: "ZERO_MODE_NONE",
+		1: "ZERO_MODE_EDGES",
+		2: "ZERO_MODE_ENTIRE",
+	}
+	ZeroMode_value = map[string]int32{
+		"ZERO_MODE_NONE":   0,
+		"ZERO_MODE_EDGES":  1,
+		"ZERO_MODE_ENTIRE": 2,
+	}
+)
+
+func (x ZeroMode) Enum() *ZeroMode {
+	p := new(ZeroMode)
+	*p = x
+	return p
+}
+
+func (x ZeroMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ZeroMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_enumTypes[0].Descriptor()
+}
+
+func (ZeroMode) Type() protoreflect.EnumType {
+	return &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_enumTypes[0]
+}
+
+func (x ZeroMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ZeroMode.Descriptor instead.
+func (ZeroMode) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{0}
+}
+
+// ClearDiskStage is the stage ClearDisk is at.
+type ClearDiskStage int32
+
+const (
+	// The partitions are being removed.
+	ClearDiskStage_CLEAR_DISK_STAGE_CLEARING ClearDiskStage = 0
+	// The disk is being zeroed.
+	ClearDiskStage_CLEAR_DISK_STAGE_ZEROING ClearDiskStage = 1
+	// The disk is cleared.
+	ClearDiskStage_CLEAR_DISK_STAGE_DONE ClearDiskStage = 2
+)
+
+// Enum value maps for ClearDiskStage.
+var (
+	ClearDiskStage_name = map[int32]string{
+		0: "CLEAR_DISK_STAGE_CLEARING",
+		1: "CLEAR_DISK_STAGE_ZEROING",
+		2: "CLEAR_DISK_STAGE_DONE",
+	}
+	ClearDiskStage_value = map[string]int32{
+		"CLEAR_DISK_STAGE_CLEARING": 0,
+		"CLEAR_DISK_STAGE_ZEROING":  1,
+		"CLEAR_DISK_STAGE_DONE":     2,
+	}
+)
+
+func (x ClearDiskStage) Enum() *ClearDiskStage {
+	p := new(ClearDiskStage)
+	*p = x
+	return p
+}
+
+func (x ClearDiskStage) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ClearDiskStage) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_enumTypes[1].Descriptor()
+}
+
+func (ClearDiskStage) Type() protoreflect.EnumType {
+	return &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_enumTypes[1]
+}
+
+func (x ClearDiskStage) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ClearDiskStage.Descriptor instead.
+func (ClearDiskStage) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{1}
+}
+
+// IdentifierType is the type of a device identification descriptor.
+type IdentifierType int32
+
+const (
+	IdentifierType_IDENTIFIER_TYPE_VENDOR_SPECIFIC      IdentifierType = 0
+	IdentifierType_IDENTIFIER_TYPE_T10_VENDOR_ID        IdentifierType = 1
+	IdentifierType_IDENTIFIER_TYPE_EUI64                IdentifierType = 2
+	IdentifierType_IDENTIFIER_TYPE_NAA                  IdentifierType = 3
+	IdentifierType_IDENTIFIER_TYPE_RELATIVE_TARGET_PORT IdentifierType = 4
+	IdentifierType_IDENTIFIER_TYPE_TARGET_PORT_GROUP    IdentifierType = 5
+	IdentifierType_IDENTIFIER_TYPE_LOGICAL_UNIT_GROUP   IdentifierType = 6
+	IdentifierType_IDENTIFIER_TYPE_MD5_LOGICAL_UNIT     IdentifierType = 7
+	IdentifierType_IDENTIFIER_TYPE_SCSI_NAME_STRING     IdentifierType = 8
+)
+
+// Enum value maps for IdentifierType.
+var (
+	IdentifierType_name = map[int32]string{
+		0: "IDENTIFIER_TYPE_VENDOR_SPECIFIC",
+		1: "IDENTIFIER_TYPE_T10_VENDOR_ID",
+		2: "IDENTIFIER_TYPE_EUI64",
+		3: "IDENTIFIER_TYPE_NAA",
+		4: "IDENTIFIER_TYPE_RELATIVE_TARGET_PORT",
+		5: "IDENTIFIER_TYPE_TARGET_PORT_GROUP",
+		6: "IDENTIFIER_TYPE_LOGICAL_UNIT_GROUP",
+		7: "IDENTIFIER_TYPE_MD5_LOGICAL_UNIT",
+		8: "IDENTIFIER_TYPE_SCSI_NAME_STRING",
+	}
+	IdentifierType_value = map[string]int32{
+		"IDENTIFIER_TYPE_VENDOR_SPECIFIC":      0,
+		"IDENTIFIER_TYPE_T10_VENDOR_ID":        1,
+		"IDENTIFIER_TYPE_EUI64":                2,
+		"IDENTIFIER_TYPE_NAA":                  3,
+		"IDENTIFIER_TYPE_RELATIVE_TARGET_PORT": 4,
+		"IDENTIFIER_TYPE_TARGET_PORT_GROUP":    5,
+		"IDENTIFIER_TYPE_LOGICAL_UNIT_GROUP":   6,
+		"IDENTIFIER_TYPE_MD5_LOGICAL_UNIT":     7,
+		"IDENTIFIER_TYPE_SCSI_NAME_STRING":     8,
+	}
+)
+
+func (x IdentifierType) Enum() *IdentifierType {
+	p := new(IdentifierType)
+	*p = x
+	return p
+}
+
+func (x IdentifierType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IdentifierType) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_enumTypes[2].Descriptor()
+}
+
+func (IdentifierType) Type() protoreflect.EnumType {
+	return &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_enumTypes[2]
+}
+
+func (x IdentifierType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IdentifierType.Descriptor instead.
+func (IdentifierType) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{2}
+}
+
+// IdentifierAssociation is the entity a device identification descriptor identifies.
+type IdentifierAssociation int32
+
+const (
+	// The logical unit, i.e. the disk itself.
+	IdentifierAssociation_IDENTIFIER_ASSOCIATION_DEVICE IdentifierAssociation = 0
+	// The port the disk is accessed through.
+	IdentifierAssociation_IDENTIFIER_ASSOCIATION_PORT IdentifierAssociation = 1
+	// The target device holding the disk.
+	IdentifierAssociation_IDENTIFIER_ASSOCIATION_TARGET IdentifierAssociation = 2
+)
+
+// Enum value maps for IdentifierAssociation.
+var (
+	IdentifierAssociation_name = map[int32]string{
+		0: "IDENTIFIER_ASSOCIATION_DEVICE",
+		1: "IDENTIFIER_ASSOCIATION_PORT",
+		2: "IDENTIFIER_ASSOCIATION_TARGET",
+	}
+	IdentifierAssociation_value = map[string]int32{
+		"IDENTIFIER_ASSOCIATION_DEVICE": 0,
+		"IDENTIFIER_ASSOCIATION_PORT":   1,
+		"IDENTIFIER_ASSOCIATION_TARGET": 2,
+	}
+)
+
+func (x IdentifierAssociation) Enum() *IdentifierAssociation {
+	p := new(IdentifierAssociation)
+	*p = x
+	return p
+}
+
+func (x IdentifierAssociation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IdentifierAssociation) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_enumTypes[3].Descriptor()
+}
+
+func (IdentifierAssociation) Type() protoreflect.EnumType {
+	return &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_enumTypes[3]
+}
+
+func (x IdentifierAssociation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IdentifierAssociation.Descriptor instead.
+func (IdentifierAssociation) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{3}
+}
+
+// IdentifierKind selects the identifiers FindDisk matches. The device identification
+// descriptors are only matched if they are associated with the disk itself.
+type IdentifierKind int32
+
+const (
+	// Any of the identifiers below.
+	IdentifierKind_IDENTIFIER_KIND_ANY IdentifierKind = 0
+	// The page83 id.
+	IdentifierKind_IDENTIFIER_KIND_PAGE83 IdentifierKind = 1
+	// The disk serial number or the device serial number.
+	IdentifierKind_IDENTIFIER_KIND_SERIAL_NUMBER IdentifierKind = 2
+	// A device identification descriptor of type vendor specific.
+	IdentifierKind_IDENTIFIER_KIND_VENDOR_SPECIFIC IdentifierKind = 3
+	// A device identification descriptor of type T10 vendor ID.
+	IdentifierKind_IDENTIFIER_KIND_T10_VENDOR_ID IdentifierKind = 4
+	// A device identification descriptor of type EUI-64.
+	IdentifierKind_IDENTIFIER_KIND_EUI64 IdentifierKind = 5
+	// A device identification descriptor of type NAA.
+	IdentifierKind_IDENTIFIER_KIND_NAA IdentifierKind = 6
+	// A device identification descriptor of type SCSI name string.
+	IdentifierKind_IDENTIFIER_KIND_SCSI_NAME_STRING IdentifierKind = 7
+)
+
+// Enum value maps for IdentifierKind.
+var (
+	IdentifierKind_name = map[int32]string{
+		0: "IDENTIFIER_KIND_ANY",
+		1: "IDENTIFIER_KIND_PAGE83",
+		2: "IDENTIFIER_KIND_SERIAL_NUMBER",
+		3: "IDENTIFIER_KIND_VENDOR_SPECIFIC",
+		4: "IDENTIFIER_KIND_T10_VENDOR_ID",
+		5: "IDENTIFIER_KIND_EUI64",
+		6: "IDENTIFIER_KIND_NAA",
+		7: "IDENTIFIER_KIND_SCSI_NAME_STRING",
+	}
+	IdentifierKind_value = map[string]int32{
+		"IDENTIFIER_KIND_ANY":              0,
+		"IDENTIFIER_KIND_PAGE83":           1,
+		"IDENTIFIER_KIND_SERIAL_NUMBER":    2,
+		"IDENTIFIER_KIND_VENDOR_SPECIFIC":  3,
+		"IDENTIFIER_KIND_T10_VENDOR_ID":    4,
+		"IDENTIFIER_KIND_EUI64":            5,
+		"IDENTIFIER_KIND_NAA":              6,
+		"IDENTIFIER_KIND_SCSI_NAME_STRING": 7,
+	}
+)
+
+func (x IdentifierKind) Enum() *IdentifierKind {
+	p := new(IdentifierKind)
+	*p = x
+	return p
+}
+
+func (x IdentifierKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IdentifierKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_enumTypes[4].Descriptor()
+}
+
+func (IdentifierKind) Type() protoreflect.EnumType {
+	return &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_enumTypes[4]
+}
+
+func (x IdentifierKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IdentifierKind.Descriptor instead.
+func (IdentifierKind) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{4}
+}
+
+// SanPolicy is the policy the host applies to the disks it discovers.
+type SanPolicy int32
+
+const (
+	// The policy is not reported, or is left unchanged.
+	SanPolicy_SAN_POLICY_UNKNOWN SanPolicy = 0
+	// All the disks are brought online.
+	SanPolicy_SAN_POLICY_ONLINE_ALL SanPolicy = 1
+	// The disks on a shared bus, e.g. iSCSI or Fibre Channel, are left offline.
+	SanPolicy_SAN_POLICY_OFFLINE_SHARED SanPolicy = 2
+	// All the disks are left offline.
+	SanPolicy_SAN_POLICY_OFFLINE_ALL SanPolicy = 3
+	// The internal disks are left offline.
+	SanPolicy_SAN_POLICY_OFFLINE_INTERNAL SanPolicy = 4
+)
+
+// Enum value maps for SanPolicy.
+var (
+	SanPolicy_name = map[int32]string{
+		0: "SAN_POLICY_UNKNOWN",
+		1: "SAN_POLICY_ONLINE_ALL",
+		2: "SAN_POLICY_OFFLINE_SHARED",
+		3: "SAN_POLICY_OFFLINE_ALL",
+		4: "SAN_POLICY_OFFLINE_INTERNAL",
+	}
+	SanPolicy_value = map[string]int32{
+		"SAN_POLICY_UNKNOWN":          0,
+		"SAN_POLICY_ONLINE_ALL":       1,
+		"SAN_POLICY_OFFLINE_SHARED":   2,
+		"SAN_POLICY_OFFLINE_ALL":      3,
+		"SAN_POLICY_OFFLINE_INTERNAL": 4,
+	}
+)
+
+func (x SanPolicy) Enum() *SanPolicy {
+	p := new(SanPolicy)
+	*p = x
+	return p
+}
+
+func (x SanPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SanPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_enumTypes[5].Descriptor()
+}
+
+func (SanPolicy) Type() protoreflect.EnumType {
+	return &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_enumTypes[5]
+}
+
+func (x SanPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SanPolicy.Descriptor instead.
+func (SanPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{5}
+}
+
+// BusType is the type of bus the disk is connected to.
+type BusType int32
+
+const (
+	BusType_BUS_TYPE_UNKNOWN             BusType = 0
+	BusType_BUS_TYPE_SCSI                BusType = 1
+	BusType_BUS_TYPE_ATAPI               BusType = 2
+	BusType_BUS_TYPE_ATA                 BusType = 3
+	BusType_BUS_TYPE_IEEE1394            BusType = 4
+	BusType_BUS_TYPE_SSA                 BusType = 5
+	BusType_BUS_TYPE_FIBRE_CHANNEL       BusType = 6
+	BusType_BUS_TYPE_USB                 BusType = 7
+	BusType_BUS_TYPE_RAID                BusType = 8
+	BusType_BUS_TYPE_ISCSI               BusType = 9
+	BusType_BUS_TYPE_SAS                 BusType = 10
+	BusType_BUS_TYPE_SATA                BusType = 11
+	BusType_BUS_TYPE_SD                  BusType = 12
+	BusType_BUS_TYPE_MMC                 BusType = 13
+	BusType_BUS_TYPE_VIRTUAL             BusType = 14
+	BusType_BUS_TYPE_FILE_BACKED_VIRTUAL BusType = 15
+	BusType_BUS_TYPE_STORAGE_SPACES      BusType = 16
+	BusType_BUS_TYPE_NVME                BusType = 17
+	BusType_BUS_TYPE_SCM                 BusType = 18
+	BusType_BUS_TYPE_UFS                 BusType = 19
+)
+
+// Enum value maps for BusType.
+var (
+	BusType_name = map[int32]string{
+		0:  "BUS_TYPE_UNKNOWN",
+		1:  "BUS_TYPE_SCSI",
+		2:  "BUS_TYPE_ATAPI",
+		3:  "BUS_TYPE_ATA",
+		4:  "BUS_TYPE_IEEE1394",
+		5:  "BUS_TYPE_SSA",
+		6:  "BUS_TYPE_FIBRE_CHANNEL",
+		7:  "BUS_TYPE_USB",
+		8:  "BUS_TYPE_RAID",
+		9:  "BUS_TYPE_ISCSI",
+		10: "BUS_TYPE_SAS",
+		11: "BUS_TYPE_SATA",
+		12: "BUS_TYPE_SD",
+		13: "BUS_TYPE_MMC",
+		14: "BUS_TYPE_VIRTUAL",
+		15: "BUS_TYPE_FILE_BACKED_VIRTUAL",
+		16: "BUS_TYPE_STORAGE_SPACES",
+		17: "BUS_TYPE_NVME",
+		18: "BUS_TYPE_SCM",
+		19: "BUS_TYPE_UFS",
+	}
+	BusType_value = map[string]int32{
+		"BUS_TYPE_UNKNOWN":             0,
+		"BUS_TYPE_SCSI":                1,
+		"BUS_TYPE_ATAPI":               2,
+		"BUS_TYPE_ATA":                 3,
+		"BUS_TYPE_IEEE1394":            4,
+		"BUS_TYPE_SSA":                 5,
+		"BUS_TYPE_FIBRE_CHANNEL":       6,
+		"BUS_TYPE_USB":                 7,
+		"BUS_TYPE_RAID":                8,
+		"BUS_TYPE_ISCSI":               9,
+		"BUS_TYPE_SAS":                 10,
+		"BUS_TYPE_SATA":                11,
+		"BUS_TYPE_SD":                  12,
+		"BUS_TYPE_MMC":                 13,
+		"BUS_TYPE_VIRTUAL":             14,
+		"BUS_TYPE_FILE_BACKED_VIRTUAL": 15,
+		"BUS_TYPE_STORAGE_SPACES":      16,
+		"BUS_TYPE_NVME":                17,
+		"BUS_TYPE_SCM":                 18,
+		"BUS_TYPE_UFS":                 19,
+	}
+)
+
+func (x BusType) Enum() *BusType {
+	p := new(BusType)
+	*p = x
+	return p
+}
+
+func (x BusType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BusType) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_enumTypes[6].Descriptor()
+}
+
+func (BusType) Type() protoreflect.EnumType {
+	return &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_enumTypes[6]
+}
+
+func (x BusType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BusType.Descriptor instead.
+func (BusType) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{6}
+}
+
+// PartitionStyle is the partition table format of the disk.
+type PartitionStyle int32
+
+const (
+	// The disk is not initialized (RAW).
+	PartitionStyle_PARTITION_STYLE_UNKNOWN PartitionStyle = 0
+	// Master Boot Record.
+	PartitionStyle_PARTITION_STYLE_MBR PartitionStyle = 1
+	// GUID Partition Table.
+	PartitionStyle_PARTITION_STYLE_GPT PartitionStyle = 2
+)
+
+// Enum value maps for PartitionStyle.
+var (
+	PartitionStyle_name = map[int32]string{
+		0: "PARTITION_STYLE_UNKNOWN",
+		1: "PARTITION_STYLE_MBR",
+		2: "PARTITION_STYLE_GPT",
+	}
+	PartitionStyle_value = map[string]int32{
+		"PARTITION_STYLE_UNKNOWN": 0,
+		"PARTITION_STYLE_MBR":     1,
+		"PARTITION_STYLE_GPT":     2,
+	}
+)
+
+func (x PartitionStyle) Enum() *PartitionStyle {
+	p := new(PartitionStyle)
+	*p = x
+	return p
+}
+
+func (x PartitionStyle) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PartitionStyle) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_enumTypes[7].Descriptor()
+}
+
+func (PartitionStyle) Type() protoreflect.EnumType {
+	return &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_enumTypes[7]
+}
+
+func (x PartitionStyle) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PartitionStyle.Descriptor instead.
+func (PartitionStyle) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{7}
+}
+
+// OfflineReason is the reason the disk is offline.
+type OfflineReason int32
+
+const (
+	// The disk is online, or the reason is not reported.
+	OfflineReason_OFFLINE_REASON_NONE OfflineReason = 0
+	// The disk is offline because of the SAN policy.
+	OfflineReason_OFFLINE_REASON_POLICY OfflineReason = 1
+	// The disk is offline because it is a redundant path to another disk.
+	OfflineReason_OFFLINE_REASON_REDUNDANT_PATH OfflineReason = 2
+	// The disk is offline because it is a snapshot.
+	OfflineReason_OFFLINE_REASON_SNAPSHOT OfflineReason = 3
+	// The disk is offline because its signature or identifier collides with another disk.
+	OfflineReason_OFFLINE_REASON_COLLISION OfflineReason = 4
+	// The disk is offline because the host ran out of resources.
+	OfflineReason_OFFLINE_REASON_RESOURCE_EXHAUSTION OfflineReason = 5
+	// The disk is offline because of critical write failures.
+	OfflineReason_OFFLINE_REASON_CRITICAL_WRITE_FAILURES OfflineReason = 6
+	// The disk is offline because a data integrity scan is required.
+	OfflineReason_OFFLINE_REASON_DATA_INTEGRITY_SCAN_REQUIRED OfflineReason = 7
+)
+
+// Enum value maps for OfflineReason.
+var (
+	OfflineReason_name = map[int32]string{
+		0: "OFFLINE_REASON_NONE",
+		1: "OFFLINE_REASON_POLICY",
+		2: "OFFLINE_REASON_REDUNDANT_PATH",
+		3: "OFFLINE_REASON_SNAPSHOT",
+		4: "OFFLINE_REASON_COLLISION",
+		5: "OFFLINE_REASON_RESOURCE_EXHAUSTION",
+		6: "OFFLINE_REASON_CRITICAL_WRITE_FAILURES",
+		7: "OFFLINE_REASON_DATA_INTEGRITY_SCAN_REQUIRED",
+	}
+	OfflineReason_value = map[string]int32{
+		"OFFLINE_REASON_NONE":                         0,
+		"OFFLINE_REASON_POLICY":                       1,
+		"OFFLINE_REASON_REDUNDANT_PATH":               2,
+		"OFFLINE_REASON_SNAPSHOT":                     3,
+		"OFFLINE_REASON_COLLISION":                    4,
+		"OFFLINE_REASON_RESOURCE_EXHAUSTION":          5,
+		"OFFLINE_REASON_CRITICAL_WRITE_FAILURES":      6,
+		"OFFLINE_REASON_DATA_INTEGRITY_SCAN_REQUIRED": 7,
+	}
+)
+
+func (x OfflineReason) Enum() *OfflineReason {
+	p := new(OfflineReason)
+	*p = x
+	return p
+}
+
+func (x OfflineReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OfflineReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_enumTypes[8].Descriptor()
+}
+
+func (OfflineReason) Type() protoreflect.EnumType {
+	return &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_enumTypes[8]
+}
+
+func (x OfflineReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OfflineReason.Descriptor instead.
+func (OfflineReason) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{8}
+}
+
+type DiskEventType int32
+
+const (
+	// The disk was enumerated by the host when the call was made.
+	DiskEventType_DISK_EVENT_TYPE_EXISTING DiskEventType = 0
+	// All the disks enumerated by the host when the call was made have been sent,
+	// the disk fields are not set.
+	DiskEventType_DISK_EVENT_TYPE_SYNCED DiskEventType = 1
+	// The disk was added.
+	DiskEventType_DISK_EVENT_TYPE_ADDED DiskEventType = 2
+	// The disk was removed, the disk holds its last known information.
+	DiskEventType_DISK_EVENT_TYPE_REMOVED DiskEventType = 3
+	// The disk was brought online.
+	DiskEventType_DISK_EVENT_TYPE_ONLINE DiskEventType = 4
+	// The disk was brought offline.
+	DiskEventType_DISK_EVENT_TYPE_OFFLINE DiskEventType = 5
+	// The size of the disk changed.
+	DiskEventType_DISK_EVENT_TYPE_RESIZED DiskEventType = 6
+)
+
+// Enum value maps for DiskEventType.
+var (
+	DiskEventType_name = map[int32]string{
+		0: "DISK_EVENT_TYPE_EXISTING",
+		1: "DISK_EVENT_TYPE_SYNCED",
+		2: "DISK_EVENT_TYPE_ADDED",
+		3: "DISK_EVENT_TYPE_REMOVED",
+		4: "DISK_EVENT_TYPE_ONLINE",
+		5: "DISK_EVENT_TYPE_OFFLINE",
+		6: "DISK_EVENT_TYPE_RESIZED",
+	}
+	DiskEventType_value = map[string]int32{
+		"DISK_EVENT_TYPE_EXISTING": 0,
+		"DISK_EVENT_TYPE_SYNCED":   1,
+		"DISK_EVENT_TYPE_ADDED":    2,
+		"DISK_EVENT_TYPE_REMOVED":  3,
+		"DISK_EVENT_TYPE_ONLINE":   4,
+		"DISK_EVENT_TYPE_OFFLINE":  5,
+		"DISK_EVENT_TYPE_RESIZED":  6,
+	}
+)
+
+func (x DiskEventType) Enum() *DiskEventType {
+	p := new(DiskEventType)
+	*p = x
+	return p
+}
+
+func (x DiskEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DiskEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_enumTypes[9].Descriptor()
+}
+
+func (DiskEventType) Type() protoreflect.EnumType {
+	return &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_enumTypes[9]
+}
+
+func (x DiskEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DiskEventType.Descriptor instead.
+func (DiskEventType) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{9}
+}
+
+type ListDiskLocationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListDiskLocationsRequest) Reset() {
+	*x = ListDiskLocationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDiskLocationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDiskLocationsRequest) ProtoMessage() {}
+
+func (x *ListDiskLocationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDiskLocationsRequest.ProtoReflect.Descriptor instead.
+func (*ListDiskLocationsRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{0}
+}
+
+type DiskLocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Adapter string `protobuf:"bytes,1,opt,name=Adapter,proto3" json:"Adapter,omitempty"`
+	Bus     string `protobuf:"bytes,2,opt,name=Bus,proto3" json:"Bus,omitempty"`
+	Target  string `protobuf:"bytes,3,opt,name=Target,proto3" json:"Target,omitempty"`
+	LUNID   string `protobuf:"bytes,4,opt,name=LUNID,proto3" json:"LUNID,omitempty"`
+}
+
+func (x *DiskLocation) Reset() {
+	*x = DiskLocation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiskLocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskLocation) ProtoMessage() {}
+
+func (x *DiskLocation) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskLocation.ProtoReflect.Descriptor instead.
+func (*DiskLocation) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DiskLocation) GetAdapter() string {
+	if x != nil {
+		return x.Adapter
+	}
+	return ""
+}
+
+func (x *DiskLocation) GetBus() string {
+	if x != nil {
+		return x.Bus
+	}
+	return ""
+}
+
+func (x *DiskLocation) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *DiskLocation) GetLUNID() string {
+	if x != nil {
+		return x.LUNID
+	}
+	return ""
+}
+
+type ListDiskLocationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Map of disk number and <adapter, bus, target, lun ID> associated with each disk device.
+	DiskLocations map[uint32]*DiskLocation `protobuf:"bytes,1,rep,name=disk_locations,json=diskLocations,proto3" json:"disk_locations,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ListDiskLocationsResponse) Reset() {
+	*x = ListDiskLocationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDiskLocationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDiskLocationsResponse) ProtoMessage() {}
+
+func (x *ListDiskLocationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDiskLocationsResponse.ProtoReflect.Descriptor instead.
+func (*ListDiskLocationsResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListDiskLocationsResponse) GetDiskLocations() map[uint32]*DiskLocation {
+	if x != nil {
+		return x.DiskLocations
+	}
+	return nil
+}
+
+type PartitionDiskRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disk device number of the disk to partition.
+	DiskNumber uint32 `protobuf:"varint,1,opt,name=disk_number,json=diskNumber,proto3" json:"disk_number,omitempty"`
+	// Partition style to initialize the disk with, GPT if unknown. The disk must
+	// already have this partition style if it is initialized.
+	PartitionStyle PartitionStyle `protobuf:"varint,2,opt,name=partition_style,json=partitionStyle,proto3,enum=v2alpha1.PartitionStyle" json:"partition_style,omitempty"`
+	// Size of the partition in bytes, 0 to use the largest free extent.
+	Size uint64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// Offset of the partition from the beginning of the disk in bytes, 0 to use
+	// the first free extent large enough.
+	Offset uint64 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Alignment of the partition in bytes, 0 for the default of the host.
+	Alignment uint32 `protobuf:"varint,5,opt,name=alignment,proto3" json:"alignment,omitempty"`
+	// GPT partition type GUID, e.g. "{ebd0a0a2-b9e5-4433-87c0-68b6b72699c7}",
+	// basic data if empty. Only for the GPT partition style.
+	GptType string `protobuf:"bytes,6,opt,name=gpt_type,json=gptType,proto3" json:"gpt_type,omitempty"`
+	// MBR partition type, e.g. 7 for the installable file systems, 7 if 0.
+	// Only for the MBR partition style.
+	MbrType uint32 `protobuf:"varint,7,opt,name=mbr_type,json=mbrType,proto3" json:"mbr_type,omitempty"`
+	// GPT partition name, at most 36 characters, unset if empty. Only for the
+	// GPT partition style.
+	Name string `protobuf:"bytes,8,opt,name=name,proto3" json:"name,omitempty"`
+	// Create a partition even if the disk already has partitions. If offset is set
+	// and a partition already starts at offset, that partition is returned instead
+	// so that the request can be retried.
+	Append bool `protobuf:"varint,9,opt,name=append,proto3" json:"append,omitempty"`
+}
+
+func (x *PartitionDiskRequest) Reset() {
+	*x = PartitionDiskRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PartitionDiskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartitionDiskRequest) ProtoMessage() {}
+
+func (x *PartitionDiskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartitionDiskRequest.ProtoReflect.Descriptor instead.
+func (*PartitionDiskRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PartitionDiskRequest) GetDiskNumber() uint32 {
+	if x != nil {
+		return x.DiskNumber
+	}
+	return 0
+}
+
+func (x *PartitionDiskRequest) GetPartitionStyle() PartitionStyle {
+	if x != nil {
+		return x.PartitionStyle
+	}
+	return PartitionStyle_PARTITION_STYLE_UNKNOWN
+}
+
+func (x *PartitionDiskRequest) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *PartitionDiskRequest) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *PartitionDiskRequest) GetAlignment() uint32 {
+	if x != nil {
+		return x.Alignment
+	}
+	return 0
+}
+
+func (x *PartitionDiskRequest) GetGptType() string {
+	if x != nil {
+		return x.GptType
+	}
+	return ""
+}
+
+func (x *PartitionDiskRequest) GetMbrType() uint32 {
+	if x != nil {
+		return x.MbrType
+	}
+	return 0
+}
+
+func (x *PartitionDiskRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PartitionDiskRequest) GetAppend() bool {
+	if x != nil {
+		return x.Append
+	}
+	return false
+}
+
+type PartitionDiskResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Partition number of the created partition, or of the existing one.
+	PartitionNumber uint32 `protobuf:"varint,1,opt,name=partition_number,json=partitionNumber,proto3" json:"partition_number,omitempty"`
+}
+
+func (x *PartitionDiskResponse) Reset() {
+	*x = PartitionDiskResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PartitionDiskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartitionDiskResponse) ProtoMessage() {}
+
+func (x *PartitionDiskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartitionDiskResponse.ProtoReflect.Descriptor instead.
+func (*PartitionDiskResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PartitionDiskResponse) GetPartitionNumber() uint32 {
+	if x != nil {
+		return x.PartitionNumber
+	}
+	return 0
+}
+
+type ListPartitionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disk device number of the disk.
+	DiskNumber uint32 `protobuf:"varint,1,opt,name=disk_number,json=diskNumber,proto3" json:"disk_number,omitempty"`
+}
+
+func (x *ListPartitionsRequest) Reset() {
+	*x = ListPartitionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPartitionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPartitionsRequest) ProtoMessage() {}
+
+func (x *ListPartitionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPartitionsRequest.ProtoReflect.Descriptor instead.
+func (*ListPartitionsRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListPartitionsRequest) GetDiskNumber() uint32 {
+	if x != nil {
+		return x.DiskNumber
+	}
+	return 0
+}
+
+type PartitionInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disk device number of the disk holding the partition.
+	DiskNumber uint32 `protobuf:"varint,1,opt,name=disk_number,json=diskNumber,proto3" json:"disk_number,omitempty"`
+	// Partition number of the partition on its disk.
+	PartitionNumber uint32 `protobuf:"varint,2,opt,name=partition_number,json=partitionNumber,proto3" json:"partition_number,omitempty"`
+	// Offset of the partition from the beginning of the disk in bytes.
+	Offset uint64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Size of the partition in bytes.
+	Size uint64 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	// MBR partition type, 0 on GPT disks.
+	MbrType uint32 `protobuf:"varint,5,opt,name=mbr_type,json=mbrType,proto3" json:"mbr_type,omitempty"`
+	// GPT partition type GUID, empty on MBR disks.
+	GptType string `protobuf:"bytes,6,opt,name=gpt_type,json=gptType,proto3" json:"gpt_type,omitempty"`
+	// Unique GUID of the partition, empty on MBR disks.
+	Guid string `protobuf:"bytes,7,opt,name=guid,proto3" json:"guid,omitempty"`
+	// Drive letter of the partition, empty if it has none.
+	DriveLetter string `protobuf:"bytes,8,opt,name=drive_letter,json=driveLetter,proto3" json:"drive_letter,omitempty"`
+	// The partition is the boot partition.
+	IsBoot bool `protobuf:"varint,9,opt,name=is_boot,json=isBoot,proto3" json:"is_boot,omitempty"`
+	// The partition is the system partition.
+	IsSystem bool `protobuf:"varint,10,opt,name=is_system,json=isSystem,proto3" json:"is_system,omitempty"`
+	// The partition is hidden.
+	IsHidden bool `protobuf:"varint,11,opt,name=is_hidden,json=isHidden,proto3" json:"is_hidden,omitempty"`
+	// The partition is read only.
+	IsReadOnly bool `protobuf:"varint,12,opt,name=is_read_only,json=isReadOnly,proto3" json:"is_read_only,omitempty"`
+	// The partition is offline.
+	IsOffline bool `protobuf:"varint,13,opt,name=is_offline,json=isOffline,proto3" json:"is_offline,omitempty"`
+}
+
+func (x *PartitionInfo) Reset() {
+	*x = PartitionInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PartitionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartitionInfo) ProtoMessage() {}
+
+func (x *PartitionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartitionInfo.ProtoReflect.Descriptor instead.
+func (*PartitionInfo) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PartitionInfo) GetDiskNumber() uint32 {
+	if x != nil {
+		return x.DiskNumber
+	}
+	return 0
+}
+
+func (x *PartitionInfo) GetPartitionNumber() uint32 {
+	if x != nil {
+		return x.PartitionNumber
+	}
+	return 0
+}
+
+func (x *PartitionInfo) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *PartitionInfo) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *PartitionInfo) GetMbrType() uint32 {
+	if x != nil {
+		return x.MbrType
+	}
+	return 0
+}
+
+func (x *PartitionInfo) GetGptType() string {
+	if x != nil {
+		return x.GptType
+	}
+	return ""
+}
+
+func (x *PartitionInfo) GetGuid() string {
+	if x != nil {
+		return x.Guid
+	}
+	return ""
+}
+
+func (x *PartitionInfo) GetDriveLetter() string {
+	if x != nil {
+		return x.DriveLetter
+	}
+	return ""
+}
+
+func (x *PartitionInfo) GetIsBoot() bool {
+	if x != nil {
+		return x.IsBoot
+	}
+	return false
+}
+
+func (x *PartitionInfo) GetIsSystem() bool {
+	if x != nil {
+		return x.IsSystem
+	}
+	return false
+}
+
+func (x *PartitionInfo) GetIsHidden() bool {
+	if x != nil {
+		return x.IsHidden
+	}
+	return false
+}
+
+func (x *PartitionInfo) GetIsReadOnly() bool {
+	if x != nil {
+		return x.IsReadOnly
+	}
+	return false
+}
+
+func (x *PartitionInfo) GetIsOffline() bool {
+	if x != nil {
+		return x.IsOffline
+	}
+	return false
+}
+
+type ListPartitionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Partitions of the disk, ordered by partition number.
+	Partitions []*PartitionInfo `protobuf:"bytes,1,rep,name=partitions,proto3" json:"partitions,omitempty"`
+}
+
+func (x *ListPartitionsResponse) Reset() {
+	*x = ListPartitionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPartitionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPartitionsResponse) ProtoMessage() {}
+
+func (x *ListPartitionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPartitionsResponse.ProtoReflect.Descriptor instead.
+func (*ListPartitionsResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListPartitionsResponse) GetPartitions() []*PartitionInfo {
+	if x != nil {
+		return x.Partitions
+	}
+	return nil
+}
+
+type DeletePartitionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disk device number of the disk holding the partition.
+	DiskNumber uint32 `protobuf:"varint,1,opt,name=disk_number,json=diskNumber,proto3" json:"disk_number,omitempty"`
+	// Partition number of the partition to delete.
+	PartitionNumber uint32 `protobuf:"varint,2,opt,name=partition_number,json=partitionNumber,proto3" json:"partition_number,omitempty"`
+}
+
+func (x *DeletePartitionRequest) Reset() {
+	*x = DeletePartitionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePartitionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePartitionRequest) ProtoMessage() {}
+
+func (x *DeletePartitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePartitionRequest.ProtoReflect.Descriptor instead.
+func (*DeletePartitionRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeletePartitionRequest) GetDiskNumber() uint32 {
+	if x != nil {
+		return x.DiskNumber
+	}
+	return 0
+}
+
+func (x *DeletePartitionRequest) GetPartitionNumber() uint32 {
+	if x != nil {
+		return x.PartitionNumber
+	}
+	return 0
+}
+
+type DeletePartitionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeletePartitionResponse) Reset() {
+	*x = DeletePartitionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePartitionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePartitionResponse) ProtoMessage() {}
+
+func (x *DeletePartitionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePartitionResponse.ProtoReflect.Descriptor instead.
+func (*DeletePartitionResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{9}
+}
+
+type ClearDiskRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disk device number of the disk to clear.
+	DiskNumber uint32 `protobuf:"varint,1,opt,name=disk_number,json=diskNumber,proto3" json:"disk_number,omitempty"`
+	// Parts of the disk to write zeros to.
+	ZeroMode ZeroMode `protobuf:"varint,2,opt,name=zero_mode,json=zeroMode,proto3,enum=v2alpha1.ZeroMode" json:"zero_mode,omitempty"`
+	// Size in megabytes zeroed at both the beginning and the end of the disk
+	// with ZERO_MODE_EDGES, 32 if 0.
+	ZeroMegabytes uint32 `protobuf:"varint,3,opt,name=zero_megabytes,json=zeroMegabytes,proto3" json:"zero_megabytes,omitempty"`
+	// Remove the OEM partitions too, e.g. recovery partitions.
+	RemoveOem bool `protobuf:"varint,4,opt,name=remove_oem,json=removeOem,proto3" json:"remove_oem,omitempty"`
+}
+
+func (x *ClearDiskRequest) Reset() {
+	*x = ClearDiskRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearDiskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearDiskRequest) ProtoMessage() {}
+
+func (x *ClearDiskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearDiskRequest.ProtoReflect.Descriptor instead.
+func (*ClearDiskRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ClearDiskRequest) GetDiskNumber() uint32 {
+	if x != nil {
+		return x.DiskNumber
+	}
+	return 0
+}
+
+func (x *ClearDiskRequest) GetZeroMode() ZeroMode {
+	if x != nil {
+		return x.ZeroMode
+	}
+	return ZeroMode_ZERO_MODE_NONE
+}
+
+func (x *ClearDiskRequest) GetZeroMegabytes() uint32 {
+	if x != nil {
+		return x.ZeroMegabytes
+	}
+	return 0
+}
+
+func (x *ClearDiskRequest) GetRemoveOem() bool {
+	if x != nil {
+		return x.RemoveOem
+	}
+	return false
+}
+
+type ClearDiskResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Stage ClearDisk is at.
+	Stage ClearDiskStage `protobuf:"varint,1,opt,name=stage,proto3,enum=v2alpha1.ClearDiskStage" json:"stage,omitempty"`
+	// Number of bytes zeroed so far.
+	BytesZeroed uint64 `protobuf:"varint,2,opt,name=bytes_zeroed,json=bytesZeroed,proto3" json:"bytes_zeroed,omitempty"`
+	// Number of bytes to zero in total.
+	BytesToZero uint64 `protobuf:"varint,3,opt,name=bytes_to_zero,json=bytesToZero,proto3" json:"bytes_to_zero,omitempty"`
+}
+
+func (x *ClearDiskResponse) Reset() {
+	*x = ClearDiskResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearDiskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearDiskResponse) ProtoMessage() {}
+
+func (x *ClearDiskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearDiskResponse.ProtoReflect.Descriptor instead.
+func (*ClearDiskResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ClearDiskResponse) GetStage() ClearDiskStage {
+	if x != nil {
+		return x.Stage
+	}
+	return ClearDiskStage_CLEAR_DISK_STAGE_CLEARING
+}
+
+func (x *ClearDiskResponse) GetBytesZeroed() uint64 {
+	if x != nil {
+		return x.BytesZeroed
+	}
+	return 0
+}
+
+func (x *ClearDiskResponse) GetBytesToZero() uint64 {
+	if x != nil {
+		return x.BytesToZero
+	}
+	return 0
+}
+
+type RescanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RescanRequest) Reset() {
+	*x = RescanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RescanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescanRequest) ProtoMessage() {}
+
+func (x *RescanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescanRequest.ProtoReflect.Descriptor instead.
+func (*RescanRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{12}
+}
+
+type RescanResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RescanResponse) Reset() {
+	*x = RescanResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RescanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescanResponse) ProtoMessage() {}
+
+func (x *RescanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescanResponse.ProtoReflect.Descriptor instead.
+func (*RescanResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{13}
+}
+
+type ListDiskIDsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListDiskIDsRequest) Reset() {
+	*x = ListDiskIDsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDiskIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDiskIDsRequest) ProtoMessage() {}
+
+func (x *ListDiskIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDiskIDsRequest.ProtoReflect.Descriptor instead.
+func (*ListDiskIDsRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{14}
+}
+
+type DiskIDs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The disk page83 id.
+	Page83 string `protobuf:"bytes,1,opt,name=page83,proto3" json:"page83,omitempty"`
+	// The disk serial number.
+	SerialNumber string `protobuf:"bytes,2,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	// The serial number reported by the storage device driver, e.g. the NVMe controller
+	// serial number.
+	DeviceSerialNumber string `protobuf:"bytes,3,opt,name=device_serial_number,json=deviceSerialNumber,proto3" json:"device_serial_number,omitempty"`
+	// All the device identification descriptors (VPD page 0x83) of the disk.
+	Identifiers []*DiskIdentifier `protobuf:"bytes,4,rep,name=identifiers,proto3" json:"identifiers,omitempty"`
+}
+
+func (x *DiskIDs) Reset() {
+	*x = DiskIDs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiskIDs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskIDs) ProtoMessage() {}
+
+func (x *DiskIDs) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskIDs.ProtoReflect.Descriptor instead.
+func (*DiskIDs) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DiskIDs) GetPage83() string {
+	if x != nil {
+		return x.Page83
+	}
+	return ""
+}
+
+func (x *DiskIDs) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+func (x *DiskIDs) GetDeviceSerialNumber() string {
+	if x != nil {
+		return x.DeviceSerialNumber
+	}
+	return ""
+}
+
+func (x *DiskIDs) GetIdentifiers() []*DiskIdentifier {
+	if x != nil {
+		return x.Identifiers
+	}
+	return nil
+}
+
+type DiskIdentifier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Type of the identifier.
+	Type IdentifierType `protobuf:"varint,1,opt,name=type,proto3,enum=v2alpha1.IdentifierType" json:"type,omitempty"`
+	// Entity the identifier identifies.
+	Association IdentifierAssociation `protobuf:"varint,2,opt,name=association,proto3,enum=v2alpha1.IdentifierAssociation" json:"association,omitempty"`
+	// Value of the identifier, hex encoded if binary.
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *DiskIdentifier) Reset() {
+	*x = DiskIdentifier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiskIdentifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskIdentifier) ProtoMessage() {}
+
+func (x *DiskIdentifier) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskIdentifier.ProtoReflect.Descriptor instead.
+func (*DiskIdentifier) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DiskIdentifier) GetType() IdentifierType {
+	if x != nil {
+		return x.Type
+	}
+	return IdentifierType_IDENTIFIER_TYPE_VENDOR_SPECIFIC
+}
+
+func (x *DiskIdentifier) GetAssociation() IdentifierAssociation {
+	if x != nil {
+		return x.Association
+	}
+	return IdentifierAssociation_IDENTIFIER_ASSOCIATION_DEVICE
+}
+
+func (x *DiskIdentifier) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type ListDiskIDsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Map of disk numbers and disk identifiers associated with each disk device.
+	DiskIDs map[uint32]*DiskIDs `protobuf:"bytes,1,rep,name=diskIDs,proto3" json:"diskIDs,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // the case is intentional for protoc to generate the field as DiskIDs
+}
+
+func (x *ListDiskIDsResponse) Reset() {
+	*x = ListDiskIDsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDiskIDsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDiskIDsResponse) ProtoMessage() {}
+
+func (x *ListDiskIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDiskIDsResponse.ProtoReflect.Descriptor instead.
+func (*ListDiskIDsResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListDiskIDsResponse) GetDiskIDs() map[uint32]*DiskIDs {
+	if x != nil {
+		return x.DiskIDs
+	}
+	return nil
+}
+
+type FindDiskRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Identifier to match, case insensitively and ignoring the surrounding spaces.
+	// Ignored if empty.
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// Kind of the identifiers to match identifier with.
+	IdentifierKind IdentifierKind `protobuf:"varint,2,opt,name=identifier_kind,json=identifierKind,proto3,enum=v2alpha1.IdentifierKind" json:"identifier_kind,omitempty"`
+	// Location to match, the empty fields matching any value, e.g. only the LUN ID
+	// and the target to find an Azure data disk. Ignored if unset.
+	Location *DiskLocation `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+}
+
+func (x *FindDiskRequest) Reset() {
+	*x = FindDiskRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindDiskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindDiskRequest) ProtoMessage() {}
+
+func (x *FindDiskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindDiskRequest.ProtoReflect.Descriptor instead.
+func (*FindDiskRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *FindDiskRequest) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+func (x *FindDiskRequest) GetIdentifierKind() IdentifierKind {
+	if x != nil {
+		return x.IdentifierKind
+	}
+	return IdentifierKind_IDENTIFIER_KIND_ANY
+}
+
+func (x *FindDiskRequest) GetLocation() *DiskLocation {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type FindDiskResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disk device number of the only disk matching the request.
+	DiskNumber uint32 `protobuf:"varint,1,opt,name=disk_number,json=diskNumber,proto3" json:"disk_number,omitempty"`
+}
+
+func (x *FindDiskResponse) Reset() {
+	*x = FindDiskResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindDiskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindDiskResponse) ProtoMessage() {}
+
+func (x *FindDiskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindDiskResponse.ProtoReflect.Descriptor instead.
+func (*FindDiskResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *FindDiskResponse) GetDiskNumber() uint32 {
+	if x != nil {
+		return x.DiskNumber
+	}
+	return 0
+}
+
+type WaitForDiskRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Identifier to match, as in FindDiskRequest.
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// Kind of the identifiers to match identifier with, as in FindDiskRequest.
+	IdentifierKind IdentifierKind `protobuf:"varint,2,opt,name=identifier_kind,json=identifierKind,proto3,enum=v2alpha1.IdentifierKind" json:"identifier_kind,omitempty"`
+	// Location to match, as in FindDiskRequest.
+	Location *DiskLocation `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	// Bring the disk online if it shows up offline, e.g. because of the SAN policy,
+	// instead of waiting for it to be brought online.
+	SetOnline bool `protobuf:"varint,4,opt,name=set_online,json=setOnline,proto3" json:"set_online,omitempty"`
+}
+
+func (x *WaitForDiskRequest) Reset() {
+	*x = WaitForDiskRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WaitForDiskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitForDiskRequest) ProtoMessage() {}
+
+func (x *WaitForDiskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitForDiskRequest.ProtoReflect.Descriptor instead.
+func (*WaitForDiskRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *WaitForDiskRequest) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+func (x *WaitForDiskRequest) GetIdentifierKind() IdentifierKind {
+	if x != nil {
+		return x.IdentifierKind
+	}
+	return IdentifierKind_IDENTIFIER_KIND_ANY
+}
+
+func (x *WaitForDiskRequest) GetLocation() *DiskLocation {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *WaitForDiskRequest) GetSetOnline() bool {
+	if x != nil {
+		return x.SetOnline
+	}
+	return false
+}
+
+type WaitForDiskResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disk device number of the disk.
+	DiskNumber uint32 `protobuf:"varint,1,opt,name=disk_number,json=diskNumber,proto3" json:"disk_number,omitempty"`
+	// Information of the disk.
+	Disk *DiskInfo `protobuf:"bytes,2,opt,name=disk,proto3" json:"disk,omitempty"`
+}
+
+func (x *WaitForDiskResponse) Reset() {
+	*x = WaitForDiskResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WaitForDiskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitForDiskResponse) ProtoMessage() {}
+
+func (x *WaitForDiskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitForDiskResponse.ProtoReflect.Descriptor instead.
+func (*WaitForDiskResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *WaitForDiskResponse) GetDiskNumber() uint32 {
+	if x != nil {
+		return x.DiskNumber
+	}
+	return 0
+}
+
+func (x *WaitForDiskResponse) GetDisk() *DiskInfo {
+	if x != nil {
+		return x.Disk
+	}
+	return nil
+}
+
+type GetDiskStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disk device number of the disk to get the stats from.
+	DiskNumber uint32 `protobuf:"varint,1,opt,name=disk_number,json=diskNumber,proto3" json:"disk_number,omitempty"`
+}
+
+func (x *GetDiskStatsRequest) Reset() {
+	*x = GetDiskStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiskStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiskStatsRequest) ProtoMessage() {}
+
+func (x *GetDiskStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiskStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetDiskStatsRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetDiskStatsRequest) GetDiskNumber() uint32 {
+	if x != nil {
+		return x.DiskNumber
+	}
+	return 0
+}
+
+type GetDiskStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Total size of the volume.
+	TotalBytes int64 `protobuf:"varint,1,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+}
+
+func (x *GetDiskStatsResponse) Reset() {
+	*x = GetDiskStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiskStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiskStatsResponse) ProtoMessage() {}
+
+func (x *GetDiskStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiskStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetDiskStatsResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetDiskStatsResponse) GetTotalBytes() int64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+type SetDiskStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disk device number of the disk.
+	DiskNumber uint32 `protobuf:"varint,1,opt,name=disk_number,json=diskNumber,proto3" json:"disk_number,omitempty"`
+	// Online state to set for the disk. true for online, false for offline.
+	IsOnline bool `protobuf:"varint,2,opt,name=is_online,json=isOnline,proto3" json:"is_online,omitempty"`
+}
+
+func (x *SetDiskStateRequest) Reset() {
+	*x = SetDiskStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetDiskStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDiskStateRequest) ProtoMessage() {}
+
+func (x *SetDiskStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDiskStateRequest.ProtoReflect.Descriptor instead.
+func (*SetDiskStateRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SetDiskStateRequest) GetDiskNumber() uint32 {
+	if x != nil {
+		return x.DiskNumber
+	}
+	return 0
+}
+
+func (x *SetDiskStateRequest) GetIsOnline() bool {
+	if x != nil {
+		return x.IsOnline
+	}
+	return false
+}
+
+type SetDiskStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetDiskStateResponse) Reset() {
+	*x = SetDiskStateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetDiskStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDiskStateResponse) ProtoMessage() {}
+
+func (x *SetDiskStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDiskStateResponse.ProtoReflect.Descriptor instead.
+func (*SetDiskStateResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{25}
+}
+
+type GetDiskStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disk device number of the disk.
+	DiskNumber uint32 `protobuf:"varint,1,opt,name=disk_number,json=diskNumber,proto3" json:"disk_number,omitempty"`
+}
+
+func (x *GetDiskStateRequest) Reset() {
+	*x = GetDiskStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiskStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiskStateRequest) ProtoMessage() {}
+
+func (x *GetDiskStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiskStateRequest.ProtoReflect.Descriptor instead.
+func (*GetDiskStateRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetDiskStateRequest) GetDiskNumber() uint32 {
+	if x != nil {
+		return x.DiskNumber
+	}
+	return 0
+}
+
+type GetDiskStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Online state of the disk. true for online, false for offline.
+	IsOnline bool `protobuf:"varint,1,opt,name=is_online,json=isOnline,proto3" json:"is_online,omitempty"`
+}
+
+func (x *GetDiskStateResponse) Reset() {
+	*x = GetDiskStateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiskStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiskStateResponse) ProtoMessage() {}
+
+func (x *GetDiskStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiskStateResponse.ProtoReflect.Descriptor instead.
+func (*GetDiskStateResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetDiskStateResponse) GetIsOnline() bool {
+	if x != nil {
+		return x.IsOnline
+	}
+	return false
+}
+
+type DiskAttributes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The disk is read only.
+	IsReadOnly bool `protobuf:"varint,1,opt,name=is_read_only,json=isReadOnly,proto3" json:"is_read_only,omitempty"`
+	// The disk is offline.
+	IsOffline bool `protobuf:"varint,2,opt,name=is_offline,json=isOffline,proto3" json:"is_offline,omitempty"`
+	// Reason the disk is offline, which is reported but can't be set.
+	OfflineReason OfflineReason `protobuf:"varint,3,opt,name=offline_reason,json=offlineReason,proto3,enum=v2alpha1.OfflineReason" json:"offline_reason,omitempty"`
+	// SAN policy of the host.
+	SanPolicy SanPolicy `protobuf:"varint,4,opt,name=san_policy,json=sanPolicy,proto3,enum=v2alpha1.SanPolicy" json:"san_policy,omitempty"`
+}
+
+func (x *DiskAttributes) Reset() {
+	*x = DiskAttributes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiskAttributes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskAttributes) ProtoMessage() {}
+
+func (x *DiskAttributes) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskAttributes.ProtoReflect.Descriptor instead.
+func (*DiskAttributes) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DiskAttributes) GetIsReadOnly() bool {
+	if x != nil {
+		return x.IsReadOnly
+	}
+	return false
+}
+
+func (x *DiskAttributes) GetIsOffline() bool {
+	if x != nil {
+		return x.IsOffline
+	}
+	return false
+}
+
+func (x *DiskAttributes) GetOfflineReason() OfflineReason {
+	if x != nil {
+		return x.OfflineReason
+	}
+	return OfflineReason_OFFLINE_REASON_NONE
+}
+
+func (x *DiskAttributes) GetSanPolicy() SanPolicy {
+	if x != nil {
+		return x.SanPolicy
+	}
+	return SanPolicy_SAN_POLICY_UNKNOWN
+}
+
+type SetDiskAttributesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disk device number of the disk.
+	DiskNumber uint32 `protobuf:"varint,1,opt,name=disk_number,json=diskNumber,proto3" json:"disk_number,omitempty"`
+	// Set the read only attribute of the disk to is_read_only.
+	SetReadOnly bool `protobuf:"varint,2,opt,name=set_read_only,json=setReadOnly,proto3" json:"set_read_only,omitempty"`
+	// Read only attribute to set, if set_read_only is set.
+	IsReadOnly bool `protobuf:"varint,3,opt,name=is_read_only,json=isReadOnly,proto3" json:"is_read_only,omitempty"`
+	// SAN policy to set on the host, left unchanged if unknown.
+	SanPolicy SanPolicy `protobuf:"varint,4,opt,name=san_policy,json=sanPolicy,proto3,enum=v2alpha1.SanPolicy" json:"san_policy,omitempty"`
+}
+
+func (x *SetDiskAttributesRequest) Reset() {
+	*x = SetDiskAttributesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetDiskAttributesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDiskAttributesRequest) ProtoMessage() {}
+
+func (x *SetDiskAttributesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDiskAttributesRequest.ProtoReflect.Descriptor instead.
+func (*SetDiskAttributesRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SetDiskAttributesRequest) GetDiskNumber() uint32 {
+	if x != nil {
+		return x.DiskNumber
+	}
+	return 0
+}
+
+func (x *SetDiskAttributesRequest) GetSetReadOnly() bool {
+	if x != nil {
+		return x.SetReadOnly
+	}
+	return false
+}
+
+func (x *SetDiskAttributesRequest) GetIsReadOnly() bool {
+	if x != nil {
+		return x.IsReadOnly
+	}
+	return false
+}
+
+func (x *SetDiskAttributesRequest) GetSanPolicy() SanPolicy {
+	if x != nil {
+		return x.SanPolicy
+	}
+	return SanPolicy_SAN_POLICY_UNKNOWN
+}
+
+type SetDiskAttributesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Attributes of the disk after the change.
+	Attributes *DiskAttributes `protobuf:"bytes,1,opt,name=attributes,proto3" json:"attributes,omitempty"`
+}
+
+func (x *SetDiskAttributesResponse) Reset() {
+	*x = SetDiskAttributesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetDiskAttributesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDiskAttributesResponse) ProtoMessage() {}
+
+func (x *SetDiskAttributesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDiskAttributesResponse.ProtoReflect.Descriptor instead.
+func (*SetDiskAttributesResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SetDiskAttributesResponse) GetAttributes() *DiskAttributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type GetDiskAttributesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disk device number of the disk.
+	DiskNumber uint32 `protobuf:"varint,1,opt,name=disk_number,json=diskNumber,proto3" json:"disk_number,omitempty"`
+}
+
+func (x *GetDiskAttributesRequest) Reset() {
+	*x = GetDiskAttributesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiskAttributesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiskAttributesRequest) ProtoMessage() {}
+
+func (x *GetDiskAttributesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiskAttributesRequest.ProtoReflect.Descriptor instead.
+func (*GetDiskAttributesRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetDiskAttributesRequest) GetDiskNumber() uint32 {
+	if x != nil {
+		return x.DiskNumber
+	}
+	return 0
+}
+
+type GetDiskAttributesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Attributes of the disk.
+	Attributes *DiskAttributes `protobuf:"bytes,1,opt,name=attributes,proto3" json:"attributes,omitempty"`
+}
+
+func (x *GetDiskAttributesResponse) Reset() {
+	*x = GetDiskAttributesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiskAttributesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiskAttributesResponse) ProtoMessage() {}
+
+func (x *GetDiskAttributesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiskAttributesResponse.ProtoReflect.Descriptor instead.
+func (*GetDiskAttributesResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetDiskAttributesResponse) GetAttributes() *DiskAttributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type DiskInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disk device number of the disk.
+	DiskNumber uint32 `protobuf:"varint,1,opt,name=disk_number,json=diskNumber,proto3" json:"disk_number,omitempty"`
+	// Total size of the disk in bytes.
+	Size uint64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// Logical sector size of the disk in bytes.
+	LogicalSectorSize uint32 `protobuf:"varint,3,opt,name=logical_sector_size,json=logicalSectorSize,proto3" json:"logical_sector_size,omitempty"`
+	// Physical sector size of the disk in bytes.
+	PhysicalSectorSize uint32 `protobuf:"varint,4,opt,name=physical_sector_size,json=physicalSectorSize,proto3" json:"physical_sector_size,omitempty"`
+	// Type of bus the disk is connected to.
+	BusType BusType `protobuf:"varint,5,opt,name=bus_type,json=busType,proto3,enum=v2alpha1.BusType" json:"bus_type,omitempty"`
+	// Friendly name of the disk, e.g. "Msft Virtual Disk".
+	FriendlyName string `protobuf:"bytes,6,opt,name=friendly_name,json=friendlyName,proto3" json:"friendly_name,omitempty"`
+	// Model of the disk.
+	Model string `protobuf:"bytes,7,opt,name=model,proto3" json:"model,omitempty"`
+	// The disk serial number.
+	SerialNumber string `protobuf:"bytes,8,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	// The disk page83 id, as reported by the storage subsystem.
+	Page83 string `protobuf:"bytes,9,opt,name=page83,proto3" json:"page83,omitempty"`
+	// Partition table format of the disk.
+	PartitionStyle PartitionStyle `protobuf:"varint,10,opt,name=partition_style,json=partitionStyle,proto3,enum=v2alpha1.PartitionStyle" json:"partition_style,omitempty"`
+	// The disk is the boot disk.
+	IsBoot bool `protobuf:"varint,11,opt,name=is_boot,json=isBoot,proto3" json:"is_boot,omitempty"`
+	// The disk holds the system partition.
+	IsSystem bool `protobuf:"varint,12,opt,name=is_system,json=isSystem,proto3" json:"is_system,omitempty"`
+	// The disk is read only.
+	IsReadOnly bool `protobuf:"varint,13,opt,name=is_read_only,json=isReadOnly,proto3" json:"is_read_only,omitempty"`
+	// The disk is offline.
+	IsOffline bool `protobuf:"varint,14,opt,name=is_offline,json=isOffline,proto3" json:"is_offline,omitempty"`
+	// Reason the disk is offline.
+	OfflineReason OfflineReason `protobuf:"varint,15,opt,name=offline_reason,json=offlineReason,proto3,enum=v2alpha1.OfflineReason" json:"offline_reason,omitempty"`
+	// <adapter, bus, target, lun ID> of the disk, empty if the disk location
+	// doesn't have them, e.g. for virtual hard disks.
+	Location *DiskLocation `protobuf:"bytes,16,opt,name=location,proto3" json:"location,omitempty"`
+	// The disk is used by a failover cluster.
+	IsClustered bool `protobuf:"varint,17,opt,name=is_clustered,json=isClustered,proto3" json:"is_clustered,omitempty"`
+}
+
+func (x *DiskInfo) Reset() {
+	*x = DiskInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiskInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskInfo) ProtoMessage() {}
+
+func (x *DiskInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskInfo.ProtoReflect.Descriptor instead.
+func (*DiskInfo) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DiskInfo) GetDiskNumber() uint32 {
+	if x != nil {
+		return x.DiskNumber
+	}
+	return 0
+}
+
+func (x *DiskInfo) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *DiskInfo) GetLogicalSectorSize() uint32 {
+	if x != nil {
+		return x.LogicalSectorSize
+	}
+	return 0
+}
+
+func (x *DiskInfo) GetPhysicalSectorSize() uint32 {
+	if x != nil {
+		return x.PhysicalSectorSize
+	}
+	return 0
+}
+
+func (x *DiskInfo) GetBusType() BusType {
+	if x != nil {
+		return x.BusType
+	}
+	return BusType_BUS_TYPE_UNKNOWN
+}
+
+func (x *DiskInfo) GetFriendlyName() string {
+	if x != nil {
+		return x.FriendlyName
+	}
+	return ""
+}
+
+func (x *DiskInfo) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *DiskInfo) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+func (x *DiskInfo) GetPage83() string {
+	if x != nil {
+		return x.Page83
+	}
+	return ""
+}
+
+func (x *DiskInfo) GetPartitionStyle() PartitionStyle {
+	if x != nil {
+		return x.PartitionStyle
+	}
+	return PartitionStyle_PARTITION_STYLE_UNKNOWN
+}
+
+func (x *DiskInfo) GetIsBoot() bool {
+	if x != nil {
+		return x.IsBoot
+	}
+	return false
+}
+
+func (x *DiskInfo) GetIsSystem() bool {
+	if x != nil {
+		return x.IsSystem
+	}
+	return false
+}
+
+func (x *DiskInfo) GetIsReadOnly() bool {
+	if x != nil {
+		return x.IsReadOnly
+	}
+	return false
+}
+
+func (x *DiskInfo) GetIsOffline() bool {
+	if x != nil {
+		return x.IsOffline
+	}
+	return false
+}
+
+func (x *DiskInfo) GetOfflineReason() OfflineReason {
+	if x != nil {
+		return x.OfflineReason
+	}
+	return OfflineReason_OFFLINE_REASON_NONE
+}
+
+func (x *DiskInfo) GetLocation() *DiskLocation {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *DiskInfo) GetIsClustered() bool {
+	if x != nil {
+		return x.IsClustered
+	}
+	return false
+}
+
+type ListDisksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListDisksRequest) Reset() {
+	*x = ListDisksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDisksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDisksRequest) ProtoMessage() {}
+
+func (x *ListDisksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDisksRequest.ProtoReflect.Descriptor instead.
+func (*ListDisksRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{34}
+}
+
+type ListDisksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Map of disk numbers and the information of each disk device.
+	Disks map[uint32]*DiskInfo `protobuf:"bytes,1,rep,name=disks,proto3" json:"disks,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ListDisksResponse) Reset() {
+	*x = ListDisksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDisksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDisksResponse) ProtoMessage() {}
+
+func (x *ListDisksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDisksResponse.ProtoReflect.Descriptor instead.
+func (*ListDisksResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListDisksResponse) GetDisks() map[uint32]*DiskInfo {
+	if x != nil {
+		return x.Disks
+	}
+	return nil
+}
+
+type GetDiskInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disk device number of the disk.
+	DiskNumber uint32 `protobuf:"varint,1,opt,name=disk_number,json=diskNumber,proto3" json:"disk_number,omitempty"`
+}
+
+func (x *GetDiskInfoRequest) Reset() {
+	*x = GetDiskInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiskInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiskInfoRequest) ProtoMessage() {}
+
+func (x *GetDiskInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiskInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetDiskInfoRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetDiskInfoRequest) GetDiskNumber() uint32 {
+	if x != nil {
+		return x.DiskNumber
+	}
+	return 0
+}
+
+type GetDiskInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Information of the disk.
+	Disk *DiskInfo `protobuf:"bytes,1,opt,name=disk,proto3" json:"disk,omitempty"`
+}
+
+func (x *GetDiskInfoResponse) Reset() {
+	*x = GetDiskInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiskInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiskInfoResponse) ProtoMessage() {}
+
+func (x *GetDiskInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiskInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetDiskInfoResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetDiskInfoResponse) GetDisk() *DiskInfo {
+	if x != nil {
+		return x.Disk
+	}
+	return nil
+}
+
+type WatchDisksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Interval in seconds at which the disks are checked for changes, 5 if 0.
+	PollIntervalSeconds uint32 `protobuf:"varint,1,opt,name=poll_interval_seconds,json=pollIntervalSeconds,proto3" json:"poll_interval_seconds,omitempty"`
+}
+
+func (x *WatchDisksRequest) Reset() {
+	*x = WatchDisksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchDisksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchDisksRequest) ProtoMessage() {}
+
+func (x *WatchDisksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchDisksRequest.ProtoReflect.Descriptor instead.
+func (*WatchDisksRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *WatchDisksRequest) GetPollIntervalSeconds() uint32 {
+	if x != nil {
+		return x.PollIntervalSeconds
+	}
+	return 0
+}
+
+type WatchDisksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Type of the event.
+	Type DiskEventType `protobuf:"varint,1,opt,name=type,proto3,enum=v2alpha1.DiskEventType" json:"type,omitempty"`
+	// Disk device number of the disk.
+	DiskNumber uint32 `protobuf:"varint,2,opt,name=disk_number,json=diskNumber,proto3" json:"disk_number,omitempty"`
+	// Information of the disk.
+	Disk *DiskInfo `protobuf:"bytes,3,opt,name=disk,proto3" json:"disk,omitempty"`
+}
+
+func (x *WatchDisksResponse) Reset() {
+	*x = WatchDisksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchDisksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchDisksResponse) ProtoMessage() {}
+
+func (x *WatchDisksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchDisksResponse.ProtoReflect.Descriptor instead.
+func (*WatchDisksResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *WatchDisksResponse) GetType() DiskEventType {
+	if x != nil {
+		return x.Type
+	}
+	return DiskEventType_DISK_EVENT_TYPE_EXISTING
+}
+
+func (x *WatchDisksResponse) GetDiskNumber() uint32 {
+	if x != nil {
+		return x.DiskNumber
+	}
+	return 0
+}
+
+func (x *WatchDisksResponse) GetDisk() *DiskInfo {
+	if x != nil {
+		return x.Disk
+	}
+	return nil
+}
+
+var File_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto protoreflect.FileDescriptor
+
+var file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDesc = []byte{
+	0x0a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x62,
+	0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x2d, 0x63, 0x73, 0x69, 0x2f, 0x63, 0x73, 0x69, 0x2d,
+	0x70, 0x72, 0x6f, 0x78, 0x79, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x64, 0x69, 0x73, 0x6b, 0x2f, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x61,
+	0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x22, 0x1a, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x68,
+	0x0a, 0x0c, 0x44, 0x69, 0x73, 0x6b, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x42, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x42, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x55, 0x4e, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x4c, 0x55, 0x4e, 0x49, 0x44, 0x22, 0xd4, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73,
+	0x74, 0x44, 0x69, 0x73, 0x6b, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x0e, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36,
+	0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69,
+	0x73, 0x6b, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x6b, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x58, 0x0a, 0x12, 0x44, 0x69, 0x73, 0x6b, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76,
+	0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0xa6, 0x02, 0x0a, 0x14, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x6b,
+	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64,
+	0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x0f, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x18, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x52, 0x0e, 0x70, 0x61,
+	0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x61, 0x6c, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x70, 0x74, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x70, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x62, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x6d, 0x62, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x22, 0x42, 0x0a, 0x15, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x38, 0x0a, 0x15,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x6b,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x88, 0x03, 0x0a, 0x0d, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x6b,
+	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64,
+	0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x6d, 0x62, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x6d, 0x62, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x67,
+	0x70, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67,
+	0x70, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x75, 0x69, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x67, 0x75, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x72,
+	0x69, 0x76, 0x65, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x72, 0x69, 0x76, 0x65, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x12, 0x17, 0x0a,
+	0x07, 0x69, 0x73, 0x5f, 0x62, 0x6f, 0x6f, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x69, 0x73, 0x42, 0x6f, 0x6f, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e,
+	0x12, 0x20, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x6e,
+	0x6c, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e,
+	0x65, 0x22, 0x51, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x64, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x29, 0x0a, 0x10, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x19, 0x0a, 0x17, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xaa, 0x01, 0x0a, 0x10, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x44,
+	0x69, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69,
+	0x73, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x09, 0x7a,
+	0x65, 0x72, 0x6f, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12,
+	0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x5a, 0x65, 0x72, 0x6f, 0x4d, 0x6f,
+	0x64, 0x65, 0x52, 0x08, 0x7a, 0x65, 0x72, 0x6f, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x25, 0x0a, 0x0e,
+	0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x6d, 0x65, 0x67, 0x61, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x7a, 0x65, 0x72, 0x6f, 0x4d, 0x65, 0x67, 0x61, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x6f, 0x65,
+	0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4f,
+	0x65, 0x6d, 0x22, 0x8a, 0x01, 0x0a, 0x11, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x44, 0x69, 0x73, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x67,
+	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x5a, 0x65, 0x72, 0x6f, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x62, 0x79, 0x74, 0x65, 0x73, 0x54, 0x6f, 0x5a, 0x65, 0x72, 0x6f, 0x22,
+	0x0f, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x10, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x44,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xb4, 0x01, 0x0a, 0x07, 0x44, 0x69, 0x73,
+	0x6b, 0x49, 0x44, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x67, 0x65, 0x38, 0x33, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x67, 0x65, 0x38, 0x33, 0x12, 0x23, 0x0a, 0x0d,
+	0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x30, 0x0a, 0x14, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x69,
+	0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x12, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x52, 0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x22,
+	0x97, 0x01, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x12, 0x2c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x18, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x41, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x41, 0x73, 0x73, 0x6f, 0x63,
+	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xaa, 0x01, 0x0a, 0x13, 0x4c, 0x69,
+	0x73, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x44, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x6b, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x44, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
+	0x64, 0x69, 0x73, 0x6b, 0x49, 0x44, 0x73, 0x1a, 0x4d, 0x0a, 0x0c, 0x44, 0x69, 0x73, 0x6b, 0x49,
+	0x44, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x27, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x44, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa8, 0x01, 0x0a, 0x0f, 0x46, 0x69, 0x6e, 0x64, 0x44,
+	0x69, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x0f, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x0e, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x32, 0x0a,
+	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x33, 0x0a, 0x10, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x6b,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xca, 0x01, 0x0a, 0x12, 0x57, 0x61, 0x69, 0x74, 0x46,
+	0x6f, 0x72, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x41, 0x0a,
+	0x0f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x5f, 0x6b, 0x69, 0x6e, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x4b, 0x69, 0x6e, 0x64,
+	0x52, 0x0e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x4b, 0x69, 0x6e, 0x64,
+	0x12, 0x32, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x69,
+	0x73, 0x6b, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x74, 0x5f, 0x6f, 0x6e, 0x6c, 0x69,
+	0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x65, 0x74, 0x4f, 0x6e, 0x6c,
+	0x69, 0x6e, 0x65, 0x22, 0x5e, 0x0a, 0x13, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x44, 0x69,
+	0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69,
+	0x73, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x04, 0x64,
+	0x69, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76, 0x32, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x64,
+	0x69, 0x73, 0x6b, 0x22, 0x36, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69,
+	0x73, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x37, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x22, 0x53, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64,
+	0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09,
+	0x69, 0x73, 0x5f, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x69, 0x73, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x53, 0x65, 0x74,
+	0x44, 0x69, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x36, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x6b,
+	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64,
+	0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x33, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x44, 0x69, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0xc5,
+	0x01, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x6b, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x12, 0x20, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6f, 0x6e, 0x6c,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x52, 0x65, 0x61, 0x64, 0x4f,
+	0x6e, 0x6c, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x4f, 0x66, 0x66, 0x6c, 0x69,
+	0x6e, 0x65, 0x12, 0x3e, 0x0a, 0x0e, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x76, 0x32, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x52, 0x0d, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x0a, 0x73, 0x61, 0x6e, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x53, 0x61, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x09, 0x73, 0x61, 0x6e,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0xb5, 0x01, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x44, 0x69,
+	0x73, 0x6b, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x64,
+	0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x65, 0x74,
+	0x52, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x20, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x72,
+	0x65, 0x61, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x69, 0x73, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x32, 0x0a, 0x0a, 0x73, 0x61,
+	0x6e, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13,
+	0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x61, 0x6e, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x09, 0x73, 0x61, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x55,
+	0x0a, 0x19, 0x53, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0a, 0x61,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0x3b, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x22, 0x55, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x38, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44,
+	0x69, 0x73, 0x6b, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x0a, 0x61,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0x98, 0x05, 0x0a, 0x08, 0x44, 0x69,
+	0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x69, 0x73,
+	0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x6c,
+	0x6f, 0x67, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x61,
+	0x6c, 0x53, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x70,
+	0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x70, 0x68, 0x79, 0x73, 0x69,
+	0x63, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2c, 0x0a,
+	0x08, 0x62, 0x75, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x11, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x42, 0x75, 0x73, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x07, 0x62, 0x75, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x66,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x6c, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x6c, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c,
+	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73,
+	0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x61, 0x67, 0x65, 0x38, 0x33, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x67,
+	0x65, 0x38, 0x33, 0x12, 0x41, 0x0a, 0x0f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x76,
+	0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x52, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x62, 0x6f, 0x6f,
+	0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x42, 0x6f, 0x6f, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x20, 0x0a, 0x0c,
+	0x69, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x1d,
+	0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x3e, 0x0a,
+	0x0e, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0d,
+	0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x32, 0x0a,
+	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x65,
+	0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x65, 0x64, 0x22, 0x12, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73, 0x6b,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x9f, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73,
+	0x74, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c,
+	0x0a, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73,
+	0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x1a, 0x4c, 0x0a, 0x0a,
+	0x44, 0x69, 0x73, 0x6b, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76, 0x32,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x35, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x22, 0x3d, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x64, 0x69, 0x73, 0x6b,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x64, 0x69, 0x73, 0x6b,
+	0x22, 0x47, 0x0a, 0x11, 0x57, 0x61, 0x74, 0x63, 0x68, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x70, 0x6f, 0x6c, 0x6c, 0x5f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x70, 0x6f, 0x6c, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x8a, 0x01, 0x0a, 0x12, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17,
+	0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x26,
+	0x0a, 0x04, 0x64, 0x69, 0x73, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76,
+	0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x04, 0x64, 0x69, 0x73, 0x6b, 0x2a, 0x49, 0x0a, 0x08, 0x5a, 0x65, 0x72, 0x6f, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x5a, 0x45, 0x52, 0x4f, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f,
+	0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x5a, 0x45, 0x52, 0x4f, 0x5f, 0x4d,
+	0x4f, 0x44, 0x45, 0x5f, 0x45, 0x44, 0x47, 0x45, 0x53, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x5a,
+	0x45, 0x52, 0x4f, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x45, 0x4e, 0x54, 0x49, 0x52, 0x45, 0x10,
+	0x02, 0x2a, 0x68, 0x0a, 0x0e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x74,
+	0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x43, 0x4c, 0x45, 0x41, 0x52, 0x5f, 0x44, 0x49, 0x53,
+	0x4b, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x43, 0x4c, 0x45, 0x41, 0x52, 0x49, 0x4e, 0x47,
+	0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x4c, 0x45, 0x41, 0x52, 0x5f, 0x44, 0x49, 0x53, 0x4b,
+	0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x5a, 0x45, 0x52, 0x4f, 0x49, 0x4e, 0x47, 0x10, 0x01,
+	0x12, 0x19, 0x0a, 0x15, 0x43, 0x4c, 0x45, 0x41, 0x52, 0x5f, 0x44, 0x49, 0x53, 0x4b, 0x5f, 0x53,
+	0x54, 0x41, 0x47, 0x45, 0x5f, 0x44, 0x4f, 0x4e, 0x45, 0x10, 0x02, 0x2a, 0xd1, 0x02, 0x0a, 0x0e,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23,
+	0x0a, 0x1f, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x46, 0x49, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x56, 0x45, 0x4e, 0x44, 0x4f, 0x52, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x43, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x46, 0x49, 0x45,
+	0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x31, 0x30, 0x5f, 0x56, 0x45, 0x4e, 0x44, 0x4f,
+	0x52, 0x5f, 0x49, 0x44, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49,
+	0x46, 0x49, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x55, 0x49, 0x36, 0x34, 0x10,
+	0x02, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x46, 0x49, 0x45, 0x52, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x41, 0x41, 0x10, 0x03, 0x12, 0x28, 0x0a, 0x24, 0x49, 0x44,
+	0x45, 0x4e, 0x54, 0x49, 0x46, 0x49, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45,
+	0x4c, 0x41, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x50, 0x4f,
+	0x52, 0x54, 0x10, 0x04, 0x12, 0x25, 0x0a, 0x21, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x46, 0x49,
+	0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x50,
+	0x4f, 0x52, 0x54, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x10, 0x05, 0x12, 0x26, 0x0a, 0x22, 0x49,
+	0x44, 0x45, 0x4e, 0x54, 0x49, 0x46, 0x49, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c,
+	0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x47, 0x52, 0x4f, 0x55,
+	0x50, 0x10, 0x06, 0x12, 0x24, 0x0a, 0x20, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x46, 0x49, 0x45,
+	0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x44, 0x35, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43,
+	0x41, 0x4c, 0x5f, 0x55, 0x4e, 0x49, 0x54, 0x10, 0x07, 0x12, 0x24, 0x0a, 0x20, 0x49, 0x44, 0x45,
+	0x4e, 0x54, 0x49, 0x46, 0x49, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x43, 0x53,
+	0x49, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x08, 0x2a,
+	0x7e, 0x0a, 0x15, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x41, 0x73, 0x73,
+	0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x1d, 0x49, 0x44, 0x45, 0x4e,
+	0x54, 0x49, 0x46, 0x49, 0x45, 0x52, 0x5f, 0x41, 0x53, 0x53, 0x4f, 0x43, 0x49, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x56, 0x49, 0x43, 0x45, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x49,
+	0x44, 0x45, 0x4e, 0x54, 0x49, 0x46, 0x49, 0x45, 0x52, 0x5f, 0x41, 0x53, 0x53, 0x4f, 0x43, 0x49,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d,
+	0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x46, 0x49, 0x45, 0x52, 0x5f, 0x41, 0x53, 0x53, 0x4f, 0x43,
+	0x49, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x10, 0x02, 0x2a,
+	0x8a, 0x02, 0x0a, 0x0e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x4b, 0x69,
+	0x6e, 0x64, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x46, 0x49, 0x45, 0x52,
+	0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41, 0x4e, 0x59, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x49,
+	0x44, 0x45, 0x4e, 0x54, 0x49, 0x46, 0x49, 0x45, 0x52, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x50,
+	0x41, 0x47, 0x45, 0x38, 0x33, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x49, 0x44, 0x45, 0x4e, 0x54,
+	0x49, 0x46, 0x49, 0x45, 0x52, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x53, 0x45, 0x52, 0x49, 0x41,
+	0x4c, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x02, 0x12, 0x23, 0x0a, 0x1f, 0x49, 0x44,
+	0x45, 0x4e, 0x54, 0x49, 0x46, 0x49, 0x45, 0x52, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x56, 0x45,
+	0x4e, 0x44, 0x4f, 0x52, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x43, 0x10, 0x03, 0x12,
+	0x21, 0x0a, 0x1d, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x46, 0x49, 0x45, 0x52, 0x5f, 0x4b, 0x49,
+	0x4e, 0x44, 0x5f, 0x54, 0x31, 0x30, 0x5f, 0x56, 0x45, 0x4e, 0x44, 0x4f, 0x52, 0x5f, 0x49, 0x44,
+	0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x46, 0x49, 0x45, 0x52,
+	0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x45, 0x55, 0x49, 0x36, 0x34, 0x10, 0x05, 0x12, 0x17, 0x0a,
+	0x13, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x46, 0x49, 0x45, 0x52, 0x5f, 0x4b, 0x49, 0x4e, 0x44,
+	0x5f, 0x4e, 0x41, 0x41, 0x10, 0x06, 0x12, 0x24, 0x0a, 0x20, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49,
+	0x46, 0x49, 0x45, 0x52, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x53, 0x43, 0x53, 0x49, 0x5f, 0x4e,
+	0x41, 0x4d, 0x45, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x07, 0x2a, 0x9a, 0x01, 0x0a,
+	0x09, 0x53, 0x61, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x41,
+	0x4e, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
+	0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x41, 0x4e, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59,
+	0x5f, 0x4f, 0x4e, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x01, 0x12, 0x1d, 0x0a,
+	0x19, 0x53, 0x41, 0x4e, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x4f, 0x46, 0x46, 0x4c,
+	0x49, 0x4e, 0x45, 0x5f, 0x53, 0x48, 0x41, 0x52, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16,
+	0x53, 0x41, 0x4e, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x4f, 0x46, 0x46, 0x4c, 0x49,
+	0x4e, 0x45, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x53, 0x41, 0x4e, 0x5f,
+	0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x4f, 0x46, 0x46, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x49,
+	0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x10, 0x04, 0x2a, 0xaa, 0x03, 0x0a, 0x07, 0x42, 0x75,
+	0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x42, 0x55, 0x53, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x42,
+	0x55, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x43, 0x53, 0x49, 0x10, 0x01, 0x12, 0x12,
+	0x0a, 0x0e, 0x42, 0x55, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x54, 0x41, 0x50, 0x49,
+	0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x42, 0x55, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41,
+	0x54, 0x41, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x42, 0x55, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x49, 0x45, 0x45, 0x45, 0x31, 0x33, 0x39, 0x34, 0x10, 0x04, 0x12, 0x10, 0x0a, 0x0c, 0x42,
+	0x55, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x53, 0x41, 0x10, 0x05, 0x12, 0x1a, 0x0a,
+	0x16, 0x42, 0x55, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x49, 0x42, 0x52, 0x45, 0x5f,
+	0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x06, 0x12, 0x10, 0x0a, 0x0c, 0x42, 0x55, 0x53,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x53, 0x42, 0x10, 0x07, 0x12, 0x11, 0x0a, 0x0d, 0x42,
+	0x55, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x41, 0x49, 0x44, 0x10, 0x08, 0x12, 0x12,
+	0x0a, 0x0e, 0x42, 0x55, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x53, 0x43, 0x53, 0x49,
+	0x10, 0x09, 0x12, 0x10, 0x0a, 0x0c, 0x42, 0x55, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53,
+	0x41, 0x53, 0x10, 0x0a, 0x12, 0x11, 0x0a, 0x0d, 0x42, 0x55, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x53, 0x41, 0x54, 0x41, 0x10, 0x0b, 0x12, 0x0f, 0x0a, 0x0b, 0x42, 0x55, 0x53, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x53, 0x44, 0x10, 0x0c, 0x12, 0x10, 0x0a, 0x0c, 0x42, 0x55, 0x53, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x4d, 0x43, 0x10, 0x0d, 0x12, 0x14, 0x0a, 0x10, 0x42, 0x55,
+	0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x10, 0x0e,
+	0x12, 0x20, 0x0a, 0x1c, 0x42, 0x55, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x49, 0x4c,
+	0x45, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x45, 0x44, 0x5f, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c,
+	0x10, 0x0f, 0x12, 0x1b, 0x0a, 0x17, 0x42, 0x55, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53,
+	0x54, 0x4f, 0x52, 0x41, 0x47, 0x45, 0x5f, 0x53, 0x50, 0x41, 0x43, 0x45, 0x53, 0x10, 0x10, 0x12,
+	0x11, 0x0a, 0x0d, 0x42, 0x55, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x56, 0x4d, 0x45,
+	0x10, 0x11, 0x12, 0x10, 0x0a, 0x0c, 0x42, 0x55, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53,
+	0x43, 0x4d, 0x10, 0x12, 0x12, 0x10, 0x0a, 0x0c, 0x42, 0x55, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x55, 0x46, 0x53, 0x10, 0x13, 0x2a, 0x5f, 0x0a, 0x0e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x41, 0x52, 0x54,
+	0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x59, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e,
+	0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x41, 0x52, 0x54, 0x49, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x59, 0x4c, 0x45, 0x5f, 0x4d, 0x42, 0x52, 0x10, 0x01, 0x12, 0x17,
+	0x0a, 0x13, 0x50, 0x41, 0x52, 0x54, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x59, 0x4c,
+	0x45, 0x5f, 0x47, 0x50, 0x54, 0x10, 0x02, 0x2a, 0xa6, 0x02, 0x0a, 0x0d, 0x4f, 0x66, 0x66, 0x6c,
+	0x69, 0x6e, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x13, 0x4f, 0x46, 0x46,
+	0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x4e, 0x45,
+	0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x4f, 0x46, 0x46, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x10, 0x01, 0x12, 0x21, 0x0a,
+	0x1d, 0x4f, 0x46, 0x46, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x52, 0x45, 0x44, 0x55, 0x4e, 0x44, 0x41, 0x4e, 0x54, 0x5f, 0x50, 0x41, 0x54, 0x48, 0x10, 0x02,
+	0x12, 0x1b, 0x0a, 0x17, 0x4f, 0x46, 0x46, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x10, 0x03, 0x12, 0x1c, 0x0a,
+	0x18, 0x4f, 0x46, 0x46, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x43, 0x4f, 0x4c, 0x4c, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x12, 0x26, 0x0a, 0x22, 0x4f,
+	0x46, 0x46, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x52, 0x45,
+	0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x45, 0x58, 0x48, 0x41, 0x55, 0x53, 0x54, 0x49, 0x4f,
+	0x4e, 0x10, 0x05, 0x12, 0x2a, 0x0a, 0x26, 0x4f, 0x46, 0x46, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x52,
+	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x43, 0x52, 0x49, 0x54, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x57,
+	0x52, 0x49, 0x54, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x53, 0x10, 0x06, 0x12,
+	0x2f, 0x0a, 0x2b, 0x4f, 0x46, 0x46, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f,
+	0x4e, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x52, 0x49, 0x54, 0x59,
+	0x5f, 0x53, 0x43, 0x41, 0x4e, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x07,
+	0x2a, 0xd7, 0x01, 0x0a, 0x0d, 0x44, 0x69, 0x73, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x49, 0x53, 0x4b, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x00,
+	0x12, 0x1a, 0x0a, 0x16, 0x44, 0x49, 0x53, 0x4b, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x45, 0x44, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15,
+	0x44, 0x49, 0x53, 0x4b, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x44, 0x49, 0x53, 0x4b, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56,
+	0x45, 0x44, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x49, 0x53, 0x4b, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x4e, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x04,
+	0x12, 0x1b, 0x0a, 0x17, 0x44, 0x49, 0x53, 0x4b, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x4f, 0x46, 0x46, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x05, 0x12, 0x1b, 0x0a,
+	0x17, 0x44, 0x49, 0x53, 0x4b, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x52, 0x45, 0x53, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x06, 0x32, 0xeb, 0x0a, 0x0a, 0x04, 0x44,
+	0x69, 0x73, 0x6b, 0x12, 0x5e, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x22, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x76,
+	0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73, 0x6b,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0d, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x69, 0x73, 0x6b, 0x12, 0x1e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x2e, 0x76, 0x32, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x76, 0x32, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58,
+	0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x20, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x09, 0x43, 0x6c, 0x65, 0x61,
+	0x72, 0x44, 0x69, 0x73, 0x6b, 0x12, 0x1a, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x65,
+	0x61, 0x72, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x30, 0x01, 0x12, 0x3d, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x12, 0x17, 0x2e, 0x76,
+	0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x52, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x4c, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x44, 0x73,
+	0x12, 0x1c, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x44, 0x69, 0x73, 0x6b, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69,
+	0x73, 0x6b, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x43, 0x0a, 0x08, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x69, 0x73, 0x6b, 0x12, 0x19, 0x2e, 0x76, 0x32,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x69, 0x73, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0b, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x44,
+	0x69, 0x73, 0x6b, 0x12, 0x1c, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x57,
+	0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x57, 0x61, 0x69,
+	0x74, 0x46, 0x6f, 0x72, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x1d, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x44, 0x69, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x1d, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53,
+	0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65,
+	0x74, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x76, 0x32, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x44, 0x69, 0x73,
+	0x6b, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x76, 0x32, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73,
+	0x6b, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73,
+	0x6b, 0x73, 0x12, 0x1a, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
+	0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69,
+	0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a,
+	0x0b, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x2e, 0x76,
+	0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x76, 0x32, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0a, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x12, 0x1b, 0x2e, 0x76, 0x32, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x42, 0x3e, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65,
+	0x73, 0x2d, 0x63, 0x73, 0x69, 0x2f, 0x63, 0x73, 0x69, 0x2d, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2f,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x64, 0x69, 0x73, 0x6b, 0x2f,
+	0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescOnce sync.Once
+	file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescData = file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDesc
+)
+
+func file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP() []byte {
+	file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescOnce.Do(func() {
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescData)
+	})
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescData
+}
+
+var file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
+var file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_goTypes = []interface{}{
+	(ZeroMode)(0),                     // 0: v2alpha1.ZeroMode
+	(ClearDiskStage)(0),               // 1: v2alpha1.ClearDiskStage
+	(IdentifierType)(0),               // 2: v2alpha1.IdentifierType
+	(IdentifierAssociation)(0),        // 3: v2alpha1.IdentifierAssociation
+	(IdentifierKind)(0),               // 4: v2alpha1.IdentifierKind
+	(SanPolicy)(0),                    // 5: v2alpha1.SanPolicy
+	(BusType)(0),                      // 6: v2alpha1.BusType
+	(PartitionStyle)(0),               // 7: v2alpha1.PartitionStyle
+	(OfflineReason)(0),                // 8: v2alpha1.OfflineReason
+	(DiskEventType)(0),                // 9: v2alpha1.DiskEventType
+	(*ListDiskLocationsRequest)(nil),  // 10: v2alpha1.ListDiskLocationsRequest
+	(*DiskLocation)(nil),              // 11: v2alpha1.DiskLocation
+	(*ListDiskLocationsResponse)(nil), // 12: v2alpha1.ListDiskLocationsResponse
+	(*PartitionDiskRequest)(nil),      // 13: v2alpha1.PartitionDiskRequest
+	(*PartitionDiskResponse)(nil),     // 14: v2alpha1.PartitionDiskResponse
+	(*ListPartitionsRequest)(nil),     // 15: v2alpha1.ListPartitionsRequest
+	(*PartitionInfo)(nil),             // 16: v2alpha1.PartitionInfo
+	(*ListPartitionsResponse)(nil),    // 17: v2alpha1.ListPartitionsResponse
+	(*DeletePartitionRequest)(nil),    // 18: v2alpha1.DeletePartitionRequest
+	(*DeletePartitionResponse)(nil),   // 19: v2alpha1.DeletePartitionResponse
+	(*ClearDiskRequest)(nil),          // 20: v2alpha1.ClearDiskRequest
+	(*ClearDiskResponse)(nil),         // 21: v2alpha1.ClearDiskResponse
+	(*RescanRequest)(nil),             // 22: v2alpha1.RescanRequest
+	(*RescanResponse)(nil),            // 23: v2alpha1.RescanResponse
+	(*ListDiskIDsRequest)(nil),        // 24: v2alpha1.ListDiskIDsRequest
+	(*DiskIDs)(nil),                   // 25: v2alpha1.DiskIDs
+	(*DiskIdentifier)(nil),            // 26: v2alpha1.DiskIdentifier
+	(*ListDiskIDsResponse)(nil),       // 27: v2alpha1.ListDiskIDsResponse
+	(*FindDiskRequest)(nil),           // 28: v2alpha1.FindDiskRequest
+	(*FindDiskResponse)(nil),          // 29: v2alpha1.FindDiskResponse
+	(*WaitForDiskRequest)(nil),        // 30: v2alpha1.WaitForDiskRequest
+	(*WaitForDiskResponse)(nil),       // 31: v2alpha1.WaitForDiskResponse
+	(*GetDiskStatsRequest)(nil),       // 32: v2alpha1.GetDiskStatsRequest
+	(*GetDiskStatsResponse)(nil),      // 33: v2alpha1.GetDiskStatsResponse
+	(*SetDiskStateRequest)(nil),       // 34: v2alpha1.SetDiskStateRequest
+	(*SetDiskStateResponse)(nil),      // 35: v2alpha1.SetDiskStateResponse
+	(*GetDiskStateRequest)(nil),       // 36: v2alpha1.GetDiskStateRequest
+	(*GetDiskStateResponse)(nil),      // 37: v2alpha1.GetDiskStateResponse
+	(*DiskAttributes)(nil),            // 38: v2alpha1.DiskAttributes
+	(*SetDiskAttributesRequest)(nil),  // 39: v2alpha1.SetDiskAttributesRequest
+	(*SetDiskAttributesResponse)(nil), // 40: v2alpha1.SetDiskAttributesResponse
+	(*GetDiskAttributesRequest)(nil),  // 41: v2alpha1.GetDiskAttributesRequest
+	(*GetDiskAttributesResponse)(nil), // 42: v2alpha1.GetDiskAttributesResponse
+	(*DiskInfo)(nil),                  // 43: v2alpha1.DiskInfo
+	(*ListDisksRequest)(nil),          // 44: v2alpha1.ListDisksRequest
+	(*ListDisksResponse)(nil),         // 45: v2alpha1.ListDisksResponse
+	(*GetDiskInfoRequest)(nil),        // 46: v2alpha1.GetDiskInfoRequest
+	(*GetDiskInfoResponse)(nil),       // 47: v2alpha1.GetDiskInfoResponse
+	(*WatchDisksRequest)(nil),         // 48: v2alpha1.WatchDisksRequest
+	(*WatchDisksResponse)(nil),        // 49: v2alpha1.WatchDisksResponse
+	nil,                               // 50: v2alpha1.ListDiskLocationsResponse.DiskLocationsEntry
+	nil,                               // 51: v2alpha1.ListDiskIDsResponse.DiskIDsEntry
+	nil,                               // 52: v2alpha1.ListDisksResponse.DisksEntry
+}
+var file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_depIdxs = []int32{
+	50, // 0: v2alpha1.ListDiskLocationsResponse.disk_locations:type_name -> v2alpha1.ListDiskLocationsResponse.DiskLocationsEntry
+	7,  // 1: v2alpha1.PartitionDiskRequest.partition_style:type_name -> v2alpha1.PartitionStyle
+	16, // 2: v2alpha1.ListPartitionsResponse.partitions:type_name -> v2alpha1.PartitionInfo
+	0,  // 3: v2alpha1.ClearDiskRequest.zero_mode:type_name -> v2alpha1.ZeroMode
+	1,  // 4: v2alpha1.ClearDiskResponse.stage:type_name -> v2alpha1.ClearDiskStage
+	26, // 5: v2alpha1.DiskIDs.identifiers:type_name -> v2alpha1.DiskIdentifier
+	2,  // 6: v2alpha1.DiskIdentifier.type:type_name -> v2alpha1.IdentifierType
+	3,  // 7: v2alpha1.DiskIdentifier.association:type_name -> v2alpha1.IdentifierAssociation
+	51, // 8: v2alpha1.ListDiskIDsResponse.diskIDs:type_name -> v2alpha1.ListDiskIDsResponse.DiskIDsEntry
+	4,  // 9: v2alpha1.FindDiskRequest.identifier_kind:type_name -> v2alpha1.IdentifierKind
+	11, // 10: v2alpha1.FindDiskRequest.location:type_name -> v2alpha1.DiskLocation
+	4,  // 11: v2alpha1.WaitForDiskRequest.identifier_kind:type_name -> v2alpha1.IdentifierKind
+	11, // 12: v2alpha1.WaitForDiskRequest.location:type_name -> v2alpha1.DiskLocation
+	43, // 13: v2alpha1.WaitForDiskResponse.disk:type_name -> v2alpha1.DiskInfo
+	8,  // 14: v2alpha1.DiskAttributes.offline_reason:type_name -> v2alpha1.OfflineReason
+	5,  // 15: v2alpha1.DiskAttributes.san_policy:type_name -> v2alpha1.SanPolicy
+	5,  // 16: v2alpha1.SetDiskAttributesRequest.san_policy:type_name -> v2alpha1.SanPolicy
+	38, // 17: v2alpha1.SetDiskAttributesResponse.attributes:type_name -> v2alpha1.DiskAttributes
+	38, // 18: v2alpha1.GetDiskAttributesResponse.attributes:type_name -> v2alpha1.DiskAttributes
+	6,  // 19: v2alpha1.DiskInfo.bus_type:type_name -> v2alpha1.BusType
+	7,  // 20: v2alpha1.DiskInfo.partition_style:type_name -> v2alpha1.PartitionStyle
+	8,  // 21: v2alpha1.DiskInfo.offline_reason:type_name -> v2alpha1.OfflineReason
+	11, // 22: v2alpha1.DiskInfo.location:type_name -> v2alpha1.DiskLocation
+	52, // 23: v2alpha1.ListDisksResponse.disks:type_name -> v2alpha1.ListDisksResponse.DisksEntry
+	43, // 24: v2alpha1.GetDiskInfoResponse.disk:type_name -> v2alpha1.DiskInfo
+	9,  // 25: v2alpha1.WatchDisksResponse.type:type_name -> v2alpha1.DiskEventType
+	43, // 26: v2alpha1.WatchDisksResponse.disk:type_name -> v2alpha1.DiskInfo
+	11, // 27: v2alpha1.ListDiskLocationsResponse.DiskLocationsEntry.value:type_name -> v2alpha1.DiskLocation
+	25, // 28: v2alpha1.ListDiskIDsResponse.DiskIDsEntry.value:type_name -> v2alpha1.DiskIDs
+	43, // 29: v2alpha1.ListDisksResponse.DisksEntry.value:type_name -> v2alpha1.DiskInfo
+	10, // 30: v2alpha1.Disk.ListDiskLocations:input_type -> v2alpha1.ListDiskLocationsRequest
+	13, // 31: v2alpha1.Disk.PartitionDisk:input_type -> v2alpha1.PartitionDiskRequest
+	15, // 32: v2alpha1.Disk.ListPartitions:input_type -> v2alpha1.ListPartitionsRequest
+	18, // 33: v2alpha1.Disk.DeletePartition:input_type -> v2alpha1.DeletePartitionRequest
+	20, // 34: v2alpha1.Disk.ClearDisk:input_type -> v2alpha1.ClearDiskRequest
+	22, // 35: v2alpha1.Disk.Rescan:input_type -> v2alpha1.RescanRequest
+	24, // 36: v2alpha1.Disk.ListDiskIDs:input_type -> v2alpha1.ListDiskIDsRequest
+	28, // 37: v2alpha1.Disk.FindDisk:input_type -> v2alpha1.FindDiskRequest
+	30, // 38: v2alpha1.Disk.WaitForDisk:input_type -> v2alpha1.WaitForDiskRequest
+	32, // 39: v2alpha1.Disk.GetDiskStats:input_type -> v2alpha1.GetDiskStatsRequest
+	34, // 40: v2alpha1.Disk.SetDiskState:input_type -> v2alpha1.SetDiskStateRequest
+	36, // 41: v2alpha1.Disk.GetDiskState:input_type -> v2alpha1.GetDiskStateRequest
+	39, // 42: v2alpha1.Disk.SetDiskAttributes:input_type -> v2alpha1.SetDiskAttributesRequest
+	41, // 43: v2alpha1.Disk.GetDiskAttributes:input_type -> v2alpha1.GetDiskAttributesRequest
+	44, // 44: v2alpha1.Disk.ListDisks:input_type -> v2alpha1.ListDisksRequest
+	46, // 45: v2alpha1.Disk.GetDiskInfo:input_type -> v2alpha1.GetDiskInfoRequest
+	48, // 46: v2alpha1.Disk.WatchDisks:input_type -> v2alpha1.WatchDisksRequest
+	12, // 47: v2alpha1.Disk.ListDiskLocations:output_type -> v2alpha1.ListDiskLocationsResponse
+	14, // 48: v2alpha1.Disk.PartitionDisk:output_type -> v2alpha1.PartitionDiskResponse
+	17, // 49: v2alpha1.Disk.ListPartitions:output_type -> v2alpha1.ListPartitionsResponse
+	19, // 50: v2alpha1.Disk.DeletePartition:output_type -> v2alpha1.DeletePartitionResponse
+	21, // 51: v2alpha1.Disk.ClearDisk:output_type -> v2alpha1.ClearDiskResponse
+	23, // 52: v2alpha1.Disk.Rescan:output_type -> v2alpha1.RescanResponse
+	27, // 53: v2alpha1.Disk.ListDiskIDs:output_type -> v2alpha1.ListDiskIDsResponse
+	29, // 54: v2alpha1.Disk.FindDisk:output_type -> v2alpha1.FindDiskResponse
+	31, // 55: v2alpha1.Disk.WaitForDisk:output_type -> v2alpha1.WaitForDiskResponse
+	33, // 56: v2alpha1.Disk.GetDiskStats:output_type -> v2alpha1.GetDiskStatsResponse
+	35, // 57: v2alpha1.Disk.SetDiskState:output_type -> v2alpha1.SetDiskStateResponse
+	37, // 58: v2alpha1.Disk.GetDiskState:output_type -> v2alpha1.GetDiskStateResponse
+	40, // 59: v2alpha1.Disk.SetDiskAttributes:output_type -> v2alpha1.SetDiskAttributesResponse
+	42, // 60: v2alpha1.Disk.GetDiskAttributes:output_type -> v2alpha1.GetDiskAttributesResponse
+	45, // 61: v2alpha1.Disk.ListDisks:output_type -> v2alpha1.ListDisksResponse
+	47, // 62: v2alpha1.Disk.GetDiskInfo:output_type -> v2alpha1.GetDiskInfoResponse
+	49, // 63: v2alpha1.Disk.WatchDisks:output_type -> v2alpha1.WatchDisksResponse
+	47, // [47:64] is the sub-list for method output_type
+	30, // [30:47] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
+}
+
+func init() { file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_init() }
+func file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_init() {
+	if File_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDiskLocationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskLocation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDiskLocationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PartitionDiskRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PartitionDiskResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPartitionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PartitionInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPartitionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePartitionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePartitionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearDiskRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearDiskResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RescanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RescanResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDiskIDsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskIDs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskIdentifier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDiskIDsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindDiskRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindDiskResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WaitForDiskRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WaitForDiskResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDiskStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDiskStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetDiskStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetDiskStateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDiskStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDiskStateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskAttributes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetDiskAttributesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetDiskAttributesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDiskAttributesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDiskAttributesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDisksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDisksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDiskInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDiskInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchDisksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchDisksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDesc,
+			NumEnums:      10,
+			NumMessages:   43,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_goTypes,
+		DependencyIndexes: file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_depIdxs,
+		EnumInfos:         file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_enumTypes,
+		MessageInfos:      file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes,
+	}.Build()
+	File_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto = out.File
+	file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDesc = nil
+	file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_goTypes = nil
+	file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// DiskClient is the client API for Disk service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DiskClient interface {
+	// ListDiskLocations returns locations <Adapter, Bus, Target, LUN ID> of all
+	// disk devices enumerated by the host.
+	ListDiskLocations(ctx context.Context, in *ListDiskLocationsRequest, opts ...grpc.CallOption) (*ListDiskLocationsResponse, error)
+	// PartitionDisk initializes a disk device with the requested partition style (if the disk
+	// has not been initialized already) and creates a partition (if the disk has not been
+	// partitioned already, or if append is set) and returns the partition number.
+	PartitionDisk(ctx context.Context, in *PartitionDiskRequest, opts ...grpc.CallOption) (*PartitionDiskResponse, error)
+	// ListPartitions returns the partitions of a disk.
+	ListPartitions(ctx context.Context, in *ListPartitionsRequest, opts ...grpc.CallOption) (*ListPartitionsResponse, error)
+	// DeletePartition deletes a partition of a disk and the data on it.
+	DeletePartition(ctx context.Context, in *DeletePartitionRequest, opts ...grpc.CallOption) (*DeletePartitionResponse, error)
+	// ClearDisk removes the partitions of a disk, optionally zeroes it entirely or at
+	// its beginning and end, and leaves it uninitialized (RAW). It streams its progress
+	// until the last response, whose stage is DONE.
+	ClearDisk(ctx context.Context, in *ClearDiskRequest, opts ...grpc.CallOption) (Disk_ClearDiskClient, error)
+	// Rescan refreshes the host's storage cache.
+	Rescan(ctx context.Context, in *RescanRequest, opts ...grpc.CallOption) (*RescanResponse, error)
+	// ListDiskIDs returns a map of DiskID objects where the key is the disk number.
+	ListDiskIDs(ctx context.Context, in *ListDiskIDsRequest, opts ...grpc.CallOption) (*ListDiskIDsResponse, error)
+	// FindDisk returns the number of the disk matching an identifier, a location or both.
+	FindDisk(ctx context.Context, in *FindDiskRequest, opts ...grpc.CallOption) (*FindDiskResponse, error)
+	// WaitForDisk rescans the host's storage and waits until a disk matching an identifier,
+	// a location or both is online, until the deadline of the call (2 minutes if it has none).
+	WaitForDisk(ctx context.Context, in *WaitForDiskRequest, opts ...grpc.CallOption) (*WaitForDiskResponse, error)
+	// GetDiskStats returns the stats of a disk (currently it returns the disk size).
+	GetDiskStats(ctx context.Context, in *GetDiskStatsRequest, opts ...grpc.CallOption) (*GetDiskStatsResponse, error)
+	// SetDiskState sets the offline/online state of a disk.
+	SetDiskState(ctx context.Context, in *SetDiskStateRequest, opts ...grpc.CallOption) (*SetDiskStateResponse, error)
+	// GetDiskState gets the offline/online state of a disk.
+	GetDiskState(ctx context.Context, in *GetDiskStateRequest, opts ...grpc.CallOption) (*GetDiskStateResponse, error)
+	// SetDiskAttributes sets the read only attribute of a disk and the SAN policy of the host,
+	// and returns the resulting attributes. Attributes already set as requested are left alone.
+	SetDiskAttributes(ctx context.Context, in *SetDiskAttributesRequest, opts ...grpc.CallOption) (*SetDiskAttributesResponse, error)
+	// GetDiskAttributes gets the read only attribute, the offline state and reason of a disk,
+	// and the SAN policy of the host.
+	GetDiskAttributes(ctx context.Context, in *GetDiskAttributesRequest, opts ...grpc.CallOption) (*GetDiskAttributesResponse, error)
+	// ListDisks returns the information of all disk devices enumerated by the host.
+	ListDisks(ctx context.Context, in *ListDisksRequest, opts ...grpc.CallOption) (*ListDisksResponse, error)
+	// GetDiskInfo returns the information of a disk.
+	GetDiskInfo(ctx context.Context, in *GetDiskInfoRequest, opts ...grpc.CallOption) (*GetDiskInfoResponse, error)
+	// WatchDisks streams the disks enumerated by the host followed by a SYNCED event,
+	// and then an event each time a disk is added, removed, brought online or offline,
+	// or resized, until the call is canceled. A client reconnecting after an error
+	// gets the current disks again before the events following them.
+	WatchDisks(ctx context.Context, in *WatchDisksRequest, opts ...grpc.CallOption) (Disk_WatchDisksClient, error)
+}
+
+type diskClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDiskClient(cc grpc.ClientConnInterface) DiskClient {
+	return &diskClient{cc}
+}
+
+func (c *diskClient) ListDiskLocations(ctx context.Context, in *ListDiskLocationsRequest, opts ...grpc.CallOption) (*ListDiskLocationsResponse, error) {
+	out := new(ListDiskLocationsResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Disk/ListDiskLocations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskClient) PartitionDisk(ctx context.Context, in *PartitionDiskRequest, opts ...grpc.CallOption) (*PartitionDiskResponse, error) {
+	out := new(PartitionDiskResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Disk/PartitionDisk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskClient) ListPartitions(ctx context.Context, in *ListPartitionsRequest, opts ...grpc.CallOption) (*ListPartitionsResponse, error) {
+	out := new(ListPartitionsResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Disk/ListPartitions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskClient) DeletePartition(ctx context.Context, in *DeletePartitionRequest, opts ...grpc.CallOption) (*DeletePartitionResponse, error) {
+	out := new(DeletePartitionResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Disk/DeletePartition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskClient) ClearDisk(ctx context.Context, in *ClearDiskRequest, opts ...grpc.CallOption) (Disk_ClearDiskClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Disk_serviceDesc.Streams[0], "/v2alpha1.Disk/ClearDisk", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &diskClearDiskClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Disk_ClearDiskClient interface {
+	Recv() (*ClearDiskResponse, error)
+	grpc.ClientStream
+}
+
+type diskClearDiskClient struct {
+	grpc.ClientStream
+}
+
+func (x *diskClearDiskClient) Recv() (*ClearDiskResponse, error) {
+	m := new(ClearDiskResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *diskClient) Rescan(ctx context.Context, in *RescanRequest, opts ...grpc.CallOption) (*RescanResponse, error) {
+	out := new(RescanResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Disk/Rescan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskClient) ListDiskIDs(ctx context.Context, in *ListDiskIDsRequest, opts ...grpc.CallOption) (*ListDiskIDsResponse, error) {
+	out := new(ListDiskIDsResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Disk/ListDiskIDs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskClient) FindDisk(ctx context.Context, in *FindDiskRequest, opts ...grpc.CallOption) (*FindDiskResponse, error) {
+	out := new(FindDiskResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Disk/FindDisk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskClient) WaitForDisk(ctx context.Context, in *WaitForDiskRequest, opts ...grpc.CallOption) (*WaitForDiskResponse, error) {
+	out := new(WaitForDiskResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Disk/WaitForDisk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskClient) GetDiskStats(ctx context.Context, in *GetDiskStatsRequest, opts ...grpc.CallOption) (*GetDiskStatsResponse, error) {
+	out := new(GetDiskStatsResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Disk/GetDiskStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskClient) SetDiskState(ctx context.Context, in *SetDiskStateRequest, opts ...grpc.CallOption) (*SetDiskStateResponse, error) {
+	out := new(SetDiskStateResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Disk/SetDiskState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskClient) GetDiskState(ctx context.Context, in *GetDiskStateRequest, opts ...grpc.CallOption) (*GetDiskStateResponse, error) {
+	out := new(GetDiskStateResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Disk/GetDiskState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskClient) SetDiskAttributes(ctx context.Context, in *SetDiskAttributesRequest, opts ...grpc.CallOption) (*SetDiskAttributesResponse, error) {
+	out := new(SetDiskAttributesResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Disk/SetDiskAttributes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskClient) GetDiskAttributes(ctx context.Context, in *GetDiskAttributesRequest, opts ...grpc.CallOption) (*GetDiskAttributesResponse, error) {
+	out := new(GetDiskAttributesResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Disk/GetDiskAttributes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskClient) ListDisks(ctx context.Context, in *ListDisksRequest, opts ...grpc.CallOption) (*ListDisksResponse, error) {
+	out := new(ListDisksResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Disk/ListDisks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskClient) GetDiskInfo(ctx context.Context, in *GetDiskInfoRequest, opts ...grpc.CallOption) (*GetDiskInfoResponse, error) {
+	out := new(GetDiskInfoResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Disk/GetDiskInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskClient) WatchDisks(ctx context.Context, in *WatchDisksRequest, opts ...grpc.CallOption) (Disk_WatchDisksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Disk_serviceDesc.Streams[1], "/v2alpha1.Disk/WatchDisks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &diskWatchDisksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Disk_WatchDisksClient interface {
+	Recv() (*WatchDisksResponse, error)
+	grpc.ClientStream
+}
+
+type diskWatchDisksClient struct {
+	grpc.ClientStream
+}
+
+func (x *diskWatchDisksClient) Recv() (*WatchDisksResponse, error) {
+	m := new(WatchDisksResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// DiskServer is the server API for Disk service.
+type DiskServer interface {
+	// ListDiskLocations returns locations <Adapter, Bus, Target, LUN ID> of all
+	// disk devices enumerated by the host.
+	ListDiskLocations(context.Context, *ListDiskLocationsRequest) (*ListDiskLocationsResponse, error)
+	// PartitionDisk initializes a disk device with the requested partition style (if the disk
+	// has not been initialized already) and creates a partition (if the disk has not been
+	// partitioned already, or if append is set) and returns the partition number.
+	PartitionDisk(context.Context, *PartitionDiskRequest) (*PartitionDiskResponse, error)
+	// ListPartitions returns the partitions of a disk.
+	ListPartitions(context.Context, *ListPartitionsRequest) (*ListPartitionsResponse, error)
+	// DeletePartition deletes a partition of a disk and the data on it.
+	DeletePartition(context.Context, *DeletePartitionRequest) (*DeletePartitionResponse, error)
+	// ClearDisk removes the partitions of a disk, optionally zeroes it entirely or at
+	// its beginning and end, and leaves it uninitialized (RAW). It streams its progress
+	// until the last response, whose stage is DONE.
+	ClearDisk(*ClearDiskRequest, Disk_ClearDiskServer) error
+	// Rescan refreshes the host's storage cache.
+	Rescan(context.Context, *RescanRequest) (*RescanResponse, error)
+	// ListDiskIDs returns a map of DiskID objects where the key is the disk number.
+	ListDiskIDs(context.Context, *ListDiskIDsRequest) (*ListDiskIDsResponse, error)
+	// FindDisk returns the number of the disk matching an identifier, a location or both.
+	FindDisk(context.Context, *FindDiskRequest) (*FindDiskResponse, error)
+	// WaitForDisk rescans the host's storage and waits until a disk matching an identifier,
+	// a location or both is online, until the deadline of the call (2 minutes if it has none).
+	WaitForDisk(context.Context, *WaitForDiskRequest) (*WaitForDiskResponse, error)
+	// GetDiskStats returns the stats of a disk (currently it returns the disk size).
+	GetDiskStats(context.Context, *GetDiskStatsRequest) (*GetDiskStatsResponse, error)
+	// SetDiskState sets the offline/online state of a disk.
+	SetDiskState(context.Context, *SetDiskStateRequest) (*SetDiskStateResponse, error)
+	// GetDiskState gets the offline/online state of a disk.
+	GetDiskState(context.Context, *GetDiskStateRequest) (*GetDiskStateResponse, error)
+	// SetDiskAttributes sets the read only attribute of a disk and the SAN policy of the host,
+	// and returns the resulting attributes. Attributes already set as requested are left alone.
+	SetDiskAttributes(context.Context, *SetDiskAttributesRequest) (*SetDiskAttributesResponse, error)
+	// GetDiskAttributes gets the read only attribute, the offline state and reason of a disk,
+	// and the SAN policy of the host.
+	GetDiskAttributes(context.Context, *GetDiskAttributesRequest) (*GetDiskAttributesResponse, error)
+	// ListDisks returns the information of all disk devices enumerated by the host.
+	ListDisks(context.Context, *ListDisksRequest) (*ListDisksResponse, error)
+	// GetDiskInfo returns the information of a disk.
+	GetDiskInfo(context.Context, *GetDiskInfoRequest) (*GetDiskInfoResponse, error)
+	// WatchDisks streams the disks enumerated by the host followed by a SYNCED event,
+	// and then an event each time a disk is added, removed, brought online or offline,
+	// or resized, until the call is canceled. A client reconnecting after an error
+	// gets the current disks again before the events following them.
+	WatchDisks(*WatchDisksRequest, Disk_WatchDisksServer) error
+}
+
+// UnimplementedDiskServer can be embedded to have forward compatible implementations.
+type UnimplementedDiskServer struct {
+}
+
+func (*UnimplementedDiskServer) ListDiskLocations(context.Context, *ListDiskLocationsRequest) (*ListDiskLocationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDiskLocations not implemented")
+}
+func (*UnimplementedDiskServer) PartitionDisk(context.Context, *PartitionDiskRequest) (*PartitionDiskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PartitionDisk not implemented")
+}
+func (*UnimplementedDiskServer) ListPartitions(context.Context, *ListPartitionsRequest) (*ListPartitionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPartitions not implemented")
+}
+func (*UnimplementedDiskServer) DeletePartition(context.Context, *DeletePartitionRequest) (*DeletePartitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePartition not implemented")
+}
+func (*UnimplementedDiskServer) ClearDisk(*ClearDiskRequest, Disk_ClearDiskServer) error {
+	return status.Errorf(codes.Unimplemented, "method ClearDisk not implemented")
+}
+func (*UnimplementedDiskServer) Rescan(context.Context, *RescanRequest) (*RescanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rescan not implemented")
+}
+func (*UnimplementedDiskServer) ListDiskIDs(context.Context, *ListDiskIDsRequest) (*ListDiskIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDiskIDs not implemented")
+}
+func (*UnimplementedDiskServer) FindDisk(context.Context, *FindDiskRequest) (*FindDiskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindDisk not implemented")
+}
+func (*UnimplementedDiskServer) WaitForDisk(context.Context, *WaitForDiskRequest) (*WaitForDiskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WaitForDisk not implemented")
+}
+func (*UnimplementedDiskServer) GetDiskStats(context.Context, *GetDiskStatsRequest) (*GetDiskStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDiskStats not implemented")
+}
+func (*UnimplementedDiskServer) SetDiskState(context.Context, *SetDiskStateRequest) (*SetDiskStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDiskState not implemented")
+}
+func (*UnimplementedDiskServer) GetDiskState(context.Context, *GetDiskStateRequest) (*GetDiskStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDiskState not implemented")
+}
+func (*UnimplementedDiskServer) SetDiskAttributes(context.Context, *SetDiskAttributesRequest) (*SetDiskAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDiskAttributes not implemented")
+}
+func (*UnimplementedDiskServer) GetDiskAttributes(context.Context, *GetDiskAttributesRequest) (*GetDiskAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDiskAttributes not implemented")
+}
+func (*UnimplementedDiskServer) ListDisks(context.Context, *ListDisksRequest) (*ListDisksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDisks not implemented")
+}
+func (*UnimplementedDiskServer) GetDiskInfo(context.Context, *GetDiskInfoRequest) (*GetDiskInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDiskInfo not implemented")
+}
+func (*UnimplementedDiskServer) WatchDisks(*WatchDisksRequest, Disk_WatchDisksServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchDisks not implemented")
+}
+
+func RegisterDiskServer(s *grpc.Server, srv DiskServer) {
+	s.RegisterService(&_Disk_serviceDesc, srv)
+}
+
+func _Disk_ListDiskLocations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDiskLocationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServer).ListDiskLocations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Disk/ListDiskLocations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServer).ListDiskLocations(ctx, req.(*ListDiskLocationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Disk_PartitionDisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PartitionDiskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServer).PartitionDisk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Disk/PartitionDisk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServer).PartitionDisk(ctx, req.(*PartitionDiskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Disk_ListPartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPartitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServer).ListPartitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Disk/ListPartitions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServer).ListPartitions(ctx, req.(*ListPartitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Disk_DeletePartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePartitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServer).DeletePartition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Disk/DeletePartition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServer).DeletePartition(ctx, req.(*DeletePartitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Disk_ClearDisk_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ClearDiskRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DiskServer).ClearDisk(m, &diskClearDiskServer{stream})
+}
+
+type Disk_ClearDiskServer interface {
+	Send(*ClearDiskResponse) error
+	grpc.ServerStream
+}
+
+type diskClearDiskServer struct {
+	grpc.ServerStream
+}
+
+func (x *diskClearDiskServer) Send(m *ClearDiskResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Disk_Rescan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RescanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServer).Rescan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Disk/Rescan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServer).Rescan(ctx, req.(*RescanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Disk_ListDiskIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDiskIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServer).ListDiskIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Disk/ListDiskIDs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServer).ListDiskIDs(ctx, req.(*ListDiskIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Disk_FindDisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindDiskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServer).FindDisk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Disk/FindDisk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServer).FindDisk(ctx, req.(*FindDiskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Disk_WaitForDisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitForDiskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServer).WaitForDisk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Disk/WaitForDisk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServer).WaitForDisk(ctx, req.(*WaitForDiskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Disk_GetDiskStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDiskStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServer).GetDiskStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Disk/GetDiskStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServer).GetDiskStats(ctx, req.(*GetDiskStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Disk_SetDiskState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDiskStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServer).SetDiskState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Disk/SetDiskState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServer).SetDiskState(ctx, req.(*SetDiskStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Disk_GetDiskState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDiskStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServer).GetDiskState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Disk/GetDiskState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServer).GetDiskState(ctx, req.(*GetDiskStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Disk_SetDiskAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDiskAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServer).SetDiskAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Disk/SetDiskAttributes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServer).SetDiskAttributes(ctx, req.(*SetDiskAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Disk_GetDiskAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDiskAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServer).GetDiskAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Disk/GetDiskAttributes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServer).GetDiskAttributes(ctx, req.(*GetDiskAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Disk_ListDisks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDisksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServer).ListDisks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Disk/ListDisks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServer).ListDisks(ctx, req.(*ListDisksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Disk_GetDiskInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDiskInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServer).GetDiskInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Disk/GetDiskInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServer).GetDiskInfo(ctx, req.(*GetDiskInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Disk_WatchDisks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchDisksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DiskServer).WatchDisks(m, &diskWatchDisksServer{stream})
+}
+
+type Disk_WatchDisksServer interface {
+	Send(*WatchDisksResponse) error
+	grpc.ServerStream
+}
+
+type diskWatchDisksServer struct {
+	grpc.ServerStream
+}
+
+func (x *diskWatchDisksServer) Send(m *WatchDisksResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Disk_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "v2alpha1.Disk",
+	HandlerType: (*DiskServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListDiskLocations",
+			Handler:    _Disk_ListDiskLocations_Handler,
+		},
+		{
+			MethodName: "PartitionDisk",
+			Handler:    _Disk_PartitionDisk_Handler,
+		},
+		{
+			MethodName: "ListPartitions",
+			Handler:    _Disk_ListPartitions_Handler,
+		},
+		{
+			MethodName: "DeletePartition",
+			Handler:    _Disk_DeletePartition_Handler,
+		},
+		{
+			MethodName: "Rescan",
+			Handler:    _Disk_Rescan_Handler,
+		},
+		{
+			MethodName: "ListDiskIDs",
+			Handler:    _Disk_ListDiskIDs_Handler,
+		},
+		{
+			MethodName: "FindDisk",
+			Handler:    _Disk_FindDisk_Handler,
+		},
+		{
+			MethodName: "WaitForDisk",
+			Handler:    _Disk_WaitForDisk_Handler,
+		},
+		{
+			MethodName: "GetDiskStats",
+			Handler:    _Disk_GetDiskStats_Handler,
+		},
+		{
+			MethodName: "SetDiskState",
+			Handler:    _Disk_SetDiskState_Handler,
+		},
+		{
+			MethodName: "GetDiskState",
+			Handler:    _Disk_GetDiskState_Handler,
+		},
+		{
+			MethodName: "SetDiskAttributes",
+			Handler:    _Disk_SetDiskAttributes_Handler,
+		},
+		{
+			MethodName: "GetDiskAttributes",
+			Handler:    _Disk_GetDiskAttributes_Handler,
+		},
+		{
+			MethodName: "ListDisks",
+			Handler:    _Disk_ListDisks_Handler,
+		},
+		{
+			MethodName: "GetDiskInfo",
+			Handler:    _Disk_GetDiskInfo_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ClearDisk",
+			Handler:       _Disk_ClearDisk_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchDisks",
+			Handler:       _Disk_WatchDisks_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "github.com/kubernetes-csi/csi-proxy/client/api/disk/v2alpha1/api.proto",
+}
@@ -0,0 +1,1423 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.12.4
+// source: github.com/kubernetes-csi/csi-proxy/client/api/encryption/v1alpha1/api.proto
+
+package v1alpha1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// KeyProtectorType is the type of a key protector supplied with a secret.
+type KeyProtectorType int32
+
+const (
+	// 48-digit numerical recovery password, e.g. 123456-123456-..., in 8 groups of 6 digits.
+	KeyProtectorType_KEY_PROTECTOR_TYPE_RECOVERY_PASSWORD KeyProtectorType = 0
+	// Password (passphrase) of at least 8 characters.
+	KeyProtectorType_KEY_PROTECTOR_TYPE_PASSWORD KeyProtectorType = 1
+)
+
+// Enum value maps for KeyProtectorType.
+var (
+	KeyProtectorType_name = map[int32]string{
+		0: "KEY_PROTECTOR_TYPE_RECOVERY_PASSWORD",
+		1: "KEY_PROTECTOR_TYPE_PASSWORD",
+	}
+	KeyProtectorType_value = map[string]int32{
+		"KEY_PROTECTOR_TYPE_RECOVERY_PASSWORD": 0,
+		"KEY_PROTECTOR_TYPE_PASSWORD":          1,
+	}
+)
+
+func (x KeyProtectorType) Enum() *KeyProtectorType {
+	p := new(KeyProtectorType)
+	*p = x
+	return p
+}
+
+func (x KeyProtectorType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (KeyProtectorType) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_enumTypes[0].Descriptor()
+}
+
+func (KeyProtectorType) Type() protoreflect.EnumType {
+	return &file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_enumTypes[0]
+}
+
+func (x KeyProtectorType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use KeyProtectorType.Descriptor instead.
+func (KeyProtectorType) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_rawDescGZIP(), []int{0}
+}
+
+// EncryptionMethod is the encryption algorithm and key size of a volume.
+type EncryptionMethod int32
+
+const (
+	// Unspecified: XTS-AES 128 when enabling the encryption, unknown in a status.
+	EncryptionMethod_ENCRYPTION_METHOD_UNSPECIFIED EncryptionMethod = 0
+	// The volume isn't encrypted.
+	EncryptionMethod_ENCRYPTION_METHOD_NONE                  EncryptionMethod = 1
+	EncryptionMethod_ENCRYPTION_METHOD_AES_128_WITH_DIFFUSER EncryptionMethod = 2
+	EncryptionMethod_ENCRYPTION_METHOD_AES_256_WITH_DIFFUSER EncryptionMethod = 3
+	EncryptionMethod_ENCRYPTION_METHOD_AES_128               EncryptionMethod = 4
+	EncryptionMethod_ENCRYPTION_METHOD_AES_256               EncryptionMethod = 5
+	// The volume is encrypted by the drive itself.
+	EncryptionMethod_ENCRYPTION_METHOD_HARDWARE    EncryptionMethod = 6
+	EncryptionMethod_ENCRYPTION_METHOD_XTS_AES_128 EncryptionMethod = 7
+	EncryptionMethod_ENCRYPTION_METHOD_XTS_AES_256 EncryptionMethod = 8
+)
+
+// Enum value maps for EncryptionMethod.
+var (
+	EncryptionMethod_name = map[int32]string{
+		0: "ENCRYPTION_METHOD_UNSPECIFIED",
+		1: "ENCRYPTION_METHOD_NONE",
+		2: "ENCRYPTION_METHOD_AES_128_WITH_DIFFUSER",
+		3: "ENCRYPTION_METHOD_AES_256_WITH_DIFFUSER",
+		4: "ENCRYPTION_METHOD_AES_128",
+		5: "ENCRYPTION_METHOD_AES_256",
+		6: "ENCRYPTION_METHOD_HARDWARE",
+		7: "ENCRYPTION_METHOD_XTS_AES_128",
+		8: "ENCRYPTION_METHOD_XTS_AES_256",
+	}
+	EncryptionMethod_value = map[string]int32{
+		"ENCRYPTION_METHOD_UNSPECIFIED":           0,
+		"ENCRYPTION_METHOD_NONE":                  1,
+		"ENCRYPTION_METHOD_AES_128_WITH_DIFFUSER": 2,
+		"ENCRYPTION_METHOD_AES_256_WITH_DIFFUSER": 3,
+		"ENCRYPTION_METHOD_AES_128":               4,
+		"ENCRYPTION_METHOD_AES_256":               5,
+		"ENCRYPTION_METHOD_HARDWARE":              6,
+		"ENCRYPTION_METHOD_XTS_AES_128":           7,
+		"ENCRYPTION_METHOD_XTS_AES_256":           8,
+	}
+)
+
+func (x EncryptionMethod) Enum() *EncryptionMethod {
+	p := new(EncryptionMethod)
+	*p = x
+	return p
+}
+
+func (x EncryptionMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EncryptionMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_enumTypes[1].Descriptor()
+}
+
+func (EncryptionMethod) Type() protoreflect.EnumType {
+	return &file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_enumTypes[1]
+}
+
+func (x EncryptionMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EncryptionMethod.Descriptor instead.
+func (EncryptionMethod) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_rawDescGZIP(), []int{1}
+}
+
+// ConversionStatus is the state of the encryption of a volume.
+type ConversionStatus int32
+
+const (
+	// Unknown, e.g. the volume is locked.
+	ConversionStatus_CONVERSION_STATUS_UNKNOWN                ConversionStatus = 0
+	ConversionStatus_CONVERSION_STATUS_FULLY_DECRYPTED        ConversionStatus = 1
+	ConversionStatus_CONVERSION_STATUS_FULLY_ENCRYPTED        ConversionStatus = 2
+	ConversionStatus_CONVERSION_STATUS_ENCRYPTION_IN_PROGRESS ConversionStatus = 3
+	ConversionStatus_CONVERSION_STATUS_DECRYPTION_IN_PROGRESS ConversionStatus = 4
+	ConversionStatus_CONVERSION_STATUS_ENCRYPTION_PAUSED      ConversionStatus = 5
+	ConversionStatus_CONVERSION_STATUS_DECRYPTION_PAUSED      ConversionStatus = 6
+)
+
+// Enum value maps for ConversionStatus.
+var (
+	ConversionStatus_name = map[int32]string{
+		0: "CONVERSION_STATUS_UNKNOWN",
+		1: "CONVERSION_STATUS_FULLY_DECRYPTED",
+		2: "CONVERSION_STATUS_FULLY_ENCRYPTED",
+		3: "CONVERSION_STATUS_ENCRYPTION_IN_PROGRESS",
+		4: "CONVERSION_STATUS_DECRYPTION_IN_PROGRESS",
+		5: "CONVERSION_STATUS_ENCRYPTION_PAUSED",
+		6: "CONVERSION_STATUS_DECRYPTION_PAUSED",
+	}
+	ConversionStatus_value = map[string]int32{
+		"CONVERSION_STATUS_UNKNOWN":                0,
+		"CONVERSION_STATUS_FULLY_DECRYPTED":        1,
+		"CONVERSION_STATUS_FULLY_ENCRYPTED":        2,
+		"CONVERSION_STATUS_ENCRYPTION_IN_PROGRESS": 3,
+		"CONVERSION_STATUS_DECRYPTION_IN_PROGRESS": 4,
+		"CONVERSION_STATUS_ENCRYPTION_PAUSED":      5,
+		"CONVERSION_STATUS_DECRYPTION_PAUSED":      6,
+	}
+)
+
+func (x ConversionStatus) Enum() *ConversionStatus {
+	p := new(ConversionStatus)
+	*p = x
+	return p
+}
+
+func (x ConversionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConversionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_enumTypes[2].Descriptor()
+}
+
+func (ConversionStatus) Type() protoreflect.EnumType {
+	return &file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_enumTypes[2]
+}
+
+func (x ConversionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConversionStatus.Descriptor instead.
+func (ConversionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_rawDescGZIP(), []int{2}
+}
+
+// ProtectionStatus is whether the volume's encryption key is protected by its key protectors.
+type ProtectionStatus int32
+
+const (
+	// Unknown, e.g. the volume is locked.
+	ProtectionStatus_PROTECTION_STATUS_UNKNOWN ProtectionStatus = 0
+	// The encryption key is stored in clear on the volume, e.g. the volume is fully decrypted.
+	ProtectionStatus_PROTECTION_STATUS_OFF ProtectionStatus = 1
+	ProtectionStatus_PROTECTION_STATUS_ON  ProtectionStatus = 2
+)
+
+// Enum value maps for ProtectionStatus.
+var (
+	ProtectionStatus_name = map[int32]string{
+		0: "PROTECTION_STATUS_UNKNOWN",
+		1: "PROTECTION_STATUS_OFF",
+		2: "PROTECTION_STATUS_ON",
+	}
+	ProtectionStatus_value = map[string]int32{
+		"PROTECTION_STATUS_UNKNOWN": 0,
+		"PROTECTION_STATUS_OFF":     1,
+		"PROTECTION_STATUS_ON":      2,
+	}
+)
+
+func (x ProtectionStatus) Enum() *ProtectionStatus {
+	p := new(ProtectionStatus)
+	*p = x
+	return p
+}
+
+func (x ProtectionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProtectionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_enumTypes[3].Descriptor()
+}
+
+func (ProtectionStatus) Type() protoreflect.EnumType {
+	return &file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_enumTypes[3]
+}
+
+func (x ProtectionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProtectionStatus.Descriptor instead.
+func (ProtectionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_rawDescGZIP(), []int{3}
+}
+
+type EnableEncryptionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Volume device ID of the volume to encrypt, e.g. \\?\Volume{...}\.
+	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	// Type of the key protector to add.
+	KeyProtectorType KeyProtectorType `protobuf:"varint,2,opt,name=key_protector_type,json=keyProtectorType,proto3,enum=v1alpha1.KeyProtectorType" json:"key_protector_type,omitempty"`
+	// Recovery password or password of the key protector, e.g. from a CSI secret.
+	// It is never logged.
+	Secret string `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Encryption method, one of AES_128, AES_256, XTS_AES_128 or XTS_AES_256.
+	EncryptionMethod EncryptionMethod `protobuf:"varint,4,opt,name=encryption_method,json=encryptionMethod,proto3,enum=v1alpha1.EncryptionMethod" json:"encryption_method,omitempty"`
+	// Encrypt only the space used by the data, instead of the whole volume.
+	UsedSpaceOnly bool `protobuf:"varint,5,opt,name=used_space_only,json=usedSpaceOnly,proto3" json:"used_space_only,omitempty"`
+}
+
+func (x *EnableEncryptionRequest) Reset() {
+	*x = EnableEncryptionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnableEncryptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableEncryptionRequest) ProtoMessage() {}
+
+func (x *EnableEncryptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableEncryptionRequest.ProtoReflect.Descriptor instead.
+func (*EnableEncryptionRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EnableEncryptionRequest) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+func (x *EnableEncryptionRequest) GetKeyProtectorType() KeyProtectorType {
+	if x != nil {
+		return x.KeyProtectorType
+	}
+	return KeyProtectorType_KEY_PROTECTOR_TYPE_RECOVERY_PASSWORD
+}
+
+func (x *EnableEncryptionRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *EnableEncryptionRequest) GetEncryptionMethod() EncryptionMethod {
+	if x != nil {
+		return x.EncryptionMethod
+	}
+	return EncryptionMethod_ENCRYPTION_METHOD_UNSPECIFIED
+}
+
+func (x *EnableEncryptionRequest) GetUsedSpaceOnly() bool {
+	if x != nil {
+		return x.UsedSpaceOnly
+	}
+	return false
+}
+
+type EnableEncryptionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the added key protector, e.g. {2a3b...}, empty if the volume wasn't fully decrypted.
+	KeyProtectorId string `protobuf:"bytes,1,opt,name=key_protector_id,json=keyProtectorId,proto3" json:"key_protector_id,omitempty"`
+}
+
+func (x *EnableEncryptionResponse) Reset() {
+	*x = EnableEncryptionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnableEncryptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableEncryptionResponse) ProtoMessage() {}
+
+func (x *EnableEncryptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableEncryptionResponse.ProtoReflect.Descriptor instead.
+func (*EnableEncryptionResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EnableEncryptionResponse) GetKeyProtectorId() string {
+	if x != nil {
+		return x.KeyProtectorId
+	}
+	return ""
+}
+
+type UnlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Volume device ID of the volume to unlock.
+	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	// Type of the key protector of the secret.
+	KeyProtectorType KeyProtectorType `protobuf:"varint,2,opt,name=key_protector_type,json=keyProtectorType,proto3,enum=v1alpha1.KeyProtectorType" json:"key_protector_type,omitempty"`
+	// Recovery password or password of one of the volume's key protectors.
+	// It is never logged.
+	Secret string `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+}
+
+func (x *UnlockRequest) Reset() {
+	*x = UnlockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockRequest) ProtoMessage() {}
+
+func (x *UnlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockRequest.ProtoReflect.Descriptor instead.
+func (*UnlockRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UnlockRequest) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+func (x *UnlockRequest) GetKeyProtectorType() KeyProtectorType {
+	if x != nil {
+		return x.KeyProtectorType
+	}
+	return KeyProtectorType_KEY_PROTECTOR_TYPE_RECOVERY_PASSWORD
+}
+
+func (x *UnlockRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type UnlockResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnlockResponse) Reset() {
+	*x = UnlockResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockResponse) ProtoMessage() {}
+
+func (x *UnlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockResponse.ProtoReflect.Descriptor instead.
+func (*UnlockResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_rawDescGZIP(), []int{3}
+}
+
+type LockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Volume device ID of the volume to lock.
+	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	// Dismount the volume even if it is in use, the open handles to the volume become invalid.
+	ForceDismount bool `protobuf:"varint,2,opt,name=force_dismount,json=forceDismount,proto3" json:"force_dismount,omitempty"`
+}
+
+func (x *LockRequest) Reset() {
+	*x = LockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockRequest) ProtoMessage() {}
+
+func (x *LockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockRequest.ProtoReflect.Descriptor instead.
+func (*LockRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LockRequest) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+func (x *LockRequest) GetForceDismount() bool {
+	if x != nil {
+		return x.ForceDismount
+	}
+	return false
+}
+
+type LockResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LockResponse) Reset() {
+	*x = LockResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockResponse) ProtoMessage() {}
+
+func (x *LockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockResponse.ProtoReflect.Descriptor instead.
+func (*LockResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_rawDescGZIP(), []int{5}
+}
+
+type GetEncryptionStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Volume device ID of the volume.
+	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+}
+
+func (x *GetEncryptionStatusRequest) Reset() {
+	*x = GetEncryptionStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEncryptionStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEncryptionStatusRequest) ProtoMessage() {}
+
+func (x *GetEncryptionStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEncryptionStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetEncryptionStatusRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetEncryptionStatusRequest) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+type GetEncryptionStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// State of the encryption of the volume.
+	ConversionStatus ConversionStatus `protobuf:"varint,1,opt,name=conversion_status,json=conversionStatus,proto3,enum=v1alpha1.ConversionStatus" json:"conversion_status,omitempty"`
+	// Percentage of the volume which is encrypted, from 0 to 100.
+	EncryptionPercentage uint32 `protobuf:"varint,2,opt,name=encryption_percentage,json=encryptionPercentage,proto3" json:"encryption_percentage,omitempty"`
+	// Whether the encryption key is protected.
+	ProtectionStatus ProtectionStatus `protobuf:"varint,3,opt,name=protection_status,json=protectionStatus,proto3,enum=v1alpha1.ProtectionStatus" json:"protection_status,omitempty"`
+	// Whether the volume is locked.
+	Locked bool `protobuf:"varint,4,opt,name=locked,proto3" json:"locked,omitempty"`
+	// Encryption method of the volume.
+	EncryptionMethod EncryptionMethod `protobuf:"varint,5,opt,name=encryption_method,json=encryptionMethod,proto3,enum=v1alpha1.EncryptionMethod" json:"encryption_method,omitempty"`
+}
+
+func (x *GetEncryptionStatusResponse) Reset() {
+	*x = GetEncryptionStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEncryptionStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEncryptionStatusResponse) ProtoMessage() {}
+
+func (x *GetEncryptionStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEncryptionStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetEncryptionStatusResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetEncryptionStatusResponse) GetConversionStatus() ConversionStatus {
+	if x != nil {
+		return x.ConversionStatus
+	}
+	return ConversionStatus_CONVERSION_STATUS_UNKNOWN
+}
+
+func (x *GetEncryptionStatusResponse) GetEncryptionPercentage() uint32 {
+	if x != nil {
+		return x.EncryptionPercentage
+	}
+	return 0
+}
+
+func (x *GetEncryptionStatusResponse) GetProtectionStatus() ProtectionStatus {
+	if x != nil {
+		return x.ProtectionStatus
+	}
+	return ProtectionStatus_PROTECTION_STATUS_UNKNOWN
+}
+
+func (x *GetEncryptionStatusResponse) GetLocked() bool {
+	if x != nil {
+		return x.Locked
+	}
+	return false
+}
+
+func (x *GetEncryptionStatusResponse) GetEncryptionMethod() EncryptionMethod {
+	if x != nil {
+		return x.EncryptionMethod
+	}
+	return EncryptionMethod_ENCRYPTION_METHOD_UNSPECIFIED
+}
+
+type DisableEncryptionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Volume device ID of the volume to decrypt.
+	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+}
+
+func (x *DisableEncryptionRequest) Reset() {
+	*x = DisableEncryptionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisableEncryptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableEncryptionRequest) ProtoMessage() {}
+
+func (x *DisableEncryptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableEncryptionRequest.ProtoReflect.Descriptor instead.
+func (*DisableEncryptionRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DisableEncryptionRequest) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+type DisableEncryptionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DisableEncryptionResponse) Reset() {
+	*x = DisableEncryptionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisableEncryptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableEncryptionResponse) ProtoMessage() {}
+
+func (x *DisableEncryptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableEncryptionResponse.ProtoReflect.Descriptor instead.
+func (*DisableEncryptionResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_rawDescGZIP(), []int{9}
+}
+
+var File_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto protoreflect.FileDescriptor
+
+var file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_rawDesc = []byte{
+	0x0a, 0x4c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x62,
+	0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x2d, 0x63, 0x73, 0x69, 0x2f, 0x63, 0x73, 0x69, 0x2d,
+	0x70, 0x72, 0x6f, 0x78, 0x79, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2f, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x22, 0x89, 0x02, 0x0a, 0x17, 0x45, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49,
+	0x64, 0x12, 0x48, 0x0a, 0x12, 0x6b, 0x65, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x50, 0x72, 0x6f, 0x74,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x10, 0x6b, 0x65, 0x79, 0x50, 0x72,
+	0x6f, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x12, 0x47, 0x0a, 0x11, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x10, 0x65, 0x6e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x26, 0x0a, 0x0f,
+	0x75, 0x73, 0x65, 0x64, 0x5f, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x75, 0x73, 0x65, 0x64, 0x53, 0x70, 0x61, 0x63, 0x65,
+	0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x44, 0x0a, 0x18, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x28, 0x0a, 0x10, 0x6b, 0x65, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6b, 0x65, 0x79, 0x50,
+	0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x8e, 0x01, 0x0a, 0x0d, 0x55,
+	0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x48, 0x0a, 0x12, 0x6b, 0x65, 0x79,
+	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x4b, 0x65, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x10, 0x6b, 0x65, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x10, 0x0a, 0x0e, 0x55,
+	0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x0a,
+	0x0b, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x6f, 0x72,
+	0x63, 0x65, 0x5f, 0x64, 0x69, 0x73, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0d, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x44, 0x69, 0x73, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x0e, 0x0a, 0x0c, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x39, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x22, 0xc5, 0x02, 0x0a, 0x1b,
+	0x47, 0x65, 0x74, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x11, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x33, 0x0a, 0x15, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x14, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x47, 0x0a, 0x11, 0x70, 0x72, 0x6f,
+	0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x47, 0x0a, 0x11, 0x65, 0x6e,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x52, 0x10, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x22, 0x37, 0x0a, 0x18, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x1b, 0x0a, 0x19,
+	0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x5d, 0x0a, 0x10, 0x4b, 0x65, 0x79,
+	0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a,
+	0x24, 0x4b, 0x45, 0x59, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x50, 0x41, 0x53,
+	0x53, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x4b, 0x45, 0x59, 0x5f, 0x50,
+	0x52, 0x4f, 0x54, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x41,
+	0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x01, 0x2a, 0xcf, 0x02, 0x0a, 0x10, 0x45, 0x6e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x21, 0x0a,
+	0x1d, 0x45, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x54, 0x48,
+	0x4f, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x1a, 0x0a, 0x16, 0x45, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d,
+	0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x2b, 0x0a, 0x27,
+	0x45, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f,
+	0x44, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x44,
+	0x49, 0x46, 0x46, 0x55, 0x53, 0x45, 0x52, 0x10, 0x02, 0x12, 0x2b, 0x0a, 0x27, 0x45, 0x4e, 0x43,
+	0x52, 0x59, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x41,
+	0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x44, 0x49, 0x46, 0x46,
+	0x55, 0x53, 0x45, 0x52, 0x10, 0x03, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x4e, 0x43, 0x52, 0x59, 0x50,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x41, 0x45, 0x53, 0x5f,
+	0x31, 0x32, 0x38, 0x10, 0x04, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32,
+	0x35, 0x36, 0x10, 0x05, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x48, 0x41, 0x52, 0x44, 0x57, 0x41,
+	0x52, 0x45, 0x10, 0x06, 0x12, 0x21, 0x0a, 0x1d, 0x45, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x58, 0x54, 0x53, 0x5f, 0x41, 0x45,
+	0x53, 0x5f, 0x31, 0x32, 0x38, 0x10, 0x07, 0x12, 0x21, 0x0a, 0x1d, 0x45, 0x4e, 0x43, 0x52, 0x59,
+	0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x58, 0x54, 0x53,
+	0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x10, 0x08, 0x2a, 0xad, 0x02, 0x0a, 0x10, 0x43,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1d, 0x0a, 0x19, 0x43, 0x4f, 0x4e, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x25,
+	0x0a, 0x21, 0x43, 0x4f, 0x4e, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x59, 0x5f, 0x44, 0x45, 0x43, 0x52, 0x59, 0x50,
+	0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x25, 0x0a, 0x21, 0x43, 0x4f, 0x4e, 0x56, 0x45, 0x52, 0x53,
+	0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x59,
+	0x5f, 0x45, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x2c, 0x0a, 0x28,
+	0x43, 0x4f, 0x4e, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x45, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x5f,
+	0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x03, 0x12, 0x2c, 0x0a, 0x28, 0x43, 0x4f,
+	0x4e, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x44, 0x45, 0x43, 0x52, 0x59, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x5f, 0x50, 0x52,
+	0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x04, 0x12, 0x27, 0x0a, 0x23, 0x43, 0x4f, 0x4e, 0x56,
+	0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x4e,
+	0x43, 0x52, 0x59, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x41, 0x55, 0x53, 0x45, 0x44, 0x10,
+	0x05, 0x12, 0x27, 0x0a, 0x23, 0x43, 0x4f, 0x4e, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x45, 0x43, 0x52, 0x59, 0x50, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x50, 0x41, 0x55, 0x53, 0x45, 0x44, 0x10, 0x06, 0x2a, 0x66, 0x0a, 0x10, 0x50, 0x72,
+	0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d,
+	0x0a, 0x19, 0x50, 0x52, 0x4f, 0x54, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x19, 0x0a,
+	0x15, 0x50, 0x52, 0x4f, 0x54, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x4f, 0x46, 0x46, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x52, 0x4f, 0x54,
+	0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4f, 0x4e,
+	0x10, 0x02, 0x32, 0xa7, 0x03, 0x0a, 0x0a, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x5b, 0x0a, 0x10, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d,
+	0x0a, 0x06, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x17, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x18, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x55, 0x6e, 0x6c,
+	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a,
+	0x04, 0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x24, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11,
+	0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x22, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x44, 0x5a, 0x42,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x62, 0x65, 0x72,
+	0x6e, 0x65, 0x74, 0x65, 0x73, 0x2d, 0x63, 0x73, 0x69, 0x2f, 0x63, 0x73, 0x69, 0x2d, 0x70, 0x72,
+	0x6f, 0x78, 0x79, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65,
+	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_rawDescOnce sync.Once
+	file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_rawDescData = file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_rawDesc
+)
+
+func file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_rawDescGZIP() []byte {
+	file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_rawDescOnce.Do(func() {
+		file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_rawDescData)
+	})
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_rawDescData
+}
+
+var file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_goTypes = []interface{}{
+	(KeyProtectorType)(0),               // 0: v1alpha1.KeyProtectorType
+	(EncryptionMethod)(0),               // 1: v1alpha1.EncryptionMethod
+	(ConversionStatus)(0),               // 2: v1alpha1.ConversionStatus
+	(ProtectionStatus)(0),               // 3: v1alpha1.ProtectionStatus
+	(*EnableEncryptionRequest)(nil),     // 4: v1alpha1.EnableEncryptionRequest
+	(*EnableEncryptionResponse)(nil),    // 5: v1alpha1.EnableEncryptionResponse
+	(*UnlockRequest)(nil),               // 6: v1alpha1.UnlockRequest
+	(*UnlockResponse)(nil),              // 7: v1alpha1.UnlockResponse
+	(*LockRequest)(nil),                 // 8: v1alpha1.LockRequest
+	(*LockResponse)(nil),                // 9: v1alpha1.LockResponse
+	(*GetEncryptionStatusRequest)(nil),  // 10: v1alpha1.GetEncryptionStatusRequest
+	(*GetEncryptionStatusResponse)(nil), // 11: v1alpha1.GetEncryptionStatusResponse
+	(*DisableEncryptionRequest)(nil),    // 12: v1alpha1.DisableEncryptionRequest
+	(*DisableEncryptionResponse)(nil),   // 13: v1alpha1.DisableEncryptionResponse
+}
+var file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_depIdxs = []int32{
+	0,  // 0: v1alpha1.EnableEncryptionRequest.key_protector_type:type_name -> v1alpha1.KeyProtectorType
+	1,  // 1: v1alpha1.EnableEncryptionRequest.encryption_method:type_name -> v1alpha1.EncryptionMethod
+	0,  // 2: v1alpha1.UnlockRequest.key_protector_type:type_name -> v1alpha1.KeyProtectorType
+	2,  // 3: v1alpha1.GetEncryptionStatusResponse.conversion_status:type_name -> v1alpha1.ConversionStatus
+	3,  // 4: v1alpha1.GetEncryptionStatusResponse.protection_status:type_name -> v1alpha1.ProtectionStatus
+	1,  // 5: v1alpha1.GetEncryptionStatusResponse.encryption_method:type_name -> v1alpha1.EncryptionMethod
+	4,  // 6: v1alpha1.Encryption.EnableEncryption:input_type -> v1alpha1.EnableEncryptionRequest
+	6,  // 7: v1alpha1.Encryption.Unlock:input_type -> v1alpha1.UnlockRequest
+	8,  // 8: v1alpha1.Encryption.Lock:input_type -> v1alpha1.LockRequest
+	10, // 9: v1alpha1.Encryption.GetEncryptionStatus:input_type -> v1alpha1.GetEncryptionStatusRequest
+	12, // 10: v1alpha1.Encryption.DisableEncryption:input_type -> v1alpha1.DisableEncryptionRequest
+	5,  // 11: v1alpha1.Encryption.EnableEncryption:output_type -> v1alpha1.EnableEncryptionResponse
+	7,  // 12: v1alpha1.Encryption.Unlock:output_type -> v1alpha1.UnlockResponse
+	9,  // 13: v1alpha1.Encryption.Lock:output_type -> v1alpha1.LockResponse
+	11, // 14: v1alpha1.Encryption.GetEncryptionStatus:output_type -> v1alpha1.GetEncryptionStatusResponse
+	13, // 15: v1alpha1.Encryption.DisableEncryption:output_type -> v1alpha1.DisableEncryptionResponse
+	11, // [11:16] is the sub-list for method output_type
+	6,  // [6:11] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_init() }
+func file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_init() {
+	if File_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnableEncryptionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnableEncryptionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnlockResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LockResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEncryptionStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEncryptionStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisableEncryptionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisableEncryptionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_rawDesc,
+			NumEnums:      4,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_goTypes,
+		DependencyIndexes: file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_depIdxs,
+		EnumInfos:         file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_enumTypes,
+		MessageInfos:      file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_msgTypes,
+	}.Build()
+	File_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto = out.File
+	file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_rawDesc = nil
+	file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_goTypes = nil
+	file_github_com_kubernetes_csi_csi_proxy_client_api_encryption_v1alpha1_api_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// EncryptionClient is the client API for Encryption service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type EncryptionClient interface {
+	// EnableEncryption adds a key protector to a data volume and starts encrypting it with BitLocker.
+	// The encryption goes on in the background, its progress is reported by GetEncryptionStatus.
+	// Enabling the encryption of a volume which isn't fully decrypted succeeds without adding a key protector.
+	EnableEncryption(ctx context.Context, in *EnableEncryptionRequest, opts ...grpc.CallOption) (*EnableEncryptionResponse, error)
+	// Unlock unlocks an encrypted volume with one of its key protectors. Unlocking a volume which
+	// isn't locked succeeds.
+	Unlock(ctx context.Context, in *UnlockRequest, opts ...grpc.CallOption) (*UnlockResponse, error)
+	// Lock locks an encrypted volume, its content is inaccessible until it is unlocked. Locking a
+	// volume which is locked already succeeds.
+	Lock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockResponse, error)
+	// GetEncryptionStatus returns the encryption status of a volume, including the progress of the
+	// encryption or decryption in progress.
+	GetEncryptionStatus(ctx context.Context, in *GetEncryptionStatusRequest, opts ...grpc.CallOption) (*GetEncryptionStatusResponse, error)
+	// DisableEncryption starts decrypting a volume. The decryption goes on in the background, its
+	// progress is reported by GetEncryptionStatus. Disabling the encryption of a volume which is
+	// fully decrypted succeeds.
+	DisableEncryption(ctx context.Context, in *DisableEncryptionRequest, opts ...grpc.CallOption) (*DisableEncryptionResponse, error)
+}
+
+type encryptionClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEncryptionClient(cc grpc.ClientConnInterface) EncryptionClient {
+	return &encryptionClient{cc}
+}
+
+func (c *encryptionClient) EnableEncryption(ctx context.Context, in *EnableEncryptionRequest, opts ...grpc.CallOption) (*EnableEncryptionResponse, error) {
+	out := new(EnableEncryptionResponse)
+	err := c.cc.Invoke(ctx, "/v1alpha1.Encryption/EnableEncryption", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *encryptionClient) Unlock(ctx context.Context, in *UnlockRequest, opts ...grpc.CallOption) (*UnlockResponse, error) {
+	out := new(UnlockResponse)
+	err := c.cc.Invoke(ctx, "/v1alpha1.Encryption/Unlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *encryptionClient) Lock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockResponse, error) {
+	out := new(LockResponse)
+	err := c.cc.Invoke(ctx, "/v1alpha1.Encryption/Lock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *encryptionClient) GetEncryptionStatus(ctx context.Context, in *GetEncryptionStatusRequest, opts ...grpc.CallOption) (*GetEncryptionStatusResponse, error) {
+	out := new(GetEncryptionStatusResponse)
+	err := c.cc.Invoke(ctx, "/v1alpha1.Encryption/GetEncryptionStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *encryptionClient) DisableEncryption(ctx context.Context, in *DisableEncryptionRequest, opts ...grpc.CallOption) (*DisableEncryptionResponse, error) {
+	out := new(DisableEncryptionResponse)
+	err := c.cc.Invoke(ctx, "/v1alpha1.Encryption/DisableEncryption", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EncryptionServer is the server API for Encryption service.
+type EncryptionServer interface {
+	// EnableEncryption adds a key protector to a data volume and starts encrypting it with BitLocker.
+	// The encryption goes on in the background, its progress is reported by GetEncryptionStatus.
+	// Enabling the encryption of a volume which isn't fully decrypted succeeds without adding a key protector.
+	EnableEncryption(context.Context, *EnableEncryptionRequest) (*EnableEncryptionResponse, error)
+	// Unlock unlocks an encrypted volume with one of its key protectors. Unlocking a volume which
+	// isn't locked succeeds.
+	Unlock(context.Context, *UnlockRequest) (*UnlockResponse, error)
+	// Lock locks an encrypted volume, its content is inaccessible until it is unlocked. Locking a
+	// volume which is locked already succeeds.
+	Lock(context.Context, *LockRequest) (*LockResponse, error)
+	// GetEncryptionStatus returns the encryption status of a volume, including the progress of the
+	// encryption or decryption in progress.
+	GetEncryptionStatus(context.Context, *GetEncryptionStatusRequest) (*GetEncryptionStatusResponse, error)
+	// DisableEncryption starts decrypting a volume. The decryption goes on in the background, its
+	// progress is reported by GetEncryptionStatus. Disabling the encryption of a volume which is
+	// fully decrypted succeeds.
+	DisableEncryption(context.Context, *DisableEncryptionRequest) (*DisableEncryptionResponse, error)
+}
+
+// UnimplementedEncryptionServer can be embedded to have forward compatible implementations.
+type UnimplementedEncryptionServer struct {
+}
+
+func (*UnimplementedEncryptionServer) EnableEncryption(context.Context, *EnableEncryptionRequest) (*EnableEncryptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableEncryption not implemented")
+}
+func (*UnimplementedEncryptionServer) Unlock(context.Context, *UnlockRequest) (*UnlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unlock not implemented")
+}
+func (*UnimplementedEncryptionServer) Lock(context.Context, *LockRequest) (*LockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lock not implemented")
+}
+func (*UnimplementedEncryptionServer) GetEncryptionStatus(context.Context, *GetEncryptionStatusRequest) (*GetEncryptionStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEncryptionStatus not implemented")
+}
+func (*UnimplementedEncryptionServer) DisableEncryption(context.Context, *DisableEncryptionRequest) (*DisableEncryptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableEncryption not implemented")
+}
+
+func RegisterEncryptionServer(s *grpc.Server, srv EncryptionServer) {
+	s.RegisterService(&_Encryption_serviceDesc, srv)
+}
+
+func _Encryption_EnableEncryption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableEncryptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EncryptionServer).EnableEncryption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1alpha1.Encryption/EnableEncryption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EncryptionServer).EnableEncryption(ctx, req.(*EnableEncryptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Encryption_Unlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EncryptionServer).Unlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1alpha1.Encryption/Unlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EncryptionServer).Unlock(ctx, req.(*UnlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Encryption_Lock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EncryptionServer).Lock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1alpha1.Encryption/Lock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EncryptionServer).Lock(ctx, req.(*LockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Encryption_GetEncryptionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEncryptionStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EncryptionServer).GetEncryptionStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1alpha1.Encryption/GetEncryptionStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EncryptionServer).GetEncryptionStatus(ctx, req.(*GetEncryptionStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Encryption_DisableEncryption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableEncryptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EncryptionServer).DisableEncryption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1alpha1.Encryption/DisableEncryption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EncryptionServer).DisableEncryption(ctx, req.(*DisableEncryptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Encryption_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "v1alpha1.Encryption",
+	HandlerType: (*EncryptionServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EnableEncryption",
+			Handler:    _Encryption_EnableEncryption_Handler,
+		},
+		{
+			MethodName: "Unlock",
+			Handler:    _Encryption_Unlock_Handler,
+		},
+		{
+			MethodName: "Lock",
+			Handler:    _Encryption_Lock_Handler,
+		},
+		{
+			MethodName: "GetEncryptionStatus",
+			Handler:    _Encryption_GetEncryptionStatus_Handler,
+		},
+		{
+			MethodName: "DisableEncryption",
+			Handler:    _Encryption_DisableEncryption_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "github.com/kubernetes-csi/csi-proxy/client/api/encryption/v1alpha1/api.proto",
+}
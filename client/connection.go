@@ -0,0 +1,56 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"net"
+
+	"google.golang.org/grpc"
+)
+
+// NewMultiplexedConnection returns a connection to the named pipe serving all API groups
+// and versions when the proxy runs in multiplexed mode. Clients for each API group and
+// version can then be created on it with their NewClientWithConnection function, e.g.
+//
+//	connection, err := client.NewMultiplexedConnection()
+//	...
+//	defer connection.Close()
+//	diskClient := diskv1.NewClientWithConnection(connection)
+//	volumeClient := volumev1.NewClientWithConnection(connection)
+//
+// It's the caller's responsibility to Close the connection when done.
+func NewMultiplexedConnection() (*grpc.ClientConn, error) {
+	pipePath := MultiplexedPipePath()
+
+	// verify that the pipe exists
+	if err := VerifyPipe(pipePath); err != nil {
+		return nil, err
+	}
+
+	return NewMultiplexedConnectionWithDialer(pipePath, DialPipe)
+}
+
+// NewMultiplexedConnectionWithDialer returns a connection to the multiplexed endpoint
+// "target", connecting to it with "dialer", e.g.
+// NewMultiplexedConnectionWithDialer(MultiplexedSocketPath(dir), DialUnix).
+// It's the caller's responsibility to Close the connection when done.
+func NewMultiplexedConnectionWithDialer(target string, dialer func(context.Context, string) (net.Conn, error)) (*grpc.ClientConn, error) {
+	return grpc.Dial(target,
+		grpc.WithContextDialer(dialer),
+		grpc.WithInsecure())
+}
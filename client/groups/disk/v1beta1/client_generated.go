@@ -6,7 +6,6 @@ import (
 	"context"
 	"net"
 
-	"github.com/Microsoft/go-winio"
 	"github.com/kubernetes-csi/csi-proxy/client"
 	"github.com/kubernetes-csi/csi-proxy/client/api/disk/v1beta1"
 	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
@@ -22,6 +21,8 @@ var Version = apiversion.NewVersionOrPanic("v1beta1")
 type Client struct {
 	client     v1beta1.DiskClient
 	connection *grpc.ClientConn
+	// sharedConnection is true when the connection is owned by the caller, see NewClientWithConnection.
+	sharedConnection bool
 }
 
 // NewClient returns a client to make calls to the disk API group version v1beta1.
@@ -36,15 +37,19 @@ func NewClient() (*Client, error) {
 func NewClientWithPipePath(pipePath string) (*Client, error) {
 
 	// verify that the pipe exists
-	_, err := winio.DialPipe(pipePath, nil)
-	if err != nil {
+	if err := client.VerifyPipe(pipePath); err != nil {
 		return nil, err
 	}
 
-	connection, err := grpc.Dial(pipePath,
-		grpc.WithContextDialer(func(context context.Context, s string) (net.Conn, error) {
-			return winio.DialPipeContext(context, s)
-		}),
+	return NewClientWithDialer(pipePath, client.DialPipe)
+}
+
+// NewClientWithDialer returns a client to make calls to "target", connecting to it with "dialer",
+// e.g. NewClientWithDialer(client.SocketPath(dir, GroupName, Version), client.DialUnix).
+// It's the caller's responsibility to Close the client when done.
+func NewClientWithDialer(target string, dialer func(context.Context, string) (net.Conn, error)) (*Client, error) {
+	connection, err := grpc.Dial(target,
+		grpc.WithContextDialer(dialer),
 		grpc.WithInsecure())
 	if err != nil {
 		return nil, err
@@ -57,8 +62,22 @@ func NewClientWithPipePath(pipePath string) (*Client, error) {
 	}, nil
 }
 
+// NewClientWithConnection returns a client making calls over "connection", e.g. one returned by
+// client.NewMultiplexedConnection, shared by the clients of several API groups and versions.
+// It's the caller's responsibility to Close the connection when done, closing the client is a no-op.
+func NewClientWithConnection(connection *grpc.ClientConn) *Client {
+	return &Client{
+		client:           v1beta1.NewDiskClient(connection),
+		connection:       connection,
+		sharedConnection: true,
+	}
+}
+
 // Close closes the client. It must be called before the client gets GC-ed.
 func (w *Client) Close() error {
+	if w.sharedConnection {
+		return nil
+	}
 	return w.connection.Close()
 }
 
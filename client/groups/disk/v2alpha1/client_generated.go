@@ -0,0 +1,153 @@
+// Code generated by csi-proxy-api-gen. DO NOT EDIT.
+
+package v2alpha1
+
+import (
+	"context"
+	"net"
+
+	"github.com/kubernetes-csi/csi-proxy/client"
+	"github.com/kubernetes-csi/csi-proxy/client/api/disk/v2alpha1"
+	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+	"google.golang.org/grpc"
+)
+
+// GroupName is the group name of this API.
+const GroupName = "disk"
+
+// Version is the api version.
+var Version = apiversion.NewVersionOrPanic("v2alpha1")
+
+type Client struct {
+	client     v2alpha1.DiskClient
+	connection *grpc.ClientConn
+	// sharedConnection is true when the connection is owned by the caller, see NewClientWithConnection.
+	sharedConnection bool
+}
+
+// NewClient returns a client to make calls to the disk API group version v2alpha1.
+// It's the caller's responsibility to Close the client when done.
+func NewClient() (*Client, error) {
+	pipePath := client.PipePath(GroupName, Version)
+	return NewClientWithPipePath(pipePath)
+}
+
+// NewClientWithPipePath returns a client to make calls to the named pipe located at "pipePath".
+// It's the caller's responsibility to Close the client when done.
+func NewClientWithPipePath(pipePath string) (*Client, error) {
+
+	// verify that the pipe exists
+	if err := client.VerifyPipe(pipePath); err != nil {
+		return nil, err
+	}
+
+	return NewClientWithDialer(pipePath, client.DialPipe)
+}
+
+// NewClientWithDialer returns a client to make calls to "target", connecting to it with "dialer",
+// e.g. NewClientWithDialer(client.SocketPath(dir, GroupName, Version), client.DialUnix).
+// It's the caller's responsibility to Close the client when done.
+func NewClientWithDialer(target string, dialer func(context.Context, string) (net.Conn, error)) (*Client, error) {
+	connection, err := grpc.Dial(target,
+		grpc.WithContextDialer(dialer),
+		grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+
+	client := v2alpha1.NewDiskClient(connection)
+	return &Client{
+		client:     client,
+		connection: connection,
+	}, nil
+}
+
+// NewClientWithConnection returns a client making calls over "connection", e.g. one returned by
+// client.NewMultiplexedConnection, shared by the clients of several API groups and versions.
+// It's the caller's responsibility to Close the connection when done, closing the client is a no-op.
+func NewClientWithConnection(connection *grpc.ClientConn) *Client {
+	return &Client{
+		client:           v2alpha1.NewDiskClient(connection),
+		connection:       connection,
+		sharedConnection: true,
+	}
+}
+
+// Close closes the client. It must be called before the client gets GC-ed.
+func (w *Client) Close() error {
+	if w.sharedConnection {
+		return nil
+	}
+	return w.connection.Close()
+}
+
+// ensures we implement all the required methods
+var _ v2alpha1.DiskClient = &Client{}
+
+func (w *Client) ClearDisk(context context.Context, request *v2alpha1.ClearDiskRequest, opts ...grpc.CallOption) (v2alpha1.Disk_ClearDiskClient, error) {
+	return w.client.ClearDisk(context, request, opts...)
+}
+
+func (w *Client) DeletePartition(context context.Context, request *v2alpha1.DeletePartitionRequest, opts ...grpc.CallOption) (*v2alpha1.DeletePartitionResponse, error) {
+	return w.client.DeletePartition(context, request, opts...)
+}
+
+func (w *Client) FindDisk(context context.Context, request *v2alpha1.FindDiskRequest, opts ...grpc.CallOption) (*v2alpha1.FindDiskResponse, error) {
+	return w.client.FindDisk(context, request, opts...)
+}
+
+func (w *Client) GetDiskAttributes(context context.Context, request *v2alpha1.GetDiskAttributesRequest, opts ...grpc.CallOption) (*v2alpha1.GetDiskAttributesResponse, error) {
+	return w.client.GetDiskAttributes(context, request, opts...)
+}
+
+func (w *Client) GetDiskInfo(context context.Context, request *v2alpha1.GetDiskInfoRequest, opts ...grpc.CallOption) (*v2alpha1.GetDiskInfoResponse, error) {
+	return w.client.GetDiskInfo(context, request, opts...)
+}
+
+func (w *Client) GetDiskState(context context.Context, request *v2alpha1.GetDiskStateRequest, opts ...grpc.CallOption) (*v2alpha1.GetDiskStateResponse, error) {
+	return w.client.GetDiskState(context, request, opts...)
+}
+
+func (w *Client) GetDiskStats(context context.Context, request *v2alpha1.GetDiskStatsRequest, opts ...grpc.CallOption) (*v2alpha1.GetDiskStatsResponse, error) {
+	return w.client.GetDiskStats(context, request, opts...)
+}
+
+func (w *Client) ListDiskIDs(context context.Context, request *v2alpha1.ListDiskIDsRequest, opts ...grpc.CallOption) (*v2alpha1.ListDiskIDsResponse, error) {
+	return w.client.ListDiskIDs(context, request, opts...)
+}
+
+func (w *Client) ListDiskLocations(context context.Context, request *v2alpha1.ListDiskLocationsRequest, opts ...grpc.CallOption) (*v2alpha1.ListDiskLocationsResponse, error) {
+	return w.client.ListDiskLocations(context, request, opts...)
+}
+
+func (w *Client) ListDisks(context context.Context, request *v2alpha1.ListDisksRequest, opts ...grpc.CallOption) (*v2alpha1.ListDisksResponse, error) {
+	return w.client.ListDisks(context, request, opts...)
+}
+
+func (w *Client) ListPartitions(context context.Context, request *v2alpha1.ListPartitionsRequest, opts ...grpc.CallOption) (*v2alpha1.ListPartitionsResponse, error) {
+	return w.client.ListPartitions(context, request, opts...)
+}
+
+func (w *Client) PartitionDisk(context context.Context, request *v2alpha1.PartitionDiskRequest, opts ...grpc.CallOption) (*v2alpha1.PartitionDiskResponse, error) {
+	return w.client.PartitionDisk(context, request, opts...)
+}
+
+func (w *Client) Rescan(context context.Context, request *v2alpha1.RescanRequest, opts ...grpc.CallOption) (*v2alpha1.RescanResponse, error) {
+	return w.client.Rescan(context, request, opts...)
+}
+
+func (w *Client) SetDiskAttributes(context context.Context, request *v2alpha1.SetDiskAttributesRequest, opts ...grpc.CallOption) (*v2alpha1.SetDiskAttributesResponse, error) {
+	return w.client.SetDiskAttributes(context, request, opts...)
+}
+
+func (w *Client) SetDiskState(context context.Context, request *v2alpha1.SetDiskStateRequest, opts ...grpc.CallOption) (*v2alpha1.SetDiskStateResponse, error) {
+	return w.client.SetDiskState(context, request, opts...)
+}
+
+func (w *Client) WaitForDisk(context context.Context, request *v2alpha1.WaitForDiskRequest, opts ...grpc.CallOption) (*v2alpha1.WaitForDiskResponse, error) {
+	return w.client.WaitForDisk(context, request, opts...)
+}
+
+func (w *Client) WatchDisks(context context.Context, request *v2alpha1.WatchDisksRequest, opts ...grpc.CallOption) (v2alpha1.Disk_WatchDisksClient, error) {
+	return w.client.WatchDisks(context, request, opts...)
+}
@@ -0,0 +1,137 @@
+// Code generated by csi-proxy-api-gen. DO NOT EDIT.
+
+package v2alpha2
+
+import (
+	"context"
+	"net"
+
+	"github.com/kubernetes-csi/csi-proxy/client"
+	"github.com/kubernetes-csi/csi-proxy/client/api/volume/v2alpha2"
+	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+	"google.golang.org/grpc"
+)
+
+// GroupName is the group name of this API.
+const GroupName = "volume"
+
+// Version is the api version.
+var Version = apiversion.NewVersionOrPanic("v2alpha2")
+
+type Client struct {
+	client     v2alpha2.VolumeClient
+	connection *grpc.ClientConn
+	// sharedConnection is true when the connection is owned by the caller, see NewClientWithConnection.
+	sharedConnection bool
+}
+
+// NewClient returns a client to make calls to the volume API group version v2alpha2.
+// It's the caller's responsibility to Close the client when done.
+func NewClient() (*Client, error) {
+	pipePath := client.PipePath(GroupName, Version)
+	return NewClientWithPipePath(pipePath)
+}
+
+// NewClientWithPipePath returns a client to make calls to the named pipe located at "pipePath".
+// It's the caller's responsibility to Close the client when done.
+func NewClientWithPipePath(pipePath string) (*Client, error) {
+
+	// verify that the pipe exists
+	if err := client.VerifyPipe(pipePath); err != nil {
+		return nil, err
+	}
+
+	return NewClientWithDialer(pipePath, client.DialPipe)
+}
+
+// NewClientWithDialer returns a client to make calls to "target", connecting to it with "dialer",
+// e.g. NewClientWithDialer(client.SocketPath(dir, GroupName, Version), client.DialUnix).
+// It's the caller's responsibility to Close the client when done.
+func NewClientWithDialer(target string, dialer func(context.Context, string) (net.Conn, error)) (*Client, error) {
+	connection, err := grpc.Dial(target,
+		grpc.WithContextDialer(dialer),
+		grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+
+	client := v2alpha2.NewVolumeClient(connection)
+	return &Client{
+		client:     client,
+		connection: connection,
+	}, nil
+}
+
+// NewClientWithConnection returns a client making calls over "connection", e.g. one returned by
+// client.NewMultiplexedConnection, shared by the clients of several API groups and versions.
+// It's the caller's responsibility to Close the connection when done, closing the client is a no-op.
+func NewClientWithConnection(connection *grpc.ClientConn) *Client {
+	return &Client{
+		client:           v2alpha2.NewVolumeClient(connection),
+		connection:       connection,
+		sharedConnection: true,
+	}
+}
+
+// Close closes the client. It must be called before the client gets GC-ed.
+func (w *Client) Close() error {
+	if w.sharedConnection {
+		return nil
+	}
+	return w.connection.Close()
+}
+
+// ensures we implement all the required methods
+var _ v2alpha2.VolumeClient = &Client{}
+
+func (w *Client) FormatVolume(context context.Context, request *v2alpha2.FormatVolumeRequest, opts ...grpc.CallOption) (*v2alpha2.FormatVolumeResponse, error) {
+	return w.client.FormatVolume(context, request, opts...)
+}
+
+func (w *Client) GetClosestVolumeIDFromTargetPath(context context.Context, request *v2alpha2.GetClosestVolumeIDFromTargetPathRequest, opts ...grpc.CallOption) (*v2alpha2.GetClosestVolumeIDFromTargetPathResponse, error) {
+	return w.client.GetClosestVolumeIDFromTargetPath(context, request, opts...)
+}
+
+func (w *Client) GetDiskNumberFromVolumeID(context context.Context, request *v2alpha2.GetDiskNumberFromVolumeIDRequest, opts ...grpc.CallOption) (*v2alpha2.GetDiskNumberFromVolumeIDResponse, error) {
+	return w.client.GetDiskNumberFromVolumeID(context, request, opts...)
+}
+
+func (w *Client) GetVolumeIDFromTargetPath(context context.Context, request *v2alpha2.GetVolumeIDFromTargetPathRequest, opts ...grpc.CallOption) (*v2alpha2.GetVolumeIDFromTargetPathResponse, error) {
+	return w.client.GetVolumeIDFromTargetPath(context, request, opts...)
+}
+
+func (w *Client) GetVolumeStats(context context.Context, request *v2alpha2.GetVolumeStatsRequest, opts ...grpc.CallOption) (*v2alpha2.GetVolumeStatsResponse, error) {
+	return w.client.GetVolumeStats(context, request, opts...)
+}
+
+func (w *Client) IsVolumeFormatted(context context.Context, request *v2alpha2.IsVolumeFormattedRequest, opts ...grpc.CallOption) (*v2alpha2.IsVolumeFormattedResponse, error) {
+	return w.client.IsVolumeFormatted(context, request, opts...)
+}
+
+func (w *Client) ListVolumesOnDisk(context context.Context, request *v2alpha2.ListVolumesOnDiskRequest, opts ...grpc.CallOption) (*v2alpha2.ListVolumesOnDiskResponse, error) {
+	return w.client.ListVolumesOnDisk(context, request, opts...)
+}
+
+func (w *Client) MountVolume(context context.Context, request *v2alpha2.MountVolumeRequest, opts ...grpc.CallOption) (*v2alpha2.MountVolumeResponse, error) {
+	return w.client.MountVolume(context, request, opts...)
+}
+
+func (w *Client) RepairVolume(context context.Context, request *v2alpha2.RepairVolumeRequest, opts ...grpc.CallOption) (*v2alpha2.RepairVolumeResponse, error) {
+	return w.client.RepairVolume(context, request, opts...)
+}
+
+func (w *Client) ResizeVolume(context context.Context, request *v2alpha2.ResizeVolumeRequest, opts ...grpc.CallOption) (*v2alpha2.ResizeVolumeResponse, error) {
+	return w.client.ResizeVolume(context, request, opts...)
+}
+
+func (w *Client) ScanVolume(context context.Context, request *v2alpha2.ScanVolumeRequest, opts ...grpc.CallOption) (*v2alpha2.ScanVolumeResponse, error) {
+	return w.client.ScanVolume(context, request, opts...)
+}
+
+func (w *Client) UnmountVolume(context context.Context, request *v2alpha2.UnmountVolumeRequest, opts ...grpc.CallOption) (*v2alpha2.UnmountVolumeResponse, error) {
+	return w.client.UnmountVolume(context, request, opts...)
+}
+
+func (w *Client) WriteVolumeCache(context context.Context, request *v2alpha2.WriteVolumeCacheRequest, opts ...grpc.CallOption) (*v2alpha2.WriteVolumeCacheResponse, error) {
+	return w.client.WriteVolumeCache(context, request, opts...)
+}
@@ -0,0 +1,39 @@
+//go:build !windows
+// +build !windows
+
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"errors"
+	"net"
+)
+
+// ErrPipeNotSupported is returned when using named pipes outside of Windows.
+var ErrPipeNotSupported = errors.New("named pipes are only supported on Windows")
+
+// DialPipe connects to the named pipe located at "pipePath".
+func DialPipe(context context.Context, pipePath string) (net.Conn, error) {
+	return nil, ErrPipeNotSupported
+}
+
+// VerifyPipe checks that the named pipe located at "pipePath" exists.
+func VerifyPipe(pipePath string) error {
+	return ErrPipeNotSupported
+}
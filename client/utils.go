@@ -17,6 +17,10 @@ limitations under the License.
 package client
 
 import (
+	"context"
+	"net"
+	"path/filepath"
+
 	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
 )
 
@@ -28,8 +32,45 @@ const (
 	// The suffix will be the API group and version,
 	// e.g. "\\.\\pipe\\csi-proxy-iscsi-v1", "\\.\\pipe\\csi-proxy-filesystem-v2alpha1", etc.
 	csiProxyNamedPipePrefix = "csi-proxy-"
+
+	// socketSuffix is the suffix of the unix domain sockets the proxy creates.
+	socketSuffix = ".sock"
+
+	// multiplexedEndpointName is the name of the single endpoint serving all API groups
+	// and versions when the proxy runs in multiplexed mode.
+	multiplexedEndpointName = "csi-proxy"
 )
 
 func PipePath(apiGroupName string, apiVersion apiversion.Version) string {
 	return pipePrefix + csiProxyNamedPipePrefix + apiGroupName + "-" + apiVersion.String()
 }
+
+// SocketPath returns the path of the unix domain socket for the given API group and version
+// in the directory "socketDir", e.g. "/run/csi-proxy/csi-proxy-iscsi-v1.sock".
+func SocketPath(socketDir, apiGroupName string, apiVersion apiversion.Version) string {
+	return filepath.Join(socketDir, csiProxyNamedPipePrefix+apiGroupName+"-"+apiVersion.String()+socketSuffix)
+}
+
+// MultiplexedPipePath returns the path of the named pipe serving all API groups and versions
+// when the proxy runs in multiplexed mode, i.e. "\\.\\pipe\\csi-proxy".
+func MultiplexedPipePath() string {
+	return pipePrefix + multiplexedEndpointName
+}
+
+// MultiplexedSocketPath returns the path of the unix domain socket serving all API groups
+// and versions when the proxy runs in multiplexed mode, e.g. "/run/csi-proxy/csi-proxy.sock".
+func MultiplexedSocketPath(socketDir string) string {
+	return filepath.Join(socketDir, multiplexedEndpointName+socketSuffix)
+}
+
+// DialUnix connects to the unix domain socket located at "socketPath".
+func DialUnix(context context.Context, socketPath string) (net.Conn, error) {
+	var dialer net.Dialer
+	return dialer.DialContext(context, "unix", socketPath)
+}
+
+// DialTCP connects to the TCP address "address", e.g. "127.0.0.1:9000".
+func DialTCP(context context.Context, address string) (net.Conn, error) {
+	var dialer net.Dialer
+	return dialer.DialContext(context, "tcp", address)
+}
@@ -32,7 +32,6 @@ func (g *clientGeneratedGenerator) Imports(*generator.Context) []string {
 	return []string{
 		"context",
 		"net",
-		"github.com/Microsoft/go-winio",
 		"google.golang.org/grpc",
 		"github.com/kubernetes-csi/csi-proxy/client",
 		"github.com/kubernetes-csi/csi-proxy/client/apiversion",
@@ -53,6 +52,8 @@ var Version = apiversion.NewVersionOrPanic("$.version$")
 type Client struct {
 	client     $.version$.$.camelGroupName$Client
 	connection *grpc.ClientConn
+	// sharedConnection is true when the connection is owned by the caller, see NewClientWithConnection.
+	sharedConnection bool
 }
 
 // NewClient returns a client to make calls to the $.groupName$ API group version $.version$.
@@ -67,15 +68,19 @@ func NewClient() (*Client, error) {
 func NewClientWithPipePath(pipePath string) (*Client, error) {
 
 	// verify that the pipe exists
-	_, err := winio.DialPipe(pipePath, nil)
-	if err != nil {
+	if err := client.VerifyPipe(pipePath); err != nil {
 		return nil, err
 	}
 
-	connection, err := grpc.Dial(pipePath,
-		grpc.WithContextDialer(func(context context.Context, s string) (net.Conn, error) {
-			return winio.DialPipeContext(context, s)
-		}),
+	return NewClientWithDialer(pipePath, client.DialPipe)
+}
+
+// NewClientWithDialer returns a client to make calls to "target", connecting to it with "dialer",
+// e.g. NewClientWithDialer(client.SocketPath(dir, GroupName, Version), client.DialUnix).
+// It's the caller's responsibility to Close the client when done.
+func NewClientWithDialer(target string, dialer func(context.Context, string) (net.Conn, error)) (*Client, error) {
+	connection, err := grpc.Dial(target,
+		grpc.WithContextDialer(dialer),
 		grpc.WithInsecure())
 	if err != nil {
 		return nil, err
@@ -88,8 +93,22 @@ func NewClientWithPipePath(pipePath string) (*Client, error) {
 	}, nil
 }
 
+// NewClientWithConnection returns a client making calls over "connection", e.g. one returned by
+// client.NewMultiplexedConnection, shared by the clients of several API groups and versions.
+// It's the caller's responsibility to Close the connection when done, closing the client is a no-op.
+func NewClientWithConnection(connection *grpc.ClientConn) *Client {
+	return &Client{
+		client:           $.version$.New$.camelGroupName$Client(connection),
+		connection:       connection,
+		sharedConnection: true,
+	}
+}
+
 // Close closes the client. It must be called before the client gets GC-ed.
 func (w *Client) Close() error {
+	if w.sharedConnection {
+		return nil
+	}
 	return w.connection.Close()
 }
 
@@ -110,6 +129,21 @@ var _ $.version$.$.camelGroupName$Client = &Client{}
 }
 
 func (g *clientGeneratedGenerator) writeWrapperFunction(callbackName string, callback *types.Type, snippetWriter *generator.SnippetWriter) {
+	if streamedResponse(callback) != nil {
+		// the client of a server streaming callback gets the stream to receive the responses from
+		snippetWriter.Do(`func (w *Client) $.name$(context context.Context, request $.request|shortenVersionPackage$, opts ...grpc.CallOption) ($.version$.$.camelGroupName$_$.name$Client, error) {
+return w.client.$.name$(context, request, opts...)
+}
+
+`, map[string]interface{}{
+			"name":           callbackName,
+			"request":        callback.Signature.Parameters[0],
+			"camelGroupName": strcase.ToCamel(g.groupDefinition.name),
+			"version":        g.version.Name,
+		})
+		return
+	}
+
 	snippetWriter.Do("func (w *Client) $.$(", callbackName)
 
 	for _, param := range callback.Signature.Parameters {
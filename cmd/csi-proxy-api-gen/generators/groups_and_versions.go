@@ -131,9 +131,15 @@ func (d *groupDefinition) addVersion(versionPkg *types.Package) {
 // validateServerCallback checks that server callbacks have the expected shape, i.e.:
 // * all versioned (i.e. in the same package) parameter should be pointers
 // * return values should all be pointers, except for the last one, which must be an error
+// * server streaming callbacks are of the form Method(*Request, Group_MethodServer) error
 // These assumptions are necessary for some of the generators in this package.
 func (d *groupDefinition) validateServerCallback(callbackName string, callback *types.Type, version *apiVersion) {
-	for _, param := range callback.Signature.Parameters {
+	isStreaming := streamedResponse(callback) != nil
+	for i, param := range callback.Signature.Parameters {
+		if isStreaming && i == len(callback.Signature.Parameters)-1 {
+			// the stream the responses are sent to
+			continue
+		}
 		if isVersionedVariable(param, version) && param.Kind != types.Pointer {
 			klog.Fatalf("Server callback %s in API %s version %s has a non-pointer versioned parameter: %v",
 				callbackName, d.name, version.Name, param)
@@ -152,6 +158,21 @@ func (d *groupDefinition) validateServerCallback(callbackName string, callback *
 	}
 }
 
+// streamedResponse returns the type of the responses a server streaming callback sends, i.e. the parameter
+// of the Send method of the stream in Method(*Request, Group_MethodServer) error, or nil if the callback
+// isn't server streaming.
+func streamedResponse(callback *types.Type) *types.Type {
+	params := callback.Signature.Parameters
+	if len(params) != 2 || params[1].Kind != types.Interface {
+		return nil
+	}
+	send, present := params[1].Methods["Send"]
+	if !present || send.Signature == nil || len(send.Signature.Parameters) != 1 {
+		return nil
+	}
+	return send.Signature.Parameters[0]
+}
+
 // isBuiltInErrorType returns true if type t is the built-in type "error".
 func isBuiltInErrorType(t *types.Type) bool {
 	return t.Kind == types.Interface && t.Name.Name == "error" && t.Name.Package == ""
@@ -36,6 +36,15 @@ func (g *serverGenerator) Init(context *generator.Context, writer io.Writer) err
 	for _, namedCallback := range g.groupDefinition.serverCallbacks {
 		callback := replaceTypesPackage(namedCallback.callback, pkgPlaceholder, "internal")
 
+		if response := streamedResponse(callback); response != nil {
+			snippetWriter.Do("func (s *Server) "+namedCallback.name+"(context context.Context, request $.request$, send func($.response$) error, version apiversion.Version) error {\n", map[string]interface{}{
+				"request":  callback.Signature.Parameters[0],
+				"response": response,
+			})
+			snippetWriter.Do("// TODO: auto-generated stub\nreturn nil}\n\n", nil)
+			continue
+		}
+
 		snippetWriter.Do("func (s *Server) "+namedCallback.name+"(", nil)
 		for _, param := range callback.Signature.Parameters {
 			snippetWriter.Do("$.|short$ $.$, ", param)
@@ -75,6 +75,11 @@ func (s *versionedAPI) Register(grpcServer *grpc.Server) {
 }
 
 func (g *serverGeneratedGenerator) writeWrapperFunction(callbackName string, callback *types.Type, snippetWriter *generator.SnippetWriter) {
+	if response := streamedResponse(callback); response != nil {
+		g.writeStreamingWrapperFunction(callbackName, callback, response, snippetWriter)
+		return
+	}
+
 	// write the func signature
 	snippetWriter.Do("func (s *versionedAPI) $.$(", callbackName)
 	for _, param := range callback.Signature.Parameters {
@@ -136,3 +141,30 @@ func (g *serverGeneratedGenerator) writeWrapperFunction(callbackName string, cal
 	// end of the request handler
 	snippetWriter.Do("\n}\n\n", nil)
 }
+
+// writeStreamingWrapperFunction writes the request handler of a server streaming callback, which converts
+// the responses the internal server sends.
+func (g *serverGeneratedGenerator) writeStreamingWrapperFunction(callbackName string, callback, response *types.Type, snippetWriter *generator.SnippetWriter) {
+	snippetWriter.Do(`func (s *versionedAPI) $.name$(versionedRequest $.request|shortenVersionPackage$, stream $.stream|shortenVersionPackage$) error {
+request := &impl.$.request|removePackage${}
+if err := Convert_$.version$_$.request|removePackage$_To_impl_$.request|removePackage$(versionedRequest, request); err != nil {
+return err
+}
+
+return s.apiGroupServer.$.name$(stream.Context(), request, func(response *impl.$.response|removePackage$) error {
+versionedResponse := &$.version$.$.response|removePackage${}
+if err := Convert_impl_$.response|removePackage$_To_$.version$_$.response|removePackage$(response, versionedResponse); err != nil {
+return err
+}
+return stream.Send(versionedResponse)
+}, version)
+}
+
+`, map[string]interface{}{
+		"name":     callbackName,
+		"request":  callback.Signature.Parameters[0],
+		"stream":   callback.Signature.Parameters[1],
+		"response": response,
+		"version":  g.version.Name,
+	})
+}
@@ -39,6 +39,15 @@ type ServerInterface interface {
 	for _, namedCallback := range g.groupDefinition.serverCallbacks {
 		callback := replaceTypesPackage(namedCallback.callback, pkgPlaceholder, "")
 
+		if response := streamedResponse(callback); response != nil {
+			// server streaming callbacks get the context of the stream, and a function to send the responses
+			snippetWriter.Do(namedCallback.name+"(context.Context, $.request$, func($.response$) error, apiversion.Version) error\n", map[string]interface{}{
+				"request":  callback.Signature.Parameters[0],
+				"response": response,
+			})
+			continue
+		}
+
 		snippetWriter.Do(namedCallback.name+"(", nil)
 		for _, param := range callback.Signature.Parameters {
 			snippetWriter.Do("$.$, ", param)
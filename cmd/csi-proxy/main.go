@@ -1,21 +1,41 @@
 package main
 
 import (
+	"context"
 	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"reflect"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
 
+	"github.com/kubernetes-csi/csi-proxy/pkg/config"
 	diskapi "github.com/kubernetes-csi/csi-proxy/pkg/os/disk"
+	encryptionapi "github.com/kubernetes-csi/csi-proxy/pkg/os/encryption"
 	filesystemapi "github.com/kubernetes-csi/csi-proxy/pkg/os/filesystem"
 	iscsiapi "github.com/kubernetes-csi/csi-proxy/pkg/os/iscsi"
 	smbapi "github.com/kubernetes-csi/csi-proxy/pkg/os/smb"
+	snapshotapi "github.com/kubernetes-csi/csi-proxy/pkg/os/snapshot"
 	sysapi "github.com/kubernetes-csi/csi-proxy/pkg/os/system"
 	volumeapi "github.com/kubernetes-csi/csi-proxy/pkg/os/volume"
 	"github.com/kubernetes-csi/csi-proxy/pkg/server"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/audit"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/authz"
 	disksrv "github.com/kubernetes-csi/csi-proxy/pkg/server/disk"
+	encryptionsrv "github.com/kubernetes-csi/csi-proxy/pkg/server/encryption"
 	filesystemsrv "github.com/kubernetes-csi/csi-proxy/pkg/server/filesystem"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/guard"
 	iscsisrv "github.com/kubernetes-csi/csi-proxy/pkg/server/iscsi"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/locks"
 	"github.com/kubernetes-csi/csi-proxy/pkg/server/metrics"
 	smbsrv "github.com/kubernetes-csi/csi-proxy/pkg/server/smb"
+	snapshotsrv "github.com/kubernetes-csi/csi-proxy/pkg/server/snapshot"
 	syssrv "github.com/kubernetes-csi/csi-proxy/pkg/server/system"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/tracing"
 	srvtypes "github.com/kubernetes-csi/csi-proxy/pkg/server/types"
 	volumesrv "github.com/kubernetes-csi/csi-proxy/pkg/server/volume"
 	"golang.org/x/sys/windows"
@@ -34,22 +54,69 @@ func (i *workingDirFlags) Set(value string) error {
 	return nil
 }
 
+// listFlag is a comma-separated list of values.
+type listFlag []string
+
+func (l *listFlag) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *listFlag) Set(value string) error {
+	*l = nil
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			*l = append(*l, item)
+		}
+	}
+	return nil
+}
+
 var (
-	kubeletPath     = flag.String("kubelet-path", `C:\var\lib\kubelet`, "Prefix path of the kubelet directory in the host file system")
-	windowsSvc      = flag.Bool("windows-service", false, "Configure as a Windows Service")
-	requirePrivacy  = flag.Bool("require-privacy", true, "If true, New-SmbGlobalMapping will be called with -RequirePrivacy $true")
-	metricsBindAddr = flag.String("metrics-bind-address", "", "The address the metric endpoint binds to. Defaults to empty in which case metrics are disabled")
-	service         *handler
-	workingDirs     workingDirFlags
+	kubeletPath         = flag.String("kubelet-path", `C:\var\lib\kubelet`, "Prefix path of the kubelet directory in the host file system")
+	windowsSvc          = flag.Bool("windows-service", false, "Configure as a Windows Service")
+	requirePrivacy      = flag.Bool("require-privacy", true, "If true, New-SmbGlobalMapping will be called with -RequirePrivacy $true")
+	metricsBindAddr     = flag.String("metrics-bind-address", "", "The address the metric endpoint binds to. Defaults to empty in which case metrics are disabled")
+	shutdownTimeout     = flag.Duration("shutdown-timeout", server.DefaultShutdownTimeout, "Maximum time to wait for in-flight requests to complete when csi-proxy is stopped")
+	transport           = flag.String("transport", server.TransportPipe, "Transport the API is served on, one of \"pipe\", \"unix\" or \"tcp\"")
+	listenAddress       = flag.String("listen-address", "", "Directory of the unix domain sockets for --transport=unix, or loopback host and first port for --transport=tcp (e.g. 127.0.0.1:9100)")
+	multiplexed         = flag.Bool("multiplexed", false, "If true, all API groups and versions are served on a single endpoint instead of one per API version")
+	enableReflection    = flag.Bool("enable-reflection", false, "If true, the GRPC reflection service is registered on every endpoint, e.g. for grpcurl")
+	auditLogPath        = flag.String("audit-log-path", "", "Path of the file mutating requests are audited to, as JSON records. Defaults to empty in which case auditing is disabled")
+	auditLogMaxSize     = flag.Int("audit-log-max-size", 100, "Maximum size in megabytes of the audit log file before it gets rotated")
+	auditLogMaxBackups  = flag.Int("audit-log-max-backups", 5, "Maximum number of rotated audit log files to retain")
+	authorizationPolicy = flag.String("authorization-policy", "", "Path of the YAML or JSON authorization policy file, defining the named pipes' security descriptors and the methods each client is allowed to call. Defaults to empty in which case all clients able to connect are allowed")
+	configFile          = flag.String("config", "", "Path of the YAML or JSON configuration file, reloaded when it changes or on SCM PARAMCHANGE. Settings it doesn't set keep their command line value")
+	tracingEndpoint     = flag.String("tracing-endpoint", "", "URL of the OTLP gRPC collector the traces are exported to, e.g. http://127.0.0.1:4317 (http disables TLS). Defaults to empty in which case tracing is disabled")
+	tracingSampling     = flag.Float64("tracing-sampling-ratio", 1, "Ratio of the traces sampled, between 0 and 1, when the client didn't propagate a sampling decision")
+	service             *handler
+	workingDirs         workingDirFlags
+	enableGroups        listFlag
+	disableVersions     listFlag
+	diskProtections     = listFlag(config.DiskProtections)
+
+	// fsServer, smbAPI and diskGuard hold the settings updated when the configuration file is reloaded
+	fsServer  *filesystemsrv.Server
+	smbAPI    *smbapi.SmbAPI
+	diskGuard *guard.Guard
+
+	// lockManager serializes the mutating operations on the same disk, volume, SMB share or iSCSI target
+	lockManager = locks.NewManager()
 )
 
 type handler struct {
 	tosvc   chan bool
 	fromsvc chan error
+	// cancel cancels the context passed to the server, which then stops gracefully
+	cancel context.CancelFunc
+	// paramChange receives the SCM PARAMCHANGE requests, that reload the configuration file
+	paramChange chan struct{}
 }
 
 func init() {
 	flag.Var(&workingDirs, "working-dir", "Prefix path of the csi-proxy working directory in the host file system")
+	flag.Var(&enableGroups, "enable-groups", fmt.Sprintf("Comma-separated list of the API groups to serve, among %s. Defaults to all of them", strings.Join(config.APIGroups, ", ")))
+	flag.Var(&disableVersions, "disable-versions", "Comma-separated list of the API versions not to serve, either <version> for all API groups (e.g. v1alpha1) or <group>/<version> (e.g. system/v1alpha1)")
+	flag.Var(&diskProtections, "disk-protection", fmt.Sprintf("Comma-separated list of the disks and volumes the destructive disk and volume operations refuse to act on, among %s (the disks hosting a page file) and formatted (the volumes already formatted, unless the request forces it), empty to protect none", strings.Join(config.DiskProtections[:4], ", ")))
 }
 
 func main() {
@@ -58,64 +125,264 @@ func main() {
 
 	flag.Parse()
 
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
 	if *windowsSvc {
-		if err := initService(); err != nil {
+		if err := initService(cancel); err != nil {
 			panic(err)
 		}
 	}
 
 	klog.Info("Starting CSI-Proxy Server ...")
 	klog.Infof("Version: %s", version)
-	apiGroups, err := apiGroups()
+
+	defaults := flagsConfiguration()
+	cfg := defaults
+	if *configFile != "" {
+		var err error
+		cfg, err = config.Load(*configFile, defaults)
+		if err != nil {
+			panic(err)
+		}
+		klog.Infof("Configuration file: %s", *configFile)
+		setVerbosity(*cfg.Logging.Verbosity)
+	}
+
+	apiGroups, err := apiGroups(cfg)
 	if err != nil {
 		panic(err)
 	}
 
-	enableMetrics := *metricsBindAddr != ""
+	if *configFile != "" {
+		watcher := config.NewWatcher(*configFile, defaults, cfg, config.DefaultWatchInterval, applyConfiguration)
+		go watcher.Run(ctx)
+		if service != nil {
+			go func() {
+				for range service.paramChange {
+					watcher.Reload()
+				}
+			}()
+		}
+	}
+
+	enableMetrics := cfg.Metrics.BindAddress != ""
 	if enableMetrics {
-		err := metrics.SetupMetricsServer(*metricsBindAddr)
+		err := metrics.SetupMetricsServer(cfg.Metrics.BindAddress, map[string]http.Handler{
+			"/debug/operations": lockManager,
+		})
+		if err != nil {
+			panic(err)
+		}
+	}
+	enableTracing := *tracingEndpoint != ""
+	if enableTracing {
+		shutdownTracing, err := tracing.Setup(ctx, *tracingEndpoint, *tracingSampling)
 		if err != nil {
 			panic(err)
 		}
+		defer func() {
+			// ctx is done by now, give the pending spans some time to be exported
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if err := shutdownTracing(shutdownCtx); err != nil {
+				klog.Warningf("Failed to export the pending spans: %v", err)
+			}
+		}()
+		klog.Infof("Exporting traces to %s, sampling ratio: %v", *tracingEndpoint, *tracingSampling)
 	}
-	s := server.NewServer(enableMetrics, apiGroups...)
 
-	if err := s.Start(nil); err != nil {
+	var auditLogger *audit.Logger
+	if *auditLogPath != "" {
+		auditFile, err := audit.NewRotatingFile(*auditLogPath, int64(*auditLogMaxSize)*1024*1024, *auditLogMaxBackups)
+		if err != nil {
+			panic(err)
+		}
+		auditLogger = audit.NewLogger(auditFile)
+		defer auditLogger.Close()
+		klog.Infof("Auditing mutating requests to %s", *auditLogPath)
+	}
+
+	var policy *authz.Policy
+	var pipeSecurityDescriptors map[string]string
+	if *authorizationPolicy != "" {
+		policy, err = authz.LoadPolicy(*authorizationPolicy)
+		if err != nil {
+			panic(err)
+		}
+		pipeSecurityDescriptors = policy.SecurityDescriptors
+		klog.Infof("Authorization policy: %s, %d rule(s)", *authorizationPolicy, len(policy.Rules))
+	}
+
+	listenerFactory, err := server.NewListenerFactory(*transport, *listenAddress, pipeSecurityDescriptors)
+	if err != nil {
+		panic(err)
+	}
+	klog.Infof("Transport: %s, multiplexed: %t", *transport, *multiplexed)
+
+	s, err := server.NewServer(server.Config{
+		EnableMetrics:       enableMetrics,
+		ShutdownTimeout:     *shutdownTimeout,
+		ListenerFactory:     listenerFactory,
+		Multiplexed:         *multiplexed,
+		EnableReflection:    *enableReflection,
+		AuditLogger:         auditLogger,
+		AuthorizationPolicy: policy,
+		EnabledGroups:       cfg.APIGroups,
+		DisabledVersions:    cfg.DisabledVersions,
+		EnableTracing:       enableTracing,
+	}, apiGroups...)
+	if err != nil {
 		panic(err)
 	}
+
+	if err := s.Start(ctx, nil); err != nil {
+		panic(err)
+	}
+	klog.Info("CSI-Proxy Server stopped")
+
+	if service != nil {
+		// let the service handler report to the SCM that the service is stopped
+		close(service.tosvc)
+		<-service.fromsvc
+	}
 }
 
-// apiGroups returns the list of enabled API groups.
-func apiGroups() ([]srvtypes.APIGroup, error) {
-	workingDirs = append(workingDirs, *kubeletPath)
-	fssrv, err := filesystemsrv.NewServer(workingDirs, filesystemapi.New())
+// flagsConfiguration returns the configuration set by the command line flags.
+func flagsConfiguration() *config.Configuration {
+	verbosity := 0
+	if v := flag.Lookup("v"); v != nil {
+		verbosity, _ = strconv.Atoi(v.Value.String())
+	}
+
+	apiGroups := config.APIGroups
+	if len(enableGroups) != 0 {
+		apiGroups = enableGroups
+	}
+
+	diskProtection, err := config.NewDiskProtectionConfiguration(diskProtections)
+	if err != nil {
+		panic(err)
+	}
+
+	return &config.Configuration{
+		APIVersion:       config.APIVersion,
+		Kind:             config.Kind,
+		APIGroups:        apiGroups,
+		DisabledVersions: disableVersions,
+		KubeletPath:      *kubeletPath,
+		WorkingDirs:      workingDirs,
+		SMB:              config.SMBConfiguration{RequirePrivacy: requirePrivacy},
+		DiskProtection:   diskProtection,
+		Metrics:          config.MetricsConfiguration{BindAddress: *metricsBindAddr},
+		Logging:          config.LoggingConfiguration{Verbosity: &verbosity},
+	}
+}
+
+// applyConfiguration applies the settings of a reloaded configuration file.
+func applyConfiguration(previous, current *config.Configuration) {
+	fsServer.SetWorkingDirs(current.AllWorkingDirs())
+	klog.Infof("Working directories: %v", fsServer.GetWorkingDirs())
+
+	smbAPI.SetRequirePrivacy(*current.SMB.RequirePrivacy)
+	klog.Infof("Require privacy: %t", *current.SMB.RequirePrivacy)
+
+	diskGuard.SetPolicy(guardPolicy(current.DiskProtection))
+	klog.Infof("Disk protection: %+v", diskGuard.Policy())
+
+	setVerbosity(*current.Logging.Verbosity)
+
+	if !reflect.DeepEqual(previous.APIGroups, current.APIGroups) {
+		klog.Warningf("Changing the API groups from %v to %v requires restarting csi-proxy", previous.APIGroups, current.APIGroups)
+	}
+	if !reflect.DeepEqual(previous.DisabledVersions, current.DisabledVersions) {
+		klog.Warningf("Changing the disabled versions from %v to %v requires restarting csi-proxy", previous.DisabledVersions, current.DisabledVersions)
+	}
+	if previous.Metrics.BindAddress != current.Metrics.BindAddress {
+		klog.Warningf("Changing the metrics bind address from %q to %q requires restarting csi-proxy", previous.Metrics.BindAddress, current.Metrics.BindAddress)
+	}
+}
+
+// guardPolicy returns the guard policy of the disk protection configuration.
+func guardPolicy(diskProtection config.DiskProtectionConfiguration) guard.Policy {
+	return guard.Policy{
+		BootDisks:        *diskProtection.BootDisks,
+		SystemDisks:      *diskProtection.SystemDisks,
+		ClusteredDisks:   *diskProtection.ClusteredDisks,
+		PageFileDisks:    *diskProtection.PageFileDisks,
+		FormattedVolumes: *diskProtection.FormattedVolumes,
+	}
+}
+
+func setVerbosity(verbosity int) {
+	if err := flag.Set("v", strconv.Itoa(verbosity)); err != nil {
+		klog.Errorf("failed to set the log verbosity to %d: %v", verbosity, err)
+	}
+}
+
+// apiGroups returns the list of API groups, the server serves the ones enabled by the configuration.
+func apiGroups(cfg *config.Configuration) ([]srvtypes.APIGroup, error) {
+	fssrv, err := filesystemsrv.NewServer(cfg.AllWorkingDirs(), filesystemapi.New())
 	if err != nil {
 		return []srvtypes.APIGroup{}, err
 	}
+	fsServer = fssrv
 	klog.Infof("Working directories: %v", fssrv.GetWorkingDirs())
-	klog.Infof("Require privacy: %t", *requirePrivacy)
+	klog.Infof("Require privacy: %t", *cfg.SMB.RequirePrivacy)
+
+	// the host APIs are instrumented when the metrics are enabled
+	var volumeAPI volumeapi.API = volumeapi.New()
+	var diskAPI diskapi.API = diskapi.New()
+	smbAPI = smbapi.New(*cfg.SMB.RequirePrivacy)
+	var smbHostAPI smbapi.API = smbAPI
+	var sysAPI syssrv.API = sysapi.New()
+	var iscsiAPI iscsisrv.API = iscsiapi.New()
+	var snapshotAPI snapshotapi.API = snapshotapi.New()
+	var encryptionAPI encryptionapi.API = encryptionapi.New()
+	if cfg.Metrics.BindAddress != "" {
+		volumeAPI = volumesrv.NewInstrumentedAPI(volumeAPI)
+		diskAPI = disksrv.NewInstrumentedAPI(diskAPI)
+		smbHostAPI = smbsrv.NewInstrumentedAPI(smbHostAPI)
+		sysAPI = syssrv.NewInstrumentedAPI(sysAPI)
+		iscsiAPI = iscsisrv.NewInstrumentedAPI(iscsiAPI)
+		snapshotAPI = snapshotsrv.NewInstrumentedAPI(snapshotAPI)
+		encryptionAPI = encryptionsrv.NewInstrumentedAPI(encryptionAPI)
+	}
 
-	volumesrv, err := volumesrv.NewServer(volumeapi.New())
+	diskGuard = guard.NewGuard(diskAPI, guardPolicy(cfg.DiskProtection))
+	klog.Infof("Disk protection: %+v", diskGuard.Policy())
+
+	volumesrv, err := volumesrv.NewServer(volumeAPI, lockManager, diskGuard)
+	if err != nil {
+		return []srvtypes.APIGroup{}, err
+	}
+
+	disksrv, err := disksrv.NewServer(diskAPI, lockManager, diskGuard)
 	if err != nil {
 		return []srvtypes.APIGroup{}, err
 	}
 
-	disksrv, err := disksrv.NewServer(diskapi.New())
+	smbsrv, err := smbsrv.NewServer(smbHostAPI, fssrv, lockManager)
 	if err != nil {
 		return []srvtypes.APIGroup{}, err
 	}
 
-	smbsrv, err := smbsrv.NewServer(smbapi.New(*requirePrivacy), fssrv)
+	syssrv, err := syssrv.NewServer(sysAPI)
 	if err != nil {
 		return []srvtypes.APIGroup{}, err
 	}
 
-	syssrv, err := syssrv.NewServer(sysapi.New())
+	iscsisrv, err := iscsisrv.NewServer(iscsiAPI, lockManager)
 	if err != nil {
 		return []srvtypes.APIGroup{}, err
 	}
 
-	iscsisrv, err := iscsisrv.NewServer(iscsiapi.New())
+	snapshotsrv, err := snapshotsrv.NewServer(snapshotAPI, fssrv, lockManager)
+	if err != nil {
+		return []srvtypes.APIGroup{}, err
+	}
+
+	encryptionsrv, err := encryptionsrv.NewServer(encryptionAPI, volumeAPI, lockManager, diskGuard)
 	if err != nil {
 		return []srvtypes.APIGroup{}, err
 	}
@@ -127,16 +394,20 @@ func apiGroups() ([]srvtypes.APIGroup, error) {
 		smbsrv,
 		syssrv,
 		iscsisrv,
+		snapshotsrv,
+		encryptionsrv,
 	}, nil
 }
 
 // configure as a Windows service managed by Windows SCM
 // code borrowed from
 // https://github.com/kubernetes/kubernetes/blob/323f34858de18b862d43c40b2cced65ad8e24052/pkg/windows/service/service.go
-func initService() error {
+func initService(cancel context.CancelFunc) error {
 	h := &handler{
-		tosvc:   make(chan bool),
-		fromsvc: make(chan error),
+		tosvc:       make(chan bool),
+		fromsvc:     make(chan error),
+		cancel:      cancel,
+		paramChange: make(chan struct{}, 1),
 	}
 
 	service = h
@@ -171,11 +442,20 @@ Loop:
 			switch c.Cmd {
 			case svc.Interrogate:
 				s <- c.CurrentStatus
+			case svc.ParamChange:
+				klog.Infof("Windows Service parameters change requested through SCM")
+				s <- c.CurrentStatus
+				select {
+				case h.paramChange <- struct{}{}:
+				default:
+					// a reload is already pending
+				}
 			case svc.Stop, svc.Shutdown:
-				// todo: need to add a ctx to servers
-				// from main and cancel it from here
-				s <- svc.Status{State: svc.StopPending}
-				break Loop
+				// cancel the servers' context, main closes tosvc once the
+				// in-flight requests are done or the shutdown timeout is exceeded
+				klog.Infof("Windows Service stop requested through SCM")
+				s <- svc.Status{State: svc.StopPending, WaitHint: uint32(shutdownTimeout.Milliseconds())}
+				h.cancel()
 			}
 		}
 	}
@@ -8,6 +8,9 @@ import (
 // the host name
 // Skip on Github Actions as it is expected to fail
 func TestDiskAPIGroup(t *testing.T) {
+	t.Run("v2alpha1Tests", func(t *testing.T) {
+		v2alpha1DiskTests(t)
+	})
 	t.Run("v1Tests", func(t *testing.T) {
 		v1DiskTests(t)
 	})
@@ -0,0 +1,317 @@
+package integrationtests
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/kubernetes-csi/csi-proxy/client/api/disk/v2alpha1"
+	diskv2alpha1client "github.com/kubernetes-csi/csi-proxy/client/groups/disk/v2alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func v2alpha1DiskTests(t *testing.T) {
+	t.Run("ListDisks,GetDiskInfo", func(t *testing.T) {
+		client, err := diskv2alpha1client.NewClient()
+		require.NoError(t, err)
+		defer client.Close()
+
+		// initialize disk
+		vhd, vhdCleanup := diskInit(t)
+		defer vhdCleanup()
+
+		listResponse, err := client.ListDisks(context.TODO(), &v2alpha1.ListDisksRequest{})
+		require.NoError(t, err)
+		t.Logf("listResponse=%v", listResponse)
+		listedDisk, ok := listResponse.Disks[vhd.DiskNumber]
+		require.True(t, ok, "disk %d not listed", vhd.DiskNumber)
+
+		// the boot disk is always listed
+		bootDisks := 0
+		for _, disk := range listResponse.Disks {
+			if disk.IsBoot {
+				bootDisks++
+			}
+		}
+		assert.Equal(t, 1, bootDisks, "Expected exactly one boot disk")
+
+		getResponse, err := client.GetDiskInfo(context.TODO(), &v2alpha1.GetDiskInfoRequest{DiskNumber: vhd.DiskNumber})
+		require.NoError(t, err)
+		disk := getResponse.Disk
+		t.Logf("disk=%v", disk)
+		assert.Equal(t, listedDisk.String(), disk.String())
+
+		assert.Equal(t, vhd.DiskNumber, disk.DiskNumber)
+		if !sizeIsAround(t, int64(disk.Size), vhd.InitialSize) {
+			t.Errorf("GetDiskInfo doesn't have the expected size, wanted (close to)=%d got=%d", vhd.InitialSize, disk.Size)
+		}
+		assert.Equal(t, v2alpha1.PartitionStyle_PARTITION_STYLE_GPT, disk.PartitionStyle)
+		assert.Equal(t, v2alpha1.BusType_BUS_TYPE_FILE_BACKED_VIRTUAL, disk.BusType)
+		assert.NotZero(t, disk.LogicalSectorSize)
+		assert.NotZero(t, disk.PhysicalSectorSize)
+		assert.False(t, disk.IsBoot)
+		assert.False(t, disk.IsSystem)
+		assert.False(t, disk.IsReadOnly)
+		assert.False(t, disk.IsOffline)
+		assert.Equal(t, v2alpha1.OfflineReason_OFFLINE_REASON_NONE, disk.OfflineReason)
+	})
+	t.Run("PartitionDisk,ListPartitions,DeletePartition", func(t *testing.T) {
+		client, err := diskv2alpha1client.NewClient()
+		require.NoError(t, err)
+		defer client.Close()
+
+		// initialize disk, it has one basic partition using the maximum size
+		vhd, vhdCleanup := diskInit(t)
+		defer vhdCleanup()
+
+		listResponse, err := client.ListPartitions(context.TODO(), &v2alpha1.ListPartitionsRequest{DiskNumber: vhd.DiskNumber})
+		require.NoError(t, err)
+		t.Logf("listResponse=%v", listResponse)
+		var basicPartition *v2alpha1.PartitionInfo
+		for _, partition := range listResponse.Partitions {
+			if partition.GptType == "{ebd0a0a2-b9e5-4433-87c0-68b6b72699c7}" {
+				basicPartition = partition
+			}
+		}
+		require.NotNil(t, basicPartition, "basic partition not listed")
+
+		// the disk is already partitioned
+		partitionResponse, err := client.PartitionDisk(context.TODO(), &v2alpha1.PartitionDiskRequest{DiskNumber: vhd.DiskNumber})
+		require.NoError(t, err)
+		assert.Equal(t, basicPartition.PartitionNumber, partitionResponse.PartitionNumber)
+
+		_, err = client.PartitionDisk(context.TODO(), &v2alpha1.PartitionDiskRequest{
+			DiskNumber:     vhd.DiskNumber,
+			PartitionStyle: v2alpha1.PartitionStyle_PARTITION_STYLE_MBR,
+		})
+		require.Error(t, err, "the disk has the GPT partition style")
+
+		_, err = client.DeletePartition(context.TODO(), &v2alpha1.DeletePartitionRequest{
+			DiskNumber:      vhd.DiskNumber,
+			PartitionNumber: basicPartition.PartitionNumber,
+		})
+		require.NoError(t, err)
+
+		// carve two partitions, the first one twice to check that the request can be retried
+		const size = 256 * 1024 * 1024
+		firstRequest := &v2alpha1.PartitionDiskRequest{
+			DiskNumber: vhd.DiskNumber,
+			Size:       size,
+			Offset:     basicPartition.Offset,
+			Name:       "csi-proxy-first",
+			Append:     true,
+		}
+		firstResponse, err := client.PartitionDisk(context.TODO(), firstRequest)
+		require.NoError(t, err)
+		retryResponse, err := client.PartitionDisk(context.TODO(), firstRequest)
+		require.NoError(t, err)
+		assert.Equal(t, firstResponse.PartitionNumber, retryResponse.PartitionNumber)
+
+		secondResponse, err := client.PartitionDisk(context.TODO(), &v2alpha1.PartitionDiskRequest{
+			DiskNumber: vhd.DiskNumber,
+			Size:       size,
+			Append:     true,
+		})
+		require.NoError(t, err)
+		assert.NotEqual(t, firstResponse.PartitionNumber, secondResponse.PartitionNumber)
+
+		listResponse, err = client.ListPartitions(context.TODO(), &v2alpha1.ListPartitionsRequest{DiskNumber: vhd.DiskNumber})
+		require.NoError(t, err)
+		t.Logf("listResponse=%v", listResponse)
+		sizes := map[uint32]uint64{}
+		for _, partition := range listResponse.Partitions {
+			sizes[partition.PartitionNumber] = partition.Size
+		}
+		assert.Equal(t, uint64(size), sizes[firstResponse.PartitionNumber])
+		assert.Equal(t, uint64(size), sizes[secondResponse.PartitionNumber])
+	})
+	t.Run("ListDiskIDs,FindDisk", func(t *testing.T) {
+		client, err := diskv2alpha1client.NewClient()
+		require.NoError(t, err)
+		defer client.Close()
+
+		// initialize disk
+		vhd, vhdCleanup := diskInit(t)
+		defer vhdCleanup()
+
+		listResponse, err := client.ListDiskIDs(context.TODO(), &v2alpha1.ListDiskIDsRequest{})
+		require.NoError(t, err)
+		ids, ok := listResponse.DiskIDs[vhd.DiskNumber]
+		require.True(t, ok, "disk %d not listed", vhd.DiskNumber)
+		t.Logf("ids=%v", ids)
+		require.NotEmpty(t, ids.Identifiers)
+
+		var deviceIdentifier *v2alpha1.DiskIdentifier
+		for _, identifier := range ids.Identifiers {
+			if identifier.Association == v2alpha1.IdentifierAssociation_IDENTIFIER_ASSOCIATION_DEVICE {
+				deviceIdentifier = identifier
+				break
+			}
+		}
+		require.NotNil(t, deviceIdentifier, "no identifier of the disk itself")
+
+		findResponse, err := client.FindDisk(context.TODO(), &v2alpha1.FindDiskRequest{Identifier: deviceIdentifier.Value})
+		require.NoError(t, err)
+		assert.Equal(t, vhd.DiskNumber, findResponse.DiskNumber)
+
+		if ids.Page83 != "" {
+			findResponse, err = client.FindDisk(context.TODO(), &v2alpha1.FindDiskRequest{
+				Identifier:     ids.Page83,
+				IdentifierKind: v2alpha1.IdentifierKind_IDENTIFIER_KIND_PAGE83,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, vhd.DiskNumber, findResponse.DiskNumber)
+		}
+
+		_, err = client.FindDisk(context.TODO(), &v2alpha1.FindDiskRequest{Identifier: "no-such-disk"})
+		require.Error(t, err)
+	})
+	t.Run("WaitForDisk", func(t *testing.T) {
+		client, err := diskv2alpha1client.NewClient()
+		require.NoError(t, err)
+		defer client.Close()
+
+		// initialize disk
+		vhd, vhdCleanup := diskInit(t)
+		defer vhdCleanup()
+
+		listResponse, err := client.ListDiskIDs(context.TODO(), &v2alpha1.ListDiskIDsRequest{})
+		require.NoError(t, err)
+		ids, ok := listResponse.DiskIDs[vhd.DiskNumber]
+		require.True(t, ok, "disk %d not listed", vhd.DiskNumber)
+		require.NotEmpty(t, ids.Page83)
+
+		_, err = client.SetDiskState(context.TODO(), &v2alpha1.SetDiskStateRequest{DiskNumber: vhd.DiskNumber, IsOnline: false})
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.TODO(), 30*time.Second)
+		defer cancel()
+		waitResponse, err := client.WaitForDisk(ctx, &v2alpha1.WaitForDiskRequest{
+			Identifier:     ids.Page83,
+			IdentifierKind: v2alpha1.IdentifierKind_IDENTIFIER_KIND_PAGE83,
+			SetOnline:      true,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, vhd.DiskNumber, waitResponse.DiskNumber)
+		assert.False(t, waitResponse.Disk.IsOffline)
+
+		ctx, cancel = context.WithTimeout(context.TODO(), 5*time.Second)
+		defer cancel()
+		_, err = client.WaitForDisk(ctx, &v2alpha1.WaitForDiskRequest{Identifier: "no-such-disk"})
+		require.Error(t, err)
+	})
+
+	t.Run("ClearDisk", func(t *testing.T) {
+		client, err := diskv2alpha1client.NewClient()
+		require.NoError(t, err)
+		defer client.Close()
+
+		// initialize disk, it has one basic partition using the maximum size
+		vhd, vhdCleanup := diskInit(t)
+		defer vhdCleanup()
+
+		// clearing twice checks that an uninitialized disk can be cleared
+		for i := 0; i < 2; i++ {
+			stream, err := client.ClearDisk(context.TODO(), &v2alpha1.ClearDiskRequest{
+				DiskNumber: vhd.DiskNumber,
+				ZeroMode:   v2alpha1.ZeroMode_ZERO_MODE_EDGES,
+			})
+			require.NoError(t, err)
+
+			var last *v2alpha1.ClearDiskResponse
+			for {
+				response, err := stream.Recv()
+				if err == io.EOF {
+					break
+				}
+				require.NoError(t, err)
+				last = response
+			}
+			require.NotNil(t, last)
+			assert.Equal(t, v2alpha1.ClearDiskStage_CLEAR_DISK_STAGE_DONE, last.Stage)
+			assert.Equal(t, uint64(64*1024*1024), last.BytesZeroed)
+		}
+
+		infoResponse, err := client.GetDiskInfo(context.TODO(), &v2alpha1.GetDiskInfoRequest{DiskNumber: vhd.DiskNumber})
+		require.NoError(t, err)
+		assert.Equal(t, v2alpha1.PartitionStyle_PARTITION_STYLE_UNKNOWN, infoResponse.Disk.PartitionStyle)
+	})
+
+	t.Run("SetDiskAttributes,GetDiskAttributes", func(t *testing.T) {
+		client, err := diskv2alpha1client.NewClient()
+		require.NoError(t, err)
+		defer client.Close()
+
+		// initialize disk
+		vhd, vhdCleanup := diskInit(t)
+		defer vhdCleanup()
+
+		getResponse, err := client.GetDiskAttributes(context.TODO(), &v2alpha1.GetDiskAttributesRequest{DiskNumber: vhd.DiskNumber})
+		require.NoError(t, err)
+		assert.False(t, getResponse.Attributes.IsReadOnly)
+		assert.False(t, getResponse.Attributes.IsOffline)
+		assert.NotEqual(t, v2alpha1.SanPolicy_SAN_POLICY_UNKNOWN, getResponse.Attributes.SanPolicy)
+
+		// setting the current SAN policy is a no-op
+		setResponse, err := client.SetDiskAttributes(context.TODO(), &v2alpha1.SetDiskAttributesRequest{
+			DiskNumber:  vhd.DiskNumber,
+			SetReadOnly: true,
+			IsReadOnly:  true,
+			SanPolicy:   getResponse.Attributes.SanPolicy,
+		})
+		require.NoError(t, err)
+		assert.True(t, setResponse.Attributes.IsReadOnly)
+		assert.Equal(t, getResponse.Attributes.SanPolicy, setResponse.Attributes.SanPolicy)
+
+		for i := 0; i < 2; i++ {
+			setResponse, err = client.SetDiskAttributes(context.TODO(), &v2alpha1.SetDiskAttributesRequest{DiskNumber: vhd.DiskNumber, SetReadOnly: true})
+			require.NoError(t, err)
+			assert.False(t, setResponse.Attributes.IsReadOnly)
+		}
+	})
+
+	t.Run("WatchDisks", func(t *testing.T) {
+		client, err := diskv2alpha1client.NewClient()
+		require.NoError(t, err)
+		defer client.Close()
+
+		// initialize disk
+		vhd, vhdCleanup := diskInit(t)
+		defer vhdCleanup()
+
+		ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Minute)
+		defer cancel()
+		stream, err := client.WatchDisks(ctx, &v2alpha1.WatchDisksRequest{PollIntervalSeconds: 1})
+		require.NoError(t, err)
+
+		// the current disks come first
+		found := false
+		for {
+			event, err := stream.Recv()
+			require.NoError(t, err)
+			if event.Type == v2alpha1.DiskEventType_DISK_EVENT_TYPE_SYNCED {
+				break
+			}
+			require.Equal(t, v2alpha1.DiskEventType_DISK_EVENT_TYPE_EXISTING, event.Type)
+			if event.DiskNumber == vhd.DiskNumber {
+				found = true
+				assert.False(t, event.Disk.IsOffline)
+			}
+		}
+		require.True(t, found, "disk %d not sent", vhd.DiskNumber)
+
+		_, err = client.SetDiskState(context.TODO(), &v2alpha1.SetDiskStateRequest{DiskNumber: vhd.DiskNumber, IsOnline: false})
+		require.NoError(t, err)
+		for {
+			event, err := stream.Recv()
+			require.NoError(t, err)
+			if event.DiskNumber == vhd.DiskNumber {
+				assert.Equal(t, v2alpha1.DiskEventType_DISK_EVENT_TYPE_OFFLINE, event.Type)
+				assert.True(t, event.Disk.IsOffline)
+				break
+			}
+		}
+	})
+}
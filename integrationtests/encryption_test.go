@@ -0,0 +1,110 @@
+package integrationtests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kubernetes-csi/csi-proxy/client/api/encryption/v1alpha1"
+	volumev2alpha2 "github.com/kubernetes-csi/csi-proxy/client/api/volume/v2alpha2"
+	v1alpha1client "github.com/kubernetes-csi/csi-proxy/client/groups/encryption/v1alpha1"
+	volumev2alpha2client "github.com/kubernetes-csi/csi-proxy/client/groups/volume/v2alpha2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEncryptionAPIGroup(t *testing.T) {
+	t.Run("v1alpha1Tests", func(t *testing.T) {
+		v1alpha1EncryptionTests(t)
+	})
+}
+
+func v1alpha1EncryptionTests(t *testing.T) {
+	encryptionClient, err := v1alpha1client.NewClient()
+	require.NoError(t, err)
+	defer encryptionClient.Close()
+
+	volumeClient, err := volumev2alpha2client.NewClient()
+	require.NoError(t, err)
+	defer volumeClient.Close()
+
+	vhd, vhdCleanup := diskInit(t)
+	defer vhdCleanup()
+
+	listResponse, err := volumeClient.ListVolumesOnDisk(context.TODO(), &volumev2alpha2.ListVolumesOnDiskRequest{DiskNumber: vhd.DiskNumber})
+	require.NoError(t, err)
+	require.Len(t, listResponse.VolumeIds, 1)
+	volumeID := listResponse.VolumeIds[0]
+
+	_, err = volumeClient.FormatVolume(context.TODO(), &volumev2alpha2.FormatVolumeRequest{VolumeId: volumeID})
+	require.NoError(t, err)
+	_, err = volumeClient.MountVolume(context.TODO(), &volumev2alpha2.MountVolumeRequest{VolumeId: volumeID, TargetPath: vhd.Mount})
+	require.NoError(t, err)
+	defer func() {
+		_, err := volumeClient.UnmountVolume(context.TODO(), &volumev2alpha2.UnmountVolumeRequest{VolumeId: volumeID, TargetPath: vhd.Mount})
+		assert.NoError(t, err)
+	}()
+
+	statusResponse, err := encryptionClient.GetEncryptionStatus(context.TODO(), &v1alpha1.GetEncryptionStatusRequest{VolumeId: volumeID})
+	if err != nil {
+		t.Skipf("BitLocker is not available: %v", err)
+	}
+	assert.Equal(t, v1alpha1.ConversionStatus_CONVERSION_STATUS_FULLY_DECRYPTED, statusResponse.ConversionStatus)
+	assert.Equal(t, v1alpha1.EncryptionMethod_ENCRYPTION_METHOD_NONE, statusResponse.EncryptionMethod)
+
+	const password = "csi-proxy-integration-test"
+	enableResponse, err := encryptionClient.EnableEncryption(context.TODO(), &v1alpha1.EnableEncryptionRequest{
+		VolumeId:         volumeID,
+		KeyProtectorType: v1alpha1.KeyProtectorType_KEY_PROTECTOR_TYPE_PASSWORD,
+		Secret:           password,
+		UsedSpaceOnly:    true,
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, enableResponse.KeyProtectorId)
+
+	waitForConversionStatus(t, encryptionClient, volumeID, v1alpha1.ConversionStatus_CONVERSION_STATUS_FULLY_ENCRYPTED)
+	statusResponse, err = encryptionClient.GetEncryptionStatus(context.TODO(), &v1alpha1.GetEncryptionStatusRequest{VolumeId: volumeID})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(100), statusResponse.EncryptionPercentage)
+	assert.Equal(t, v1alpha1.ProtectionStatus_PROTECTION_STATUS_ON, statusResponse.ProtectionStatus)
+	assert.Equal(t, v1alpha1.EncryptionMethod_ENCRYPTION_METHOD_XTS_AES_128, statusResponse.EncryptionMethod)
+
+	_, err = encryptionClient.Lock(context.TODO(), &v1alpha1.LockRequest{VolumeId: volumeID, ForceDismount: true})
+	require.NoError(t, err)
+	statusResponse, err = encryptionClient.GetEncryptionStatus(context.TODO(), &v1alpha1.GetEncryptionStatusRequest{VolumeId: volumeID})
+	require.NoError(t, err)
+	assert.True(t, statusResponse.Locked)
+
+	_, err = encryptionClient.Unlock(context.TODO(), &v1alpha1.UnlockRequest{
+		VolumeId:         volumeID,
+		KeyProtectorType: v1alpha1.KeyProtectorType_KEY_PROTECTOR_TYPE_PASSWORD,
+		Secret:           "wrong-password",
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "err=%v", err)
+
+	_, err = encryptionClient.Unlock(context.TODO(), &v1alpha1.UnlockRequest{
+		VolumeId:         volumeID,
+		KeyProtectorType: v1alpha1.KeyProtectorType_KEY_PROTECTOR_TYPE_PASSWORD,
+		Secret:           password,
+	})
+	require.NoError(t, err)
+	statusResponse, err = encryptionClient.GetEncryptionStatus(context.TODO(), &v1alpha1.GetEncryptionStatusRequest{VolumeId: volumeID})
+	require.NoError(t, err)
+	assert.False(t, statusResponse.Locked)
+
+	_, err = encryptionClient.DisableEncryption(context.TODO(), &v1alpha1.DisableEncryptionRequest{VolumeId: volumeID})
+	require.NoError(t, err)
+	waitForConversionStatus(t, encryptionClient, volumeID, v1alpha1.ConversionStatus_CONVERSION_STATUS_FULLY_DECRYPTED)
+}
+
+// waitForConversionStatus waits for the encryption or the decryption of a volume to complete.
+func waitForConversionStatus(t *testing.T, encryptionClient *v1alpha1client.Client, volumeID string, expected v1alpha1.ConversionStatus) {
+	require.Eventually(t, func() bool {
+		statusResponse, err := encryptionClient.GetEncryptionStatus(context.TODO(), &v1alpha1.GetEncryptionStatusRequest{VolumeId: volumeID})
+		require.NoError(t, err)
+		t.Logf("conversion status %v, %d%% encrypted", statusResponse.ConversionStatus, statusResponse.EncryptionPercentage)
+		return statusResponse.ConversionStatus == expected
+	}, 5*time.Minute, 5*time.Second)
+}
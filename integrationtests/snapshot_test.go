@@ -0,0 +1,84 @@
+package integrationtests
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kubernetes-csi/csi-proxy/client/api/snapshot/v1alpha1"
+	volumev2alpha2 "github.com/kubernetes-csi/csi-proxy/client/api/volume/v2alpha2"
+	v1alpha1client "github.com/kubernetes-csi/csi-proxy/client/groups/snapshot/v1alpha1"
+	volumev2alpha2client "github.com/kubernetes-csi/csi-proxy/client/groups/volume/v2alpha2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSnapshotAPIGroup(t *testing.T) {
+	t.Run("v1alpha1Tests", func(t *testing.T) {
+		v1alpha1SnapshotTests(t)
+	})
+}
+
+func v1alpha1SnapshotTests(t *testing.T) {
+	snapshotClient, err := v1alpha1client.NewClient()
+	require.NoError(t, err)
+	defer snapshotClient.Close()
+
+	volumeClient, err := volumev2alpha2client.NewClient()
+	require.NoError(t, err)
+	defer volumeClient.Close()
+
+	vhd, vhdCleanup := diskInit(t)
+	defer vhdCleanup()
+
+	listResponse, err := volumeClient.ListVolumesOnDisk(context.TODO(), &volumev2alpha2.ListVolumesOnDiskRequest{DiskNumber: vhd.DiskNumber})
+	require.NoError(t, err)
+	require.Len(t, listResponse.VolumeIds, 1)
+	volumeID := listResponse.VolumeIds[0]
+
+	_, err = volumeClient.FormatVolume(context.TODO(), &volumev2alpha2.FormatVolumeRequest{VolumeId: volumeID})
+	require.NoError(t, err)
+	_, err = volumeClient.MountVolume(context.TODO(), &volumev2alpha2.MountVolumeRequest{VolumeId: volumeID, TargetPath: vhd.Mount})
+	require.NoError(t, err)
+	defer func() {
+		_, err := volumeClient.UnmountVolume(context.TODO(), &volumev2alpha2.UnmountVolumeRequest{VolumeId: volumeID, TargetPath: vhd.Mount})
+		assert.NoError(t, err)
+	}()
+
+	require.NoError(t, os.WriteFile(filepath.Join(vhd.Mount, "before"), []byte("before"), 0644))
+
+	createResponse, err := snapshotClient.CreateShadowCopy(context.TODO(), &v1alpha1.CreateShadowCopyRequest{VolumeId: volumeID})
+	require.NoError(t, err)
+	shadowCopy := createResponse.ShadowCopy
+	assert.Equal(t, volumeID, shadowCopy.VolumeId)
+	assert.NotEmpty(t, shadowCopy.DeviceObject)
+	defer func() {
+		_, err := snapshotClient.DeleteShadowCopy(context.TODO(), &v1alpha1.DeleteShadowCopyRequest{ShadowCopyId: shadowCopy.Id})
+		assert.NoError(t, err)
+	}()
+
+	require.NoError(t, os.WriteFile(filepath.Join(vhd.Mount, "after"), []byte("after"), 0644))
+
+	shadowCopiesResponse, err := snapshotClient.ListShadowCopies(context.TODO(), &v1alpha1.ListShadowCopiesRequest{VolumeId: volumeID})
+	require.NoError(t, err)
+	require.Len(t, shadowCopiesResponse.ShadowCopies, 1)
+	assert.Equal(t, shadowCopy.Id, shadowCopiesResponse.ShadowCopies[0].Id)
+
+	exposedPath := filepath.Join(vhd.TestPluginPath, "shadow-copy")
+	_, err = snapshotClient.ExposeShadowCopy(context.TODO(), &v1alpha1.ExposeShadowCopyRequest{ShadowCopyId: shadowCopy.Id, Path: exposedPath})
+	require.NoError(t, err)
+	defer os.Remove(exposedPath)
+
+	// the shadow copy has the content of the volume when it was taken
+	content, err := os.ReadFile(filepath.Join(exposedPath, "before"))
+	require.NoError(t, err)
+	assert.Equal(t, "before", string(content))
+	_, err = os.Stat(filepath.Join(exposedPath, "after"))
+	assert.True(t, os.IsNotExist(err), "err=%v", err)
+
+	_, err = snapshotClient.ExposeShadowCopy(context.TODO(), &v1alpha1.ExposeShadowCopyRequest{ShadowCopyId: shadowCopy.Id, Path: `C:\Windows\shadow-copy`})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "err=%v", err)
+}
@@ -1,6 +1,7 @@
 package integrationtests
 
 import (
+	"context"
 	"crypto/md5"
 	"encoding/hex"
 	"fmt"
@@ -26,11 +27,12 @@ import (
 
 // startServer starts the proxy's GRPC servers, and returns a function to shut them down when done with testing
 func startServer(t *testing.T, apiGroups ...srvtypes.APIGroup) func() {
-	s := server.NewServer(true, apiGroups...)
+	s, err := server.NewServer(server.Config{EnableMetrics: true}, apiGroups...)
+	require.Nil(t, err)
 
 	listeningChan := make(chan interface{})
 	go func() {
-		assert.Nil(t, s.Start(listeningChan))
+		assert.Nil(t, s.Start(context.Background(), listeningChan))
 	}()
 
 	select {
@@ -170,4 +170,7 @@ func TestVolumeAPIs(t *testing.T) {
 	t.Run("v2alpha1Tests", func(t *testing.T) {
 		v2alpha1VolumeTests(t)
 	})
+	t.Run("v2alpha2Tests", func(t *testing.T) {
+		v2alpha2VolumeTests(t)
+	})
 }
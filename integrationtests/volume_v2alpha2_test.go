@@ -0,0 +1,138 @@
+package integrationtests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kubernetes-csi/csi-proxy/client/api/volume/v2alpha2"
+	v2alpha2client "github.com/kubernetes-csi/csi-proxy/client/groups/volume/v2alpha2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func v2alpha2FormatVolumeTests(volumeClient *v2alpha2client.Client, t *testing.T) {
+	vhd, vhdCleanup := diskInit(t)
+	defer vhdCleanup()
+
+	listResponse, err := volumeClient.ListVolumesOnDisk(context.TODO(), &v2alpha2.ListVolumesOnDiskRequest{DiskNumber: vhd.DiskNumber})
+	require.NoError(t, err)
+	require.Len(t, listResponse.VolumeIds, 1)
+	volumeID := listResponse.VolumeIds[0]
+
+	_, err = volumeClient.FormatVolume(context.TODO(), &v2alpha2.FormatVolumeRequest{VolumeId: volumeID})
+	require.NoError(t, err)
+
+	// the volume has a file system now
+	_, err = volumeClient.FormatVolume(context.TODO(), &v2alpha2.FormatVolumeRequest{VolumeId: volumeID})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "err=%v", err)
+
+	_, err = volumeClient.FormatVolume(context.TODO(), &v2alpha2.FormatVolumeRequest{VolumeId: volumeID, Force: true})
+	assert.NoError(t, err)
+
+	formattedResponse, err := volumeClient.IsVolumeFormatted(context.TODO(), &v2alpha2.IsVolumeFormattedRequest{VolumeId: volumeID})
+	require.NoError(t, err)
+	assert.True(t, formattedResponse.Formatted)
+	assert.Equal(t, "NTFS", formattedResponse.FileSystem)
+
+	_, err = volumeClient.FormatVolume(context.TODO(), &v2alpha2.FormatVolumeRequest{
+		VolumeId:           volumeID,
+		Force:              true,
+		FileSystem:         "exfat",
+		Label:              "DATA",
+		AllocationUnitSize: 64 * 1024,
+	})
+	require.NoError(t, err)
+
+	formattedResponse, err = volumeClient.IsVolumeFormatted(context.TODO(), &v2alpha2.IsVolumeFormattedRequest{VolumeId: volumeID})
+	require.NoError(t, err)
+	assert.True(t, formattedResponse.Formatted)
+	assert.Equal(t, "exFAT", formattedResponse.FileSystem)
+
+	_, err = volumeClient.FormatVolume(context.TODO(), &v2alpha2.FormatVolumeRequest{VolumeId: volumeID, Force: true, FileSystem: "ext4"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "err=%v", err)
+}
+
+func v2alpha2ResizeVolumeTests(volumeClient *v2alpha2client.Client, t *testing.T) {
+	vhd, vhdCleanup := diskInit(t)
+	defer vhdCleanup()
+
+	listResponse, err := volumeClient.ListVolumesOnDisk(context.TODO(), &v2alpha2.ListVolumesOnDiskRequest{DiskNumber: vhd.DiskNumber})
+	require.NoError(t, err)
+	require.Len(t, listResponse.VolumeIds, 1)
+	volumeID := listResponse.VolumeIds[0]
+
+	_, err = volumeClient.FormatVolume(context.TODO(), &v2alpha2.FormatVolumeRequest{VolumeId: volumeID})
+	require.NoError(t, err)
+
+	const shrunkSize = 512 * 1024 * 1024
+	resizeResponse, err := volumeClient.ResizeVolume(context.TODO(), &v2alpha2.ResizeVolumeRequest{VolumeId: volumeID, SizeBytes: shrunkSize})
+	require.NoError(t, err)
+	assert.True(t, resizeResponse.Skipped)
+	assert.Equal(t, resizeResponse.PartitionSizeBefore, resizeResponse.PartitionSizeAfter)
+	originalSize := resizeResponse.PartitionSizeBefore
+
+	resizeResponse, err = volumeClient.ResizeVolume(context.TODO(), &v2alpha2.ResizeVolumeRequest{VolumeId: volumeID, SizeBytes: shrunkSize, AllowShrink: true})
+	require.NoError(t, err)
+	assert.False(t, resizeResponse.Skipped)
+	assert.Equal(t, originalSize, resizeResponse.PartitionSizeBefore)
+	assert.Equal(t, int64(shrunkSize), resizeResponse.PartitionSizeAfter)
+	assert.Less(t, resizeResponse.FileSystemSizeAfter, resizeResponse.FileSystemSizeBefore)
+
+	// the file system can't shrink to nothing
+	_, err = volumeClient.ResizeVolume(context.TODO(), &v2alpha2.ResizeVolumeRequest{VolumeId: volumeID, SizeBytes: 1024 * 1024, AllowShrink: true})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "err=%v", err)
+
+	// grow back to the maximum size
+	resizeResponse, err = volumeClient.ResizeVolume(context.TODO(), &v2alpha2.ResizeVolumeRequest{VolumeId: volumeID})
+	require.NoError(t, err)
+	assert.False(t, resizeResponse.Skipped)
+	assert.Equal(t, originalSize, resizeResponse.PartitionSizeAfter)
+	assert.Greater(t, resizeResponse.FileSystemSizeAfter, resizeResponse.FileSystemSizeBefore)
+}
+
+func v2alpha2ScanVolumeTests(volumeClient *v2alpha2client.Client, t *testing.T) {
+	vhd, vhdCleanup := diskInit(t)
+	defer vhdCleanup()
+
+	listResponse, err := volumeClient.ListVolumesOnDisk(context.TODO(), &v2alpha2.ListVolumesOnDiskRequest{DiskNumber: vhd.DiskNumber})
+	require.NoError(t, err)
+	require.Len(t, listResponse.VolumeIds, 1)
+	volumeID := listResponse.VolumeIds[0]
+
+	_, err = volumeClient.FormatVolume(context.TODO(), &v2alpha2.FormatVolumeRequest{VolumeId: volumeID})
+	require.NoError(t, err)
+
+	statsResponse, err := volumeClient.GetVolumeStats(context.TODO(), &v2alpha2.GetVolumeStatsRequest{VolumeId: volumeID})
+	require.NoError(t, err)
+	assert.Equal(t, v2alpha2.HealthStatus_HEALTH_STATUS_HEALTHY, statsResponse.HealthStatus)
+	assert.Contains(t, statsResponse.OperationalStatus, v2alpha2.OperationalStatus_OPERATIONAL_STATUS_OK)
+
+	scanResponse, err := volumeClient.ScanVolume(context.TODO(), &v2alpha2.ScanVolumeRequest{VolumeId: volumeID})
+	require.NoError(t, err)
+	assert.Equal(t, v2alpha2.RepairResult_REPAIR_RESULT_NO_ERRORS_FOUND, scanResponse.Result)
+	assert.Equal(t, v2alpha2.HealthStatus_HEALTH_STATUS_HEALTHY, scanResponse.HealthStatus)
+	assert.False(t, scanResponse.Dirty)
+
+	repairResponse, err := volumeClient.RepairVolume(context.TODO(), &v2alpha2.RepairVolumeRequest{VolumeId: volumeID, Mode: v2alpha2.RepairMode_REPAIR_MODE_OFFLINE_SCAN_AND_FIX})
+	require.NoError(t, err)
+	assert.Equal(t, v2alpha2.HealthStatus_HEALTH_STATUS_HEALTHY, repairResponse.HealthStatus)
+	assert.False(t, repairResponse.Dirty)
+}
+
+func v2alpha2VolumeTests(t *testing.T) {
+	volumeClient, err := v2alpha2client.NewClient()
+	require.NoError(t, err)
+	defer volumeClient.Close()
+
+	t.Run("FormatVolume", func(t *testing.T) {
+		v2alpha2FormatVolumeTests(volumeClient, t)
+	})
+	t.Run("ResizeVolume", func(t *testing.T) {
+		v2alpha2ResizeVolumeTests(volumeClient, t)
+	})
+	t.Run("ScanVolume,RepairVolume", func(t *testing.T) {
+		v2alpha2ScanVolumeTests(volumeClient, t)
+	})
+}
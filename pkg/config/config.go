@@ -0,0 +1,274 @@
+package config
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// APIVersion is the version of the configuration file format.
+	APIVersion = "csiproxy.config.k8s.io/v1alpha1"
+	// Kind is the kind of the configuration file.
+	Kind = "CSIProxyConfiguration"
+)
+
+// APIGroups are the names of the API groups csi-proxy can serve.
+var APIGroups = []string{"filesystem", "disk", "volume", "smb", "system", "iscsi", "snapshot", "encryption"}
+
+// DiskProtections are the names of the protections of DiskProtectionConfiguration.
+var DiskProtections = []string{"boot", "system", "clustered", "pagefile", "formatted"}
+
+var absPathRegexWindows = regexp.MustCompile(`^[a-zA-Z]:\\`)
+
+// Configuration is the csi-proxy configuration file, in YAML or JSON, e.g.
+//
+//	apiVersion: csiproxy.config.k8s.io/v1alpha1
+//	kind: CSIProxyConfiguration
+//	apiGroups: [filesystem, disk, volume, smb]
+//	disabledVersions: [v1alpha1, disk/v1beta1]
+//	kubeletPath: C:\var\lib\kubelet
+//	workingDirs: [C:\var\lib\csi]
+//	smb:
+//	  requirePrivacy: true
+//	diskProtection:
+//	  clusteredDisks: false
+//	metrics:
+//	  bindAddress: 127.0.0.1:9090
+//	logging:
+//	  verbosity: 2
+//
+// Settings not set in the file keep their command line value.
+type Configuration struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+
+	// APIGroups are the API groups to serve, all of them if empty. Changing them requires a restart.
+	APIGroups []string `json:"apiGroups,omitempty"`
+	// DisabledVersions are the API versions not to serve, either "<version>" for all the API groups,
+	// e.g. "v1alpha1", or "<group>/<version>", e.g. "system/v1alpha1". Changing them requires a restart.
+	DisabledVersions []string `json:"disabledVersions,omitempty"`
+	// KubeletPath is the prefix path of the kubelet directory in the host file system.
+	KubeletPath string `json:"kubeletPath,omitempty"`
+	// WorkingDirs are prefix paths where csi-proxy is allowed to make privileged operations
+	// in the host file system, in addition to KubeletPath.
+	WorkingDirs []string `json:"workingDirs,omitempty"`
+
+	SMB            SMBConfiguration            `json:"smb"`
+	DiskProtection DiskProtectionConfiguration `json:"diskProtection"`
+	Metrics        MetricsConfiguration        `json:"metrics"`
+	Logging        LoggingConfiguration        `json:"logging"`
+}
+
+// SMBConfiguration holds the settings of the smb API group.
+type SMBConfiguration struct {
+	// RequirePrivacy makes New-SmbGlobalMapping be called with -RequirePrivacy $true.
+	RequirePrivacy *bool `json:"requirePrivacy,omitempty"`
+}
+
+// DiskProtectionConfiguration holds the disks and volumes the destructive disk and volume operations,
+// e.g. PartitionDisk, SetDiskState offline and FormatVolume, refuse to act on.
+type DiskProtectionConfiguration struct {
+	// BootDisks protects the disk the host booted from.
+	BootDisks *bool `json:"bootDisks,omitempty"`
+	// SystemDisks protects the disk holding the system partition.
+	SystemDisks *bool `json:"systemDisks,omitempty"`
+	// ClusteredDisks protects the disks used by a failover cluster.
+	ClusteredDisks *bool `json:"clusteredDisks,omitempty"`
+	// PageFileDisks protects the disks hosting a page file.
+	PageFileDisks *bool `json:"pageFileDisks,omitempty"`
+	// FormattedVolumes refuses to format a volume that already has a file system, unless the request forces it.
+	FormattedVolumes *bool `json:"formattedVolumes,omitempty"`
+}
+
+// NewDiskProtectionConfiguration returns the DiskProtectionConfiguration enabling the protections
+// named in protections, each one of DiskProtections, and disabling the others.
+func NewDiskProtectionConfiguration(protections []string) (DiskProtectionConfiguration, error) {
+	enabled := make(map[string]bool)
+	for _, protection := range protections {
+		found := false
+		for _, name := range DiskProtections {
+			found = found || name == protection
+		}
+		if !found {
+			return DiskProtectionConfiguration{}, fmt.Errorf("unknown disk protection %q, expected one of %v", protection, DiskProtections)
+		}
+		enabled[protection] = true
+	}
+
+	bootDisks, systemDisks, clusteredDisks := enabled["boot"], enabled["system"], enabled["clustered"]
+	pageFileDisks, formattedVolumes := enabled["pagefile"], enabled["formatted"]
+	return DiskProtectionConfiguration{
+		BootDisks:        &bootDisks,
+		SystemDisks:      &systemDisks,
+		ClusteredDisks:   &clusteredDisks,
+		PageFileDisks:    &pageFileDisks,
+		FormattedVolumes: &formattedVolumes,
+	}, nil
+}
+
+// MetricsConfiguration holds the settings of the metrics endpoint.
+type MetricsConfiguration struct {
+	// BindAddress is the address the metrics endpoint binds to, metrics are disabled if empty.
+	// Changing it requires a restart.
+	BindAddress string `json:"bindAddress,omitempty"`
+}
+
+// LoggingConfiguration holds the logging settings.
+type LoggingConfiguration struct {
+	// Verbosity is klog's verbosity level.
+	Verbosity *int `json:"verbosity,omitempty"`
+}
+
+// Load loads and validates the configuration file located at path. Settings the
+// file doesn't set are taken from defaults.
+func Load(path string, defaults *Configuration) (*Configuration, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := Parse(data, defaults)
+	if err != nil {
+		return nil, fmt.Errorf("invalid configuration file %q: %w", path, err)
+	}
+	return config, nil
+}
+
+// Parse parses and validates a YAML or JSON configuration. Settings it doesn't set are taken from defaults.
+func Parse(data []byte, defaults *Configuration) (*Configuration, error) {
+	config := &Configuration{}
+	if err := yaml.UnmarshalStrict(data, config); err != nil {
+		return nil, err
+	}
+
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	if defaults != nil {
+		config.setDefaults(defaults)
+	}
+	return config, nil
+}
+
+func (c *Configuration) setDefaults(defaults *Configuration) {
+	if len(c.APIGroups) == 0 {
+		c.APIGroups = defaults.APIGroups
+	}
+	if len(c.DisabledVersions) == 0 {
+		c.DisabledVersions = defaults.DisabledVersions
+	}
+	if c.KubeletPath == "" {
+		c.KubeletPath = defaults.KubeletPath
+	}
+	if len(c.WorkingDirs) == 0 {
+		c.WorkingDirs = defaults.WorkingDirs
+	}
+	if c.SMB.RequirePrivacy == nil {
+		c.SMB.RequirePrivacy = defaults.SMB.RequirePrivacy
+	}
+	if c.DiskProtection.BootDisks == nil {
+		c.DiskProtection.BootDisks = defaults.DiskProtection.BootDisks
+	}
+	if c.DiskProtection.SystemDisks == nil {
+		c.DiskProtection.SystemDisks = defaults.DiskProtection.SystemDisks
+	}
+	if c.DiskProtection.ClusteredDisks == nil {
+		c.DiskProtection.ClusteredDisks = defaults.DiskProtection.ClusteredDisks
+	}
+	if c.DiskProtection.PageFileDisks == nil {
+		c.DiskProtection.PageFileDisks = defaults.DiskProtection.PageFileDisks
+	}
+	if c.DiskProtection.FormattedVolumes == nil {
+		c.DiskProtection.FormattedVolumes = defaults.DiskProtection.FormattedVolumes
+	}
+	if c.Metrics.BindAddress == "" {
+		c.Metrics.BindAddress = defaults.Metrics.BindAddress
+	}
+	if c.Logging.Verbosity == nil {
+		c.Logging.Verbosity = defaults.Logging.Verbosity
+	}
+}
+
+func (c *Configuration) validate() error {
+	if c.APIVersion != APIVersion {
+		return fmt.Errorf("unsupported apiVersion %q, expected %q", c.APIVersion, APIVersion)
+	}
+	if c.Kind != Kind {
+		return fmt.Errorf("unsupported kind %q, expected %q", c.Kind, Kind)
+	}
+
+	for _, apiGroup := range c.APIGroups {
+		if !IsAPIGroup(apiGroup) {
+			return fmt.Errorf("unknown API group %q, expected one of %v", apiGroup, APIGroups)
+		}
+	}
+
+	for _, disabledVersion := range c.DisabledVersions {
+		if err := ValidateDisabledVersion(disabledVersion); err != nil {
+			return err
+		}
+	}
+
+	if c.KubeletPath != "" && !absPathRegexWindows.MatchString(c.KubeletPath) {
+		return fmt.Errorf("kubeletPath %q is not an absolute Windows path", c.KubeletPath)
+	}
+	for _, workingDir := range c.WorkingDirs {
+		if !absPathRegexWindows.MatchString(workingDir) {
+			return fmt.Errorf("working directory %q is not an absolute Windows path", workingDir)
+		}
+	}
+
+	if c.Metrics.BindAddress != "" {
+		if _, _, err := net.SplitHostPort(c.Metrics.BindAddress); err != nil {
+			return fmt.Errorf("invalid metrics bindAddress %q: %w", c.Metrics.BindAddress, err)
+		}
+	}
+
+	if c.Logging.Verbosity != nil && *c.Logging.Verbosity < 0 {
+		return fmt.Errorf("invalid logging verbosity %d", *c.Logging.Verbosity)
+	}
+
+	return nil
+}
+
+// IsAPIGroup returns true if name is one of APIGroups.
+func IsAPIGroup(name string) bool {
+	for _, apiGroup := range APIGroups {
+		if apiGroup == name {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateDisabledVersion checks that disabledVersion is either "<version>", or "<group>/<version>"
+// where group is one of APIGroups.
+func ValidateDisabledVersion(disabledVersion string) error {
+	group, version, found := strings.Cut(disabledVersion, "/")
+	if !found {
+		version = disabledVersion
+	} else if !IsAPIGroup(group) {
+		return fmt.Errorf("unknown API group %q in disabled version %q, expected one of %v", group, disabledVersion, APIGroups)
+	}
+
+	if _, err := apiversion.NewVersion(version); err != nil {
+		return fmt.Errorf("invalid disabled version %q: %w", disabledVersion, err)
+	}
+	return nil
+}
+
+// AllWorkingDirs returns the working directories, including the kubelet path.
+func (c *Configuration) AllWorkingDirs() []string {
+	workingDirs := append([]string(nil), c.WorkingDirs...)
+	if c.KubeletPath != "" {
+		workingDirs = append(workingDirs, c.KubeletPath)
+	}
+	return workingDirs
+}
@@ -0,0 +1,118 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func boolPtr(b bool) *bool { return &b }
+
+func intPtr(i int) *int { return &i }
+
+func testDefaults() *Configuration {
+	diskProtection, _ := NewDiskProtectionConfiguration(DiskProtections)
+	return &Configuration{
+		APIVersion:     APIVersion,
+		Kind:           Kind,
+		APIGroups:      APIGroups,
+		KubeletPath:    `C:\var\lib\kubelet`,
+		SMB:            SMBConfiguration{RequirePrivacy: boolPtr(true)},
+		DiskProtection: diskProtection,
+		Logging:        LoggingConfiguration{Verbosity: intPtr(0)},
+	}
+}
+
+func TestParse(t *testing.T) {
+	config, err := Parse([]byte(`
+apiVersion: csiproxy.config.k8s.io/v1alpha1
+kind: CSIProxyConfiguration
+apiGroups: [filesystem, smb]
+disabledVersions: [v1alpha1, smb/v1beta1]
+workingDirs: ['C:\var\lib\csi']
+smb:
+  requirePrivacy: false
+diskProtection:
+  clusteredDisks: false
+metrics:
+  bindAddress: 127.0.0.1:9090
+`), testDefaults())
+	require.Nil(t, err)
+
+	assert.Equal(t, []string{"filesystem", "smb"}, config.APIGroups)
+	assert.Equal(t, []string{"v1alpha1", "smb/v1beta1"}, config.DisabledVersions)
+	assert.Equal(t, []string{`C:\var\lib\csi`, `C:\var\lib\kubelet`}, config.AllWorkingDirs())
+	assert.False(t, *config.SMB.RequirePrivacy)
+	assert.False(t, *config.DiskProtection.ClusteredDisks)
+	assert.Equal(t, "127.0.0.1:9090", config.Metrics.BindAddress)
+	// not set in the file
+	assert.True(t, *config.DiskProtection.BootDisks)
+	assert.True(t, *config.DiskProtection.FormattedVolumes)
+	assert.Equal(t, `C:\var\lib\kubelet`, config.KubeletPath)
+	assert.Equal(t, 0, *config.Logging.Verbosity)
+}
+
+func TestParseJSON(t *testing.T) {
+	config, err := Parse([]byte(`{"apiVersion": "csiproxy.config.k8s.io/v1alpha1", "kind": "CSIProxyConfiguration", "logging": {"verbosity": 4}}`), testDefaults())
+	require.Nil(t, err)
+
+	assert.Equal(t, 4, *config.Logging.Verbosity)
+	assert.Equal(t, APIGroups, config.APIGroups)
+}
+
+func TestParseInvalid(t *testing.T) {
+	header := "apiVersion: csiproxy.config.k8s.io/v1alpha1\nkind: CSIProxyConfiguration\n"
+	testCases := map[string]string{
+		"missing apiVersion":                "kind: CSIProxyConfiguration\n",
+		"unsupported apiVersion":            "apiVersion: csiproxy.config.k8s.io/v2\nkind: CSIProxyConfiguration\n",
+		"wrong kind":                        "apiVersion: csiproxy.config.k8s.io/v1alpha1\nkind: Pod\n",
+		"unknown field":                     header + "kubeletDir: 'C:\\var'\n",
+		"unknown API group":                 header + "apiGroups: [disk, network]\n",
+		"invalid disabled version":          header + "disabledVersions: [v1-alpha1]\n",
+		"disabled version of unknown group": header + "disabledVersions: [network/v1]\n",
+		"relative working dir":              header + "workingDirs: [var\\lib]\n",
+		"relative kubelet path":             header + "kubeletPath: kubelet\n",
+		"invalid bind address":              header + "metrics:\n  bindAddress: localhost\n",
+		"negative verbosity":                header + "logging:\n  verbosity: -1\n",
+		"unknown disk protection":           header + "diskProtection:\n  dataDisks: true\n",
+	}
+
+	for name, data := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse([]byte(data), testDefaults())
+			assert.NotNil(t, err)
+		})
+	}
+}
+
+func TestNewDiskProtectionConfiguration(t *testing.T) {
+	diskProtection, err := NewDiskProtectionConfiguration([]string{"boot", "formatted"})
+	require.Nil(t, err)
+	assert.True(t, *diskProtection.BootDisks)
+	assert.False(t, *diskProtection.SystemDisks)
+	assert.False(t, *diskProtection.ClusteredDisks)
+	assert.False(t, *diskProtection.PageFileDisks)
+	assert.True(t, *diskProtection.FormattedVolumes)
+
+	diskProtection, err = NewDiskProtectionConfiguration(nil)
+	require.Nil(t, err)
+	assert.False(t, *diskProtection.BootDisks)
+
+	_, err = NewDiskProtectionConfiguration([]string{"boot", "data"})
+	assert.NotNil(t, err)
+}
+
+func TestLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.Nil(t, os.WriteFile(path, []byte("apiVersion: csiproxy.config.k8s.io/v1alpha1\nkind: CSIProxyConfiguration\n"), 0600))
+
+	config, err := Load(path, testDefaults())
+	require.Nil(t, err)
+	assert.Equal(t, `C:\var\lib\kubelet`, config.KubeletPath)
+
+	_, err = Load(filepath.Join(t.TempDir(), "missing.yaml"), testDefaults())
+	assert.NotNil(t, err)
+}
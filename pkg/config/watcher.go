@@ -0,0 +1,96 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+// DefaultWatchInterval is the default interval at which the configuration file is checked for changes.
+const DefaultWatchInterval = 10 * time.Second
+
+// Watcher reloads the configuration file when its contents change, or when Reload is called,
+// and passes the valid configurations to a callback. Invalid configurations are logged and
+// ignored, the previous one staying in effect.
+type Watcher struct {
+	path     string
+	defaults *Configuration
+	interval time.Duration
+	onChange func(previous, current *Configuration)
+
+	current  *Configuration
+	contents []byte
+	reload   chan struct{}
+}
+
+// NewWatcher returns a Watcher for the configuration file located at path, whose
+// current configuration is current, see Load.
+func NewWatcher(path string, defaults, current *Configuration, interval time.Duration, onChange func(previous, current *Configuration)) *Watcher {
+	if interval <= 0 {
+		interval = DefaultWatchInterval
+	}
+	contents, _ := os.ReadFile(path)
+
+	return &Watcher{
+		path:     path,
+		defaults: defaults,
+		interval: interval,
+		onChange: onChange,
+		current:  current,
+		contents: contents,
+		reload:   make(chan struct{}, 1),
+	}
+}
+
+// Reload makes the watcher reload the configuration file, even if it hasn't changed.
+// It doesn't block.
+func (w *Watcher) Reload() {
+	select {
+	case w.reload <- struct{}{}:
+	default:
+	}
+}
+
+// Run watches the configuration file until ctx is done.
+func (w *Watcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.check(false)
+		case <-w.reload:
+			w.check(true)
+		}
+	}
+}
+
+// check reloads the configuration file if it changed, or if force is true.
+func (w *Watcher) check(force bool) {
+	contents, err := os.ReadFile(w.path)
+	if err != nil {
+		klog.Errorf("failed to read configuration file %q: %v", w.path, err)
+		return
+	}
+	if !force && bytes.Equal(contents, w.contents) {
+		return
+	}
+	w.contents = contents
+
+	config, err := Parse(contents, w.defaults)
+	if err != nil {
+		klog.Errorf("ignoring invalid configuration file %q: %v", w.path, err)
+		return
+	}
+
+	klog.Infof("Reloading configuration file %q", w.path)
+	previous := w.current
+	w.current = config
+	w.onChange(previous, config)
+}
@@ -0,0 +1,63 @@
+package config
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const header = "apiVersion: csiproxy.config.k8s.io/v1alpha1\nkind: CSIProxyConfiguration\n"
+
+func TestWatcher(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.Nil(t, os.WriteFile(path, []byte(header+"workingDirs: ['C:\\first']\n"), 0600))
+
+	defaults := testDefaults()
+	current, err := Load(path, defaults)
+	require.Nil(t, err)
+
+	changes := make(chan [2]*Configuration, 10)
+	watcher := NewWatcher(path, defaults, current, 10*time.Millisecond, func(previous, current *Configuration) {
+		changes <- [2]*Configuration{previous, current}
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go watcher.Run(ctx)
+
+	nextChange := func() [2]*Configuration {
+		select {
+		case change := <-changes:
+			return change
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for the configuration to be reloaded")
+			return [2]*Configuration{}
+		}
+	}
+
+	// the file changes
+	require.Nil(t, os.WriteFile(path, []byte(header+"workingDirs: ['C:\\second']\n"), 0600))
+	change := nextChange()
+	assert.Equal(t, []string{`C:\first`}, change[0].WorkingDirs)
+	assert.Equal(t, []string{`C:\second`}, change[1].WorkingDirs)
+
+	// an invalid file is ignored
+	require.Nil(t, os.WriteFile(path, []byte(header+"workingDirs: [relative]\n"), 0600))
+	time.Sleep(50 * time.Millisecond)
+	assert.Empty(t, changes)
+
+	// fixing it applies it, the invalid one never having been current
+	require.Nil(t, os.WriteFile(path, []byte(header+"workingDirs: ['C:\\third']\n"), 0600))
+	change = nextChange()
+	assert.Equal(t, []string{`C:\second`}, change[0].WorkingDirs)
+	assert.Equal(t, []string{`C:\third`}, change[1].WorkingDirs)
+
+	// a forced reload applies the unchanged file
+	watcher.Reload()
+	change = nextChange()
+	assert.Equal(t, []string{`C:\third`}, change[1].WorkingDirs)
+}
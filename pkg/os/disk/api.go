@@ -1,11 +1,16 @@
 package disk
 
 import (
+	"bytes"
+	"encoding/binary"
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"io"
+	"strconv"
 	"strings"
 	"syscall"
+	"unicode/utf16"
 	"unsafe"
 
 	shared "github.com/kubernetes-csi/csi-proxy/pkg/shared/disk"
@@ -16,6 +21,9 @@ import (
 const (
 	IOCTL_STORAGE_GET_DEVICE_NUMBER = 0x2D1080
 	IOCTL_STORAGE_QUERY_PROPERTY    = 0x002d1400
+
+	IOCTL_DISK_GET_PARTITION_INFO_EX = 0x00070048
+	IOCTL_DISK_SET_PARTITION_INFO_EX = 0x0007c04c
 )
 
 // API declares the interface exposed by the internal API
@@ -25,12 +33,14 @@ type API interface {
 	ListDiskLocations() (map[uint32]shared.DiskLocation, error)
 	// IsDiskInitialized returns true if the disk identified by `diskNumber` is initialized.
 	IsDiskInitialized(diskNumber uint32) (bool, error)
-	// InitializeDisk initializes the disk `diskNumber`
-	InitializeDisk(diskNumber uint32) error
-	// BasicPartitionsExist checks if the disk `diskNumber` has any basic partitions.
-	BasicPartitionsExist(diskNumber uint32) (bool, error)
-	// CreateBasicPartition creates a partition in disk `diskNumber`
-	CreateBasicPartition(diskNumber uint32) error
+	// InitializeDisk initializes the disk `diskNumber` with the MSFT_Disk partition style `partitionStyle`.
+	InitializeDisk(diskNumber uint32, partitionStyle uint16) error
+	// ListPartitions lists the partitions of the disk `diskNumber`.
+	ListPartitions(diskNumber uint32) ([]shared.PartitionInfo, error)
+	// CreatePartition creates a partition in disk `diskNumber`, brings it online and returns its number.
+	CreatePartition(diskNumber uint32, options shared.PartitionOptions) (uint32, error)
+	// DeletePartition deletes the partition `partitionNumber` of the disk `diskNumber`.
+	DeletePartition(diskNumber, partitionNumber uint32) error
 	// Rescan updates the host storage cache (re-enumerates disk, partition and volume objects)
 	Rescan() error
 	// GetDiskNumberByName gets a disk number by page83 ID (disk name)
@@ -43,6 +53,26 @@ type API interface {
 	SetDiskState(diskNumber uint32, isOnline bool) error
 	// GetDiskState gets the offline/online state of the disk `diskNumber`.
 	GetDiskState(diskNumber uint32) (bool, error)
+	// ClearDisk removes the partitions of the disk `diskNumber`, and the OEM partitions if `removeOEM` is set,
+	// leaving it uninitialized (RAW).
+	ClearDisk(diskNumber uint32, removeOEM bool) error
+	// ZeroDisk writes zeros to `length` bytes at `offset` of the disk `diskNumber`, calling `progress` with the
+	// number of bytes written so far, which stops it when it returns an error.
+	ZeroDisk(diskNumber uint32, offset, length uint64, progress func(zeroed uint64) error) error
+	// SetDiskReadOnly sets the read only attribute of the disk `diskNumber`.
+	SetDiskReadOnly(diskNumber uint32, isReadOnly bool) error
+	// GetSANPolicy gets the MSFT_StorageSetting NewDiskPolicy the host applies to the disks it discovers.
+	GetSANPolicy() (uint16, error)
+	// SetSANPolicy sets the MSFT_StorageSetting NewDiskPolicy the host applies to the disks it discovers.
+	SetSANPolicy(policy uint16) error
+	// ListDisks gets the information of all disks by disk number.
+	ListDisks() (map[uint32]shared.DiskInfo, error)
+	// GetDiskInfo gets the information of the disk `diskNumber`.
+	GetDiskInfo(diskNumber uint32) (*shared.DiskInfo, error)
+	// ListPageFileDisks gets the numbers of the disks hosting a page file.
+	ListPageFileDisks() ([]uint32, error)
+	// CheckHealth returns an error if the disks can't be queried.
+	CheckHealth() error
 }
 
 // DiskAPI implements the OS API calls related to Disk Devices. All code here should be very simple
@@ -81,33 +111,8 @@ func (imp DiskAPI) ListDiskLocations() (map[uint32]shared.DiskLocation, error) {
 					return fmt.Errorf("failed to query disk location: %v, %w", disk, err)
 				}
 
-				found := false
-				s := strings.Split(location, ":")
-				if len(s) >= 5 {
-					var d shared.DiskLocation
-					for _, item := range s {
-						item = strings.TrimSpace(item)
-						itemSplit := strings.Split(item, " ")
-						if len(itemSplit) == 2 {
-							found = true
-							switch strings.TrimSpace(itemSplit[0]) {
-							case "Adapter":
-								d.Adapter = strings.TrimSpace(itemSplit[1])
-							case "Target":
-								d.Target = strings.TrimSpace(itemSplit[1])
-							case "LUN":
-								d.LUNID = strings.TrimSpace(itemSplit[1])
-							case "Bus":
-								d.Bus = strings.TrimSpace(itemSplit[1])
-							default:
-								klog.Warningf("Got unknown field : %s=%s", itemSplit[0], itemSplit[1])
-							}
-						}
-					}
-
-					if found {
-						m[num] = d
-					}
+				if d, found := parseDiskLocation(location); found {
+					m[num] = d
 				}
 				return nil
 			})
@@ -117,6 +122,36 @@ func (imp DiskAPI) ListDiskLocations() (map[uint32]shared.DiskLocation, error) {
 	return m, err
 }
 
+// parseDiskLocation parses a MSFT_Disk location, e.g. "PCI Slot 3 : Adapter 0 : Port 0 : Target 1 : LUN 0",
+// and returns whether it has any of the adapter, bus, target and LUN ID.
+func parseDiskLocation(location string) (shared.DiskLocation, bool) {
+	var d shared.DiskLocation
+	found := false
+	s := strings.Split(location, ":")
+	if len(s) >= 5 {
+		for _, item := range s {
+			item = strings.TrimSpace(item)
+			itemSplit := strings.Split(item, " ")
+			if len(itemSplit) == 2 {
+				found = true
+				switch strings.TrimSpace(itemSplit[0]) {
+				case "Adapter":
+					d.Adapter = strings.TrimSpace(itemSplit[1])
+				case "Target":
+					d.Target = strings.TrimSpace(itemSplit[1])
+				case "LUN":
+					d.LUNID = strings.TrimSpace(itemSplit[1])
+				case "Bus":
+					d.Bus = strings.TrimSpace(itemSplit[1])
+				default:
+					klog.Warningf("Got unknown field : %s=%s", itemSplit[0], itemSplit[1])
+				}
+			}
+		}
+	}
+	return d, found
+}
+
 func (imp DiskAPI) Rescan() error {
 	return wmi.WithCOMThread(func() error {
 		err := wmi.RescanDisks()
@@ -147,7 +182,7 @@ func (imp DiskAPI) IsDiskInitialized(diskNumber uint32) (bool, error) {
 	return partitionStyle != wmi.PartitionStyleUnknown, err
 }
 
-func (imp DiskAPI) InitializeDisk(diskNumber uint32) error {
+func (imp DiskAPI) InitializeDisk(diskNumber uint32, partitionStyle uint16) error {
 	return wmi.WithCOMThread(func() error {
 		return wmi.WithScope(func(scope *wmi.Scope) error {
 			disk, err := wmi.QueryDiskByNumber(scope, diskNumber, nil)
@@ -155,7 +190,7 @@ func (imp DiskAPI) InitializeDisk(diskNumber uint32) error {
 				return fmt.Errorf("failed to initializing disk %d. error: %w", diskNumber, err)
 			}
 
-			err = wmi.InitializeDisk(disk, wmi.PartitionStyleGPT)
+			err = wmi.InitializeDisk(disk, int(partitionStyle))
 			if err != nil {
 				return fmt.Errorf("failed to initializing disk %d: error: %w", diskNumber, err)
 			}
@@ -165,42 +200,143 @@ func (imp DiskAPI) InitializeDisk(diskNumber uint32) error {
 	})
 }
 
-func (imp DiskAPI) BasicPartitionsExist(diskNumber uint32) (bool, error) {
-	var exist bool
+func (imp DiskAPI) ListPartitions(diskNumber uint32) ([]shared.PartitionInfo, error) {
+	var result []shared.PartitionInfo
 	err := wmi.WithCOMThread(func() error {
 		return wmi.WithScope(func(scope *wmi.Scope) error {
-			partitions, err := wmi.ListPartitionsWithFilters(scope, nil, wmi.FilterForPartitionOnDisk(diskNumber), wmi.FilterForPartitionsOfTypeNormal())
+			partitions, err := wmi.ListPartitionsOnDisk(scope, diskNumber, 0, wmi.PartitionSelectorListForInfo)
 			if err != nil {
-				return fmt.Errorf("error checking presence of partitions on disk %d:, %w", diskNumber, err)
+				return fmt.Errorf("error listing partitions on disk %d: %w", diskNumber, err)
 			}
 
-			exist = len(partitions) > 0
+			result = make([]shared.PartitionInfo, 0, len(partitions))
+			for _, partition := range partitions {
+				info, err := getPartitionInfo(partition)
+				if err != nil {
+					return fmt.Errorf("failed to query partition %v on disk %d: %w", partition, diskNumber, err)
+				}
+				result = append(result, *info)
+			}
 			return nil
 		})
 	})
-	return exist, err
+	return result, err
 }
 
-func (imp DiskAPI) CreateBasicPartition(diskNumber uint32) error {
-	return wmi.WithCOMThread(func() error {
+func getPartitionInfo(partition *wmi.COMDispatchObject) (*shared.PartitionInfo, error) {
+	var err error
+	info := &shared.PartitionInfo{}
+	if info.DiskNumber, err = wmi.GetPartitionDiskNumber(partition); err != nil {
+		return nil, err
+	}
+	if info.PartitionNumber, err = wmi.GetPartitionNumber(partition); err != nil {
+		return nil, err
+	}
+	if info.Offset, err = wmi.GetPartitionOffset(partition); err != nil {
+		return nil, err
+	}
+	if info.Size, err = wmi.GetPartitionSize(partition); err != nil {
+		return nil, err
+	}
+	if info.MbrType, err = wmi.GetPartitionMbrType(partition); err != nil {
+		return nil, err
+	}
+	if info.GptType, err = wmi.GetPartitionGptType(partition); err != nil {
+		return nil, err
+	}
+	if info.GUID, err = wmi.GetPartitionGUID(partition); err != nil {
+		return nil, err
+	}
+	driveLetter, err := wmi.GetPartitionDriveLetter(partition)
+	if err != nil {
+		return nil, err
+	}
+	if driveLetter != 0 {
+		info.DriveLetter = string(rune(driveLetter))
+	}
+	if info.IsBoot, err = wmi.IsPartitionBoot(partition); err != nil {
+		return nil, err
+	}
+	if info.IsSystem, err = wmi.IsPartitionSystem(partition); err != nil {
+		return nil, err
+	}
+	if info.IsHidden, err = wmi.IsPartitionHidden(partition); err != nil {
+		return nil, err
+	}
+	if info.IsReadOnly, err = wmi.IsPartitionReadOnly(partition); err != nil {
+		return nil, err
+	}
+	if info.IsOffline, err = wmi.IsPartitionOffline(partition); err != nil {
+		return nil, err
+	}
+	info.IsReserved = strings.EqualFold(info.GptType, wmi.GPTPartitionTypeMicrosoftReserved)
+	return info, nil
+}
+
+func (imp DiskAPI) CreatePartition(diskNumber uint32, options shared.PartitionOptions) (uint32, error) {
+	var partitionNumber uint32
+	err := wmi.WithCOMThread(func() error {
 		return wmi.WithScope(func(scope *wmi.Scope) error {
 			disk, err := wmi.QueryDiskByNumber(scope, diskNumber, nil)
 			if err != nil {
 				return err
 			}
 
+			partitionStyle, err := wmi.GetDiskPartitionStyle(disk)
+			if err != nil {
+				return fmt.Errorf("failed to query partition style of disk %d: %w", diskNumber, err)
+			}
+
+			// the created partition is the one missing from the partitions listed beforehand
+			existing, err := listPartitionNumbers(scope, diskNumber)
+			if err != nil {
+				return err
+			}
+
+			// nil leaves the optional parameters of MSFT_Disk CreatePartition unset
+			var size, offset, alignment, mbrType, gptType interface{}
+			if options.Size > 0 {
+				size = strconv.FormatUint(options.Size, 10)
+			}
+			if options.Offset > 0 {
+				offset = strconv.FormatUint(options.Offset, 10)
+			}
+			if options.Alignment > 0 {
+				alignment = options.Alignment
+			}
+			switch partitionStyle {
+			case wmi.PartitionStyleMBR:
+				if options.GptType != "" || options.Name != "" {
+					return fmt.Errorf("disk %d has the MBR partition style, GPT partition types and names aren't supported", diskNumber)
+				}
+				mbrType = uint16(wmi.MBRPartitionTypeIFS)
+				if options.MbrType > 0 {
+					mbrType = options.MbrType
+				}
+			case wmi.PartitionStyleGPT:
+				if options.MbrType > 0 {
+					return fmt.Errorf("disk %d has the GPT partition style, MBR partition types aren't supported", diskNumber)
+				}
+				gptType = wmi.GPTPartitionTypeBasicData
+				if options.GptType != "" {
+					gptType = options.GptType
+				}
+			default:
+				return fmt.Errorf("disk %d is not initialized", diskNumber)
+			}
+
 			err = wmi.CreatePartition(
 				disk,
-				nil,                           // Size
-				true,                          // UseMaximumSize
-				nil,                           // Offset
-				nil,                           // Alignment
-				nil,                           // DriveLetter
-				false,                         // AssignDriveLetter
-				nil,                           // MbrType,
-				wmi.GPTPartitionTypeBasicData, // GPT Type
-				false,                         // IsHidden
-				false,                         // IsActive,
+				size,              // Size
+				options.Size == 0, // UseMaximumSize
+				offset,            // Offset
+				alignment,         // Alignment
+				nil,               // DriveLetter
+				false,             // AssignDriveLetter
+				mbrType,           // MbrType,
+				gptType,           // GPT Type
+				false,             // IsHidden
+				false,             // IsActive,
 			)
 			if err != nil {
 				var werr *wmi.WMIError
@@ -214,24 +350,115 @@ func (imp DiskAPI) CreateBasicPartition(diskNumber uint32) error {
 				return fmt.Errorf("error rescan disk (%d). error: %w", diskNumber, err)
 			}
 
-			partitions, err := wmi.ListPartitionsWithFilters(scope, nil, wmi.FilterForPartitionOnDisk(diskNumber), wmi.FilterForPartitionsOfTypeNormal())
+			partitions, err := wmi.ListPartitionsOnDisk(scope, diskNumber, 0, wmi.PartitionSelectorListNumber)
 			if err != nil {
-				return fmt.Errorf("error query basic partition on disk %d:, %w", diskNumber, err)
+				return fmt.Errorf("error query partitions on disk %d:, %w", diskNumber, err)
 			}
 
-			if len(partitions) == 0 {
-				return fmt.Errorf("failed to create basic partition on disk %d:, %w", diskNumber, err)
+			var partition *wmi.COMDispatchObject
+			for _, candidate := range partitions {
+				number, err := wmi.GetPartitionNumber(candidate)
+				if err != nil {
+					return fmt.Errorf("failed to query partition number on disk %d: %w", diskNumber, err)
+				}
+				if !existing[number] {
+					partition = candidate
+					partitionNumber = number
+					break
+				}
+			}
+			if partition == nil {
+				return fmt.Errorf("failed to find the partition created on disk %d", diskNumber)
 			}
 
-			partition := partitions[0]
 			status, err := wmi.SetPartitionState(partition, true)
 			if err != nil {
-				return fmt.Errorf("error bring partition %v on disk %d online. status %s, err: %w", partition, diskNumber, status, err)
+				return fmt.Errorf("error bring partition %d on disk %d online. status %s, err: %w", partitionNumber, diskNumber, status, err)
+			}
+
+			if options.Name != "" {
+				if err := setPartitionName(diskNumber, partitionNumber, options.Name); err != nil {
+					return fmt.Errorf("failed to name partition %d on disk %d: %w", partitionNumber, diskNumber, err)
+				}
 			}
 
 			return nil
 		})
 	})
+	return partitionNumber, err
+}
+
+// listPartitionNumbers returns the set of the partition numbers of the disk diskNumber.
+func listPartitionNumbers(scope *wmi.Scope, diskNumber uint32) (map[uint32]bool, error) {
+	partitions, err := wmi.ListPartitionsOnDisk(scope, diskNumber, 0, wmi.PartitionSelectorListNumber)
+	if err != nil {
+		return nil, fmt.Errorf("error query partitions on disk %d:, %w", diskNumber, err)
+	}
+
+	numbers := make(map[uint32]bool, len(partitions))
+	for _, partition := range partitions {
+		number, err := wmi.GetPartitionNumber(partition)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query partition number on disk %d: %w", diskNumber, err)
+		}
+		numbers[number] = true
+	}
+	return numbers, nil
+}
+
+// setPartitionName sets the GPT name of the partition partitionNumber of the disk diskNumber,
+// which MSFT_Partition doesn't expose, through its partition device.
+func setPartitionName(diskNumber, partitionNumber uint32, name string) error {
+	encoded := utf16.Encode([]rune(name))
+	if len(encoded) > len(PartitionInformationGPT{}.Name) {
+		return fmt.Errorf("partition name %q is longer than %d characters", name, len(PartitionInformationGPT{}.Name))
+	}
+
+	path := fmt.Sprintf(`\\?\GLOBALROOT\Device\Harddisk%d\Partition%d`, diskNumber, partitionNumber)
+	h, err := syscall.Open(path, syscall.O_RDWR, 0)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer syscall.Close(h)
+
+	var bytes uint32
+	info := PartitionInformationEx{}
+	err = syscall.DeviceIoControl(h, IOCTL_DISK_GET_PARTITION_INFO_EX, nil, 0, (*byte)(unsafe.Pointer(&info)), uint32(unsafe.Sizeof(info)), &bytes, nil)
+	if err != nil {
+		return fmt.Errorf("IOCTL_DISK_GET_PARTITION_INFO_EX failed: %w", err)
+	}
+	if info.PartitionStyle != PartitionStyleGPT {
+		return fmt.Errorf("partition %d of disk %d isn't a GPT partition", partitionNumber, diskNumber)
+	}
+
+	set := SetPartitionInformationEx{PartitionStyle: PartitionStyleGPT, Gpt: info.Gpt}
+	set.Gpt.Name = [36]uint16{}
+	copy(set.Gpt.Name[:], encoded)
+	err = syscall.DeviceIoControl(h, IOCTL_DISK_SET_PARTITION_INFO_EX, (*byte)(unsafe.Pointer(&set)), uint32(unsafe.Sizeof(set)), nil, 0, &bytes, nil)
+	if err != nil {
+		return fmt.Errorf("IOCTL_DISK_SET_PARTITION_INFO_EX failed: %w", err)
+	}
+	return nil
+}
+
+func (imp DiskAPI) DeletePartition(diskNumber, partitionNumber uint32) error {
+	return wmi.WithCOMThread(func() error {
+		return wmi.WithScope(func(scope *wmi.Scope) error {
+			partitions, err := wmi.ListPartitionsOnDisk(scope, diskNumber, partitionNumber, wmi.PartitionSelectorListObjectID)
+			if err != nil {
+				return fmt.Errorf("error query partition %d on disk %d: %w", partitionNumber, diskNumber, err)
+			}
+			if len(partitions) == 0 {
+				return fmt.Errorf("partition %d on disk %d: %w", partitionNumber, diskNumber, wmi.ErrNotFound)
+			}
+
+			status, err := wmi.DeletePartition(partitions[0])
+			if err != nil {
+				return fmt.Errorf("error deleting partition %d on disk %d. status %s, err: %w", partitionNumber, diskNumber, status, err)
+			}
+			return nil
+		})
+	})
 }
 
 func (imp DiskAPI) GetDiskNumberByName(page83ID string) (uint32, error) {
@@ -331,6 +558,130 @@ func (imp DiskAPI) GetDiskNumberWithID(page83ID string) (uint32, error) {
 	return diskNumberResult, err
 }
 
+// GetDiskIdentifiers returns all the device identification descriptors of the disk.
+func (imp DiskAPI) GetDiskIdentifiers(disk syscall.Handle) ([]shared.DiskIdentifier, error) {
+	buffer, err := queryStorageProperty(disk, StorageDeviceIDProperty)
+	if err != nil {
+		return nil, err
+	}
+	return parseDeviceIdentifiers(buffer), nil
+}
+
+// GetDeviceSerialNumber returns the serial number of the storage device descriptor of the disk, which
+// for NVMe disks is the serial number of the controller.
+func (imp DiskAPI) GetDeviceSerialNumber(disk syscall.Handle) (string, error) {
+	buffer, err := queryStorageProperty(disk, StorageDeviceProperty)
+	if err != nil {
+		return "", err
+	}
+	return parseDeviceSerialNumber(buffer), nil
+}
+
+// queryStorageProperty returns the descriptor of the property propertyID of the disk.
+func queryStorageProperty(disk syscall.Handle, propertyID StoragePropertyID) ([]byte, error) {
+	query := StoragePropertyQuery{
+		PropertyID: propertyID,
+		QueryType:  PropertyStandardQuery,
+	}
+
+	var size uint32
+	buffer := make([]byte, 4*1024)
+	err := syscall.DeviceIoControl(disk, IOCTL_STORAGE_QUERY_PROPERTY, (*byte)(unsafe.Pointer(&query)), uint32(unsafe.Sizeof(query)), &buffer[0], uint32(len(buffer)), &size, nil)
+	if err != nil {
+		return nil, fmt.Errorf("IOCTL_STORAGE_QUERY_PROPERTY failed: %w", err)
+	}
+	return buffer[:size], nil
+}
+
+// parseDeviceIdentifiers parses the identifiers of a STORAGE_DEVICE_ID_DESCRIPTOR, stopping at the first
+// one overflowing the buffer.
+func parseDeviceIdentifiers(buffer []byte) []shared.DiskIdentifier {
+	const (
+		descriptorHeaderSize = 12 // Version, Size, NumberOfIdentifiers
+		identifierHeaderSize = 16 // CodeSet, Type, IdentifierSize, NextOffset, Association
+	)
+	if len(buffer) < descriptorHeaderSize {
+		return nil
+	}
+
+	count := binary.LittleEndian.Uint32(buffer[8:])
+	identifiers := []shared.DiskIdentifier{}
+	offset := descriptorHeaderSize
+	for n := uint32(0); n < count && offset+identifierHeaderSize <= len(buffer); n++ {
+		codeSet := StorageIdentifierCodeSet(binary.LittleEndian.Uint32(buffer[offset:]))
+		identifierType := binary.LittleEndian.Uint32(buffer[offset+4:])
+		identifierSize := int(binary.LittleEndian.Uint16(buffer[offset+8:]))
+		nextOffset := int(binary.LittleEndian.Uint16(buffer[offset+10:]))
+		association := binary.LittleEndian.Uint32(buffer[offset+12:])
+
+		start := offset + identifierHeaderSize
+		if start+identifierSize > len(buffer) {
+			break
+		}
+		raw := buffer[start : start+identifierSize]
+
+		value := hex.EncodeToString(raw)
+		if codeSet == StorageIDCodeSetASCII || codeSet == StorageIDCodeSetUtf8 {
+			value = strings.TrimRight(string(raw), "\x00")
+		}
+		identifiers = append(identifiers, shared.DiskIdentifier{
+			Type:        identifierType,
+			Association: association,
+			Value:       value,
+		})
+
+		if nextOffset == 0 {
+			break
+		}
+		offset += nextOffset
+	}
+	return identifiers
+}
+
+// parseDeviceSerialNumber returns the serial number of a STORAGE_DEVICE_DESCRIPTOR, empty if it has none.
+func parseDeviceSerialNumber(buffer []byte) string {
+	const serialNumberOffsetField = 24 // after Version, Size, 4 flags, VendorIdOffset, ProductIdOffset, ProductRevisionOffset
+	if len(buffer) < serialNumberOffsetField+4 {
+		return ""
+	}
+
+	serialNumberOffset := int(binary.LittleEndian.Uint32(buffer[serialNumberOffsetField:]))
+	if serialNumberOffset == 0 || serialNumberOffset >= len(buffer) {
+		return ""
+	}
+	serialNumber := buffer[serialNumberOffset:]
+	if end := bytes.IndexByte(serialNumber, 0); end >= 0 {
+		serialNumber = serialNumber[:end]
+	}
+	return strings.TrimSpace(string(serialNumber))
+}
+
+// getDiskNumberAndIDs returns the number and the identifiers of the disk at path, but its MSFT_Disk serial number.
+func (imp DiskAPI) getDiskNumberAndIDs(path string) (uint32, shared.DiskIDs, error) {
+	ids := shared.DiskIDs{}
+	h, err := syscall.Open(path, syscall.O_RDONLY, 0)
+	if err != nil {
+		return 0, ids, err
+	}
+	defer syscall.Close(h)
+
+	diskNumber, err := imp.GetDiskNumber(h)
+	if err != nil {
+		return 0, ids, err
+	}
+
+	if ids.Page83, err = imp.GetDiskPage83ID(h); err != nil {
+		return 0, ids, err
+	}
+	if ids.Identifiers, err = imp.GetDiskIdentifiers(h); err != nil {
+		return 0, ids, err
+	}
+	if ids.DeviceSerialNumber, err = imp.GetDeviceSerialNumber(h); err != nil {
+		return 0, ids, err
+	}
+	return diskNumber, ids, nil
+}
+
 func (imp DiskAPI) GetDiskNumberAndPage83ID(path string) (uint32, string, error) {
 	h, err := syscall.Open(path, syscall.O_RDONLY, 0)
 	defer syscall.Close(h)
@@ -352,7 +703,8 @@ func (imp DiskAPI) GetDiskNumberAndPage83ID(path string) (uint32, string, error)
 }
 
 // ListDiskIDs - constructs a map with the disk number as the key and the DiskID structure
-// as the value. The DiskID struct has a field for the page83 ID.
+// as the value. The DiskID struct has fields for the page83 ID, the serial numbers and all
+// the device identification descriptors.
 func (imp DiskAPI) ListDiskIDs() (map[uint32]shared.DiskIDs, error) {
 	m := make(map[uint32]shared.DiskIDs)
 	err := wmi.WithCOMThread(func() error {
@@ -373,15 +725,13 @@ func (imp DiskAPI) ListDiskIDs() (map[uint32]shared.DiskIDs, error) {
 					return fmt.Errorf("failed to query disk serial number: %v, %w", disk, err)
 				}
 
-				diskNumber, page83, err := imp.GetDiskNumberAndPage83ID(path)
+				diskNumber, ids, err := imp.getDiskNumberAndIDs(path)
 				if err != nil {
 					return err
 				}
 
-				m[diskNumber] = shared.DiskIDs{
-					Page83:       page83,
-					SerialNumber: sn,
-				}
+				ids.SerialNumber = sn
+				m[diskNumber] = ids
 				return nil
 			})
 			return err
@@ -459,3 +809,274 @@ func (imp DiskAPI) GetDiskState(diskNumber uint32) (bool, error) {
 	})
 	return !isOffline, err
 }
+
+func (imp DiskAPI) ClearDisk(diskNumber uint32, removeOEM bool) error {
+	return wmi.WithCOMThread(func() error {
+		return wmi.WithScope(func(scope *wmi.Scope) error {
+			disk, err := wmi.QueryDiskByNumber(scope, diskNumber, wmi.DiskSelectorListForPartitionStyle)
+			if err != nil {
+				return err
+			}
+
+			partitionStyle, err := wmi.GetDiskPartitionStyle(disk)
+			if err != nil {
+				return fmt.Errorf("error getting disk %d partition style. error: %w", diskNumber, err)
+			}
+
+			if partitionStyle == wmi.PartitionStyleUnknown {
+				klog.V(2).Infof("Disk %d is already uninitialized", diskNumber)
+				return nil
+			}
+
+			status, err := wmi.ClearDisk(disk, removeOEM)
+			if err != nil {
+				return fmt.Errorf("error clearing disk %d. status %s, err: %w", diskNumber, status, err)
+			}
+			return nil
+		})
+	})
+}
+
+// zeroDiskChunkSize is the size of the writes of ZeroDisk, a multiple of all the sector sizes.
+const zeroDiskChunkSize = 1024 * 1024
+
+func (imp DiskAPI) ZeroDisk(diskNumber uint32, offset, length uint64, progress func(zeroed uint64) error) error {
+	path := fmt.Sprintf(`\\.\PhysicalDrive%d`, diskNumber)
+	h, err := syscall.Open(path, syscall.O_RDWR, 0)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer syscall.Close(h)
+
+	if _, err := syscall.Seek(h, int64(offset), 0); err != nil {
+		return fmt.Errorf("failed to seek to %d on disk %d: %w", offset, diskNumber, err)
+	}
+
+	zeros := make([]byte, zeroDiskChunkSize)
+	var zeroed uint64
+	for zeroed < length {
+		chunk := zeros
+		if remaining := length - zeroed; remaining < uint64(len(chunk)) {
+			chunk = chunk[:remaining]
+		}
+		n, err := syscall.Write(h, chunk)
+		if err == nil && n == 0 {
+			err = io.ErrShortWrite
+		}
+		if err != nil {
+			return fmt.Errorf("failed to write zeros at %d on disk %d: %w", offset+zeroed, diskNumber, err)
+		}
+		zeroed += uint64(n)
+		if err := progress(zeroed); err != nil {
+			return err
+		}
+	}
+	return syscall.FlushFileBuffers(h)
+}
+
+func (imp DiskAPI) SetDiskReadOnly(diskNumber uint32, isReadOnly bool) error {
+	return wmi.WithCOMThread(func() error {
+		return wmi.WithScope(func(scope *wmi.Scope) error {
+			disk, err := wmi.QueryDiskByNumber(scope, diskNumber, wmi.DiskSelectorListForIsReadOnly)
+			if err != nil {
+				return err
+			}
+
+			readOnly, err := wmi.IsDiskReadOnly(disk)
+			if err != nil {
+				return fmt.Errorf("error getting disk %d read only attribute. error: %w", diskNumber, err)
+			}
+
+			if readOnly == isReadOnly {
+				klog.V(2).Infof("Disk %d read only attribute is already %v", diskNumber, isReadOnly)
+				return nil
+			}
+
+			_, err = wmi.SetDiskReadOnly(disk, isReadOnly)
+			if err != nil {
+				return fmt.Errorf("setting disk %d read only attribute (isReadOnly: %v): error: %w", diskNumber, isReadOnly, err)
+			}
+
+			return nil
+		})
+	})
+}
+
+func (imp DiskAPI) GetSANPolicy() (uint16, error) {
+	var policy uint16
+	err := wmi.WithCOMThread(func() error {
+		var err error
+		policy, err = wmi.GetSANPolicy()
+		if err != nil {
+			return fmt.Errorf("error getting SAN policy. err: %w", err)
+		}
+		return nil
+	})
+	return policy, err
+}
+
+func (imp DiskAPI) SetSANPolicy(policy uint16) error {
+	return wmi.WithCOMThread(func() error {
+		current, err := wmi.GetSANPolicy()
+		if err != nil {
+			return fmt.Errorf("error getting SAN policy. err: %w", err)
+		}
+
+		if current == policy {
+			klog.V(2).Infof("SAN policy is already %d", policy)
+			return nil
+		}
+
+		if err := wmi.SetSANPolicy(policy); err != nil {
+			return fmt.Errorf("error setting SAN policy %d. err: %w", policy, err)
+		}
+		return nil
+	})
+}
+
+// ListDisks - constructs a map with the disk number as the key and the DiskInfo structure
+// as the value, from a single MSFT_Disk query.
+func (imp DiskAPI) ListDisks() (map[uint32]shared.DiskInfo, error) {
+	m := make(map[uint32]shared.DiskInfo)
+	err := wmi.WithCOMThread(func() error {
+		return wmi.WithScope(func(scope *wmi.Scope) error {
+			disks, err := wmi.ListDisks(scope, wmi.DiskSelectorListForInfo)
+			if err != nil {
+				return fmt.Errorf("failed to list disks: %w", err)
+			}
+
+			return wmi.ForEach(disks, func(disk *wmi.COMDispatchObject) error {
+				info, err := getDiskInfo(disk)
+				if err != nil {
+					return err
+				}
+
+				m[info.Number] = *info
+				return nil
+			})
+		})
+	})
+	return m, err
+}
+
+func (imp DiskAPI) GetDiskInfo(diskNumber uint32) (*shared.DiskInfo, error) {
+	var info *shared.DiskInfo
+	err := wmi.WithCOMThread(func() error {
+		return wmi.WithScope(func(scope *wmi.Scope) error {
+			disk, err := wmi.QueryDiskByNumber(scope, diskNumber, wmi.DiskSelectorListForInfo)
+			if err != nil {
+				return err
+			}
+
+			info, err = getDiskInfo(disk)
+			return err
+		})
+	})
+	return info, err
+}
+
+// ListPageFileDisks - maps the drive letter of every page file in use to the disk of its partition.
+func (imp DiskAPI) ListPageFileDisks() ([]uint32, error) {
+	var diskNumbers []uint32
+	err := wmi.WithCOMThread(func() error {
+		return wmi.WithScope(func(scope *wmi.Scope) error {
+			pageFiles, err := wmi.ListPageFiles(scope, wmi.PageFileSelectorList)
+			if err != nil {
+				return err
+			}
+
+			return wmi.ForEach(pageFiles, func(pageFile *wmi.COMDispatchObject) error {
+				name, err := wmi.GetPageFileName(pageFile)
+				if err != nil {
+					return fmt.Errorf("failed to query page file name: %v, %w", pageFile, err)
+				}
+				if len(name) < 2 || name[1] != ':' {
+					klog.Warningf("Ignoring page file %q not on a drive letter", name)
+					return nil
+				}
+
+				partitions, err := wmi.ListPartitionsWithFilters(scope, []string{"DiskNumber"}, wmi.WithCondition("DriveLetter", "=", name[:1]))
+				if err != nil {
+					return fmt.Errorf("failed to query the partition of page file %q: %w", name, err)
+				}
+
+				return wmi.ForEach(partitions, func(partition *wmi.COMDispatchObject) error {
+					diskNumber, err := wmi.GetPartitionDiskNumber(partition)
+					if err != nil {
+						return fmt.Errorf("failed to query the disk of page file %q: %w", name, err)
+					}
+					diskNumbers = append(diskNumbers, diskNumber)
+					return nil
+				})
+			})
+		})
+	})
+	return diskNumbers, err
+}
+
+// getDiskInfo reads the DiskInfo from a MSFT_Disk queried with wmi.DiskSelectorListForInfo.
+func getDiskInfo(disk *wmi.COMDispatchObject) (*shared.DiskInfo, error) {
+	var info shared.DiskInfo
+	var err error
+
+	if info.Number, err = wmi.GetDiskNumber(disk); err != nil {
+		return nil, fmt.Errorf("failed to query disk number: %v, %w", disk, err)
+	}
+	if info.Size, err = wmi.GetDiskSize(disk); err != nil {
+		return nil, fmt.Errorf("failed to query size of disk %d: %w", info.Number, err)
+	}
+	if info.LogicalSectorSize, err = wmi.GetDiskLogicalSectorSize(disk); err != nil {
+		return nil, fmt.Errorf("failed to query logical sector size of disk %d: %w", info.Number, err)
+	}
+	if info.PhysicalSectorSize, err = wmi.GetDiskPhysicalSectorSize(disk); err != nil {
+		return nil, fmt.Errorf("failed to query physical sector size of disk %d: %w", info.Number, err)
+	}
+	if info.BusType, err = wmi.GetDiskBusType(disk); err != nil {
+		return nil, fmt.Errorf("failed to query bus type of disk %d: %w", info.Number, err)
+	}
+	if info.FriendlyName, err = wmi.GetDiskFriendlyName(disk); err != nil {
+		return nil, fmt.Errorf("failed to query friendly name of disk %d: %w", info.Number, err)
+	}
+	if info.Model, err = wmi.GetDiskModel(disk); err != nil {
+		return nil, fmt.Errorf("failed to query model of disk %d: %w", info.Number, err)
+	}
+	if info.SerialNumber, err = wmi.GetDiskSerialNumber(disk); err != nil {
+		return nil, fmt.Errorf("failed to query serial number of disk %d: %w", info.Number, err)
+	}
+	if info.Page83, err = wmi.GetDiskUniqueID(disk); err != nil {
+		return nil, fmt.Errorf("failed to query unique ID of disk %d: %w", info.Number, err)
+	}
+	if info.PartitionStyle, err = wmi.GetDiskPartitionStyle(disk); err != nil {
+		return nil, fmt.Errorf("failed to query partition style of disk %d: %w", info.Number, err)
+	}
+	if info.IsBoot, err = wmi.IsDiskBoot(disk); err != nil {
+		return nil, fmt.Errorf("failed to query boot state of disk %d: %w", info.Number, err)
+	}
+	if info.IsSystem, err = wmi.IsDiskSystem(disk); err != nil {
+		return nil, fmt.Errorf("failed to query system state of disk %d: %w", info.Number, err)
+	}
+	if info.IsClustered, err = wmi.IsDiskClustered(disk); err != nil {
+		return nil, fmt.Errorf("failed to query clustered state of disk %d: %w", info.Number, err)
+	}
+	if info.IsReadOnly, err = wmi.IsDiskReadOnly(disk); err != nil {
+		return nil, fmt.Errorf("failed to query read only state of disk %d: %w", info.Number, err)
+	}
+	if info.IsOffline, err = wmi.IsDiskOffline(disk); err != nil {
+		return nil, fmt.Errorf("failed to query offline state of disk %d: %w", info.Number, err)
+	}
+	if info.OfflineReason, err = wmi.GetDiskOfflineReason(disk); err != nil {
+		return nil, fmt.Errorf("failed to query offline reason of disk %d: %w", info.Number, err)
+	}
+
+	location, err := wmi.GetDiskLocation(disk)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query location of disk %d: %w", info.Number, err)
+	}
+	info.Location, _ = parseDiskLocation(location)
+
+	return &info, nil
+}
+
+func (imp DiskAPI) CheckHealth() error {
+	return wmi.CheckClass(wmi.WMINamespaceStorage, wmi.MSFTDiskClass)
+}
@@ -113,3 +113,37 @@ type Disk struct {
 	Path         string `json:"Path"`
 	SerialNumber string `json:"SerialNumber"`
 }
+
+// PartitionStyle is the Win32 PARTITION_STYLE, which numbers the styles differently from MSFT_Disk.
+type PartitionStyle uint32
+
+const (
+	PartitionStyleMBR PartitionStyle = 0
+	PartitionStyleGPT PartitionStyle = 1
+	PartitionStyleRAW PartitionStyle = 2
+)
+
+type PartitionInformationGPT struct {
+	PartitionType [16]byte
+	PartitionID   [16]byte
+	Attributes    uint64
+	Name          [36]uint16
+}
+
+// PartitionInformationEx is the PARTITION_INFORMATION_EX of GPT partitions, the MBR member of its union
+// being smaller than the GPT one.
+type PartitionInformationEx struct {
+	PartitionStyle     PartitionStyle
+	StartingOffset     int64
+	PartitionLength    int64
+	PartitionNumber    uint32
+	RewritePartition   byte
+	IsServicePartition byte
+	Gpt                PartitionInformationGPT
+}
+
+// SetPartitionInformationEx is the SET_PARTITION_INFORMATION_EX of GPT partitions.
+type SetPartitionInformationEx struct {
+	PartitionStyle PartitionStyle
+	Gpt            PartitionInformationGPT
+}
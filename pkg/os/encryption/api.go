@@ -0,0 +1,159 @@
+package encryption
+
+import (
+	"fmt"
+
+	"github.com/kubernetes-csi/csi-proxy/pkg/wmi"
+)
+
+// Implements the BitLocker OS API calls. All code here should be very simple
+// pass-through to the OS APIs. Any logic around the APIs should go in
+// pkg/server/encryption/server.go so that logic can be easily unit-tested
+// without requiring specific OS environments.
+//
+// The secrets of the key protectors are never logged nor part of the returned errors.
+
+// API exposes the internal BitLocker operations available in the server
+type API interface {
+	// GetEncryptionStatus returns the BitLocker state of a volume.
+	GetEncryptionStatus(volumeID string) (*EncryptionStatus, error)
+	// AddKeyProtector adds a key protector of type `protectorType` with `secret` to a volume, and returns its ID.
+	AddKeyProtector(volumeID string, protectorType KeyProtectorType, secret string) (string, error)
+	// Encrypt starts encrypting a volume with an encryption method, e.g. 6 (XTS-AES 128).
+	Encrypt(volumeID string, encryptionMethod uint32, usedSpaceOnly bool) error
+	// Unlock unlocks a volume with the secret of one of its key protectors.
+	Unlock(volumeID string, protectorType KeyProtectorType, secret string) error
+	// Lock locks a volume, dismounting it even if it is in use with `forceDismount`.
+	Lock(volumeID string, forceDismount bool) error
+	// Decrypt starts decrypting a volume.
+	Decrypt(volumeID string) error
+}
+
+// EncryptionAPI implements the API interface on the Win32_EncryptableVolume WMI class.
+type EncryptionAPI struct{}
+
+// verifies that the API is implemented
+var _ API = &EncryptionAPI{}
+
+// New EncryptionAPI implementation.
+func New() EncryptionAPI {
+	return EncryptionAPI{}
+}
+
+// withEncryptableVolume runs fn with the Win32_EncryptableVolume of a volume.
+func withEncryptableVolume(volumeID string, fn func(volume *wmi.COMDispatchObject) error) error {
+	return wmi.WithCOMThread(func() error {
+		return wmi.WithScope(func(scope *wmi.Scope) error {
+			volume, err := wmi.QueryEncryptableVolumeByDeviceID(scope, volumeID, wmi.EncryptableVolumeSelectorList)
+			if err != nil {
+				return err
+			}
+			return fn(volume)
+		})
+	})
+}
+
+func (EncryptionAPI) GetEncryptionStatus(volumeID string) (*EncryptionStatus, error) {
+	status := &EncryptionStatus{}
+	err := withEncryptableVolume(volumeID, func(volume *wmi.COMDispatchObject) error {
+		var err error
+		status.Locked, err = wmi.IsVolumeLocked(volume)
+		if err != nil {
+			return fmt.Errorf("error getting lock status of volume (%s). error: %w", volumeID, err)
+		}
+
+		status.ProtectionStatus, err = wmi.GetProtectionStatus(volume)
+		if err != nil {
+			return fmt.Errorf("error getting protection status of volume (%s). error: %w", volumeID, err)
+		}
+
+		// the metadata of a locked volume is encrypted
+		if status.Locked {
+			return nil
+		}
+
+		status.ConversionStatus, status.EncryptionPercentage, err = wmi.GetConversionStatus(volume)
+		if err != nil {
+			return fmt.Errorf("error getting conversion status of volume (%s). error: %w", volumeID, err)
+		}
+
+		status.EncryptionMethod, err = wmi.GetEncryptionMethod(volume)
+		if err != nil {
+			return fmt.Errorf("error getting encryption method of volume (%s). error: %w", volumeID, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+func (EncryptionAPI) AddKeyProtector(volumeID string, protectorType KeyProtectorType, secret string) (string, error) {
+	var keyProtectorID string
+	err := withEncryptableVolume(volumeID, func(volume *wmi.COMDispatchObject) error {
+		var err error
+		switch protectorType {
+		case KeyProtectorTypeRecoveryPassword:
+			keyProtectorID, err = wmi.ProtectKeyWithNumericalPassword(volume, secret)
+		case KeyProtectorTypePassword:
+			keyProtectorID, err = wmi.ProtectKeyWithPassphrase(volume, secret)
+		default:
+			err = fmt.Errorf("unknown key protector type %d", protectorType)
+		}
+		if err != nil {
+			return fmt.Errorf("error adding key protector to volume (%s). error: %w", volumeID, err)
+		}
+		return nil
+	})
+	return keyProtectorID, err
+}
+
+func (EncryptionAPI) Encrypt(volumeID string, encryptionMethod uint32, usedSpaceOnly bool) error {
+	var encryptionFlags uint32
+	if usedSpaceOnly {
+		encryptionFlags |= wmi.EncryptionFlagDataOnly
+	}
+	return withEncryptableVolume(volumeID, func(volume *wmi.COMDispatchObject) error {
+		if err := wmi.EncryptVolume(volume, encryptionMethod, encryptionFlags); err != nil {
+			return fmt.Errorf("error encrypting volume (%s) with method %d. error: %w", volumeID, encryptionMethod, err)
+		}
+		return nil
+	})
+}
+
+func (EncryptionAPI) Unlock(volumeID string, protectorType KeyProtectorType, secret string) error {
+	return withEncryptableVolume(volumeID, func(volume *wmi.COMDispatchObject) error {
+		var err error
+		switch protectorType {
+		case KeyProtectorTypeRecoveryPassword:
+			err = wmi.UnlockWithNumericalPassword(volume, secret)
+		case KeyProtectorTypePassword:
+			err = wmi.UnlockWithPassphrase(volume, secret)
+		default:
+			err = fmt.Errorf("unknown key protector type %d", protectorType)
+		}
+		if err != nil {
+			return fmt.Errorf("error unlocking volume (%s). error: %w", volumeID, err)
+		}
+		return nil
+	})
+}
+
+func (EncryptionAPI) Lock(volumeID string, forceDismount bool) error {
+	return withEncryptableVolume(volumeID, func(volume *wmi.COMDispatchObject) error {
+		if err := wmi.LockVolume(volume, forceDismount); err != nil {
+			return fmt.Errorf("error locking volume (%s). error: %w", volumeID, err)
+		}
+		return nil
+	})
+}
+
+func (EncryptionAPI) Decrypt(volumeID string) error {
+	return withEncryptableVolume(volumeID, func(volume *wmi.COMDispatchObject) error {
+		if err := wmi.DecryptVolume(volume); err != nil {
+			return fmt.Errorf("error decrypting volume (%s). error: %w", volumeID, err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,27 @@
+package encryption
+
+// KeyProtectorType is the type of a key protector supplied with a secret.
+type KeyProtectorType uint32
+
+const (
+	// KeyProtectorTypeRecoveryPassword is a 48-digit numerical recovery password.
+	KeyProtectorTypeRecoveryPassword KeyProtectorType = iota
+	// KeyProtectorTypePassword is a password (passphrase).
+	KeyProtectorTypePassword
+)
+
+// EncryptionStatus is the BitLocker state of a volume, as reported by the Win32_EncryptableVolume methods.
+type EncryptionStatus struct {
+	// Locked is true if the volume is locked, its conversion status and encryption method are unknown then
+	Locked bool
+	// ConversionStatus is 0 (Fully decrypted), 1 (Fully encrypted), 2 (Encryption in progress),
+	// 3 (Decryption in progress), 4 (Encryption paused) or 5 (Decryption paused)
+	ConversionStatus uint32
+	// EncryptionPercentage is the percentage of the volume which is encrypted, from 0 to 100
+	EncryptionPercentage uint32
+	// ProtectionStatus is 0 (Unprotected), 1 (Protected) or 2 (Unknown)
+	ProtectionStatus uint32
+	// EncryptionMethod is 0 (Not encrypted), 1 (AES 128 with diffuser), 2 (AES 256 with diffuser), 3 (AES 128),
+	// 4 (AES 256), 5 (Hardware encryption), 6 (XTS-AES 128) or 7 (XTS-AES 256)
+	EncryptionMethod uint32
+}
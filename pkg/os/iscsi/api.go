@@ -239,3 +239,7 @@ func (APIImplementor) SetMutualChapSecret(mutualChapSecret string) error {
 		return nil
 	})
 }
+
+func (APIImplementor) CheckHealth() error {
+	return wmi.CheckClass(wmi.WMINamespaceStorage, wmi.MSFTiSCSITargetClass)
+}
@@ -3,6 +3,7 @@ package smb
 import (
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/kubernetes-csi/csi-proxy/pkg/utils"
 	"github.com/kubernetes-csi/csi-proxy/pkg/wmi"
@@ -13,20 +14,38 @@ type API interface {
 	NewSmbLink(remotePath, localPath string) error
 	NewSmbGlobalMapping(remotePath, username, password string) error
 	RemoveSmbGlobalMapping(remotePath string) error
+	// CheckHealth returns an error if the SMB global mappings can't be queried.
+	CheckHealth() error
 }
 
 type SmbAPI struct {
-	RequirePrivacy bool
+	privacyRequired bool
+	// mutex guards privacyRequired, which can be changed while serving requests, see SetRequirePrivacy.
+	mutex sync.RWMutex
 }
 
 var _ API = &SmbAPI{}
 
 func New(requirePrivacy bool) *SmbAPI {
 	return &SmbAPI{
-		RequirePrivacy: requirePrivacy,
+		privacyRequired: requirePrivacy,
 	}
 }
 
+// SetRequirePrivacy sets whether new SMB global mappings require privacy (SMB encryption).
+func (api *SmbAPI) SetRequirePrivacy(requirePrivacy bool) {
+	api.mutex.Lock()
+	defer api.mutex.Unlock()
+	api.privacyRequired = requirePrivacy
+}
+
+// requirePrivacy returns whether new SMB global mappings require privacy (SMB encryption).
+func (api *SmbAPI) requirePrivacy() bool {
+	api.mutex.RLock()
+	defer api.mutex.RUnlock()
+	return api.privacyRequired
+}
+
 func (*SmbAPI) IsSmbMapped(remotePath string) (bool, error) {
 	var isMapped bool
 	err := wmi.WithCOMThread(func() error {
@@ -70,7 +89,7 @@ func (*SmbAPI) NewSmbLink(remotePath, localPath string) error {
 
 func (api *SmbAPI) NewSmbGlobalMapping(remotePath, username, password string) error {
 	return wmi.WithCOMThread(func() error {
-		err := wmi.NewSmbGlobalMapping(remotePath, username, password, api.RequirePrivacy)
+		err := wmi.NewSmbGlobalMapping(remotePath, username, password, api.requirePrivacy())
 		if err != nil {
 			return fmt.Errorf("NewSmbGlobalMapping failed. err: %w", err)
 		}
@@ -89,3 +108,7 @@ func (*SmbAPI) RemoveSmbGlobalMapping(remotePath string) error {
 		})
 	})
 }
+
+func (*SmbAPI) CheckHealth() error {
+	return wmi.CheckClass(wmi.WMINamespaceSmb, wmi.MSFTSmbGlobalMappingClass)
+}
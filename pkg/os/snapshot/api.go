@@ -0,0 +1,167 @@
+package snapshot
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kubernetes-csi/csi-proxy/pkg/wmi"
+	"golang.org/x/sys/windows"
+	"k8s.io/klog/v2"
+)
+
+// Implements the snapshot OS API calls. All code here should be very simple
+// pass-through to the OS APIs. Any logic around the APIs should go in
+// pkg/server/snapshot/server.go so that logic can be easily unit-tested
+// without requiring specific OS environments.
+
+// API exposes the internal snapshot operations available in the server
+type API interface {
+	// CreateShadowCopy creates a persistent shadow copy of a volume.
+	CreateShadowCopy(volumeID string) (*ShadowCopy, error)
+	// ListShadowCopies lists the shadow copies of a volume, or of all the volumes if `volumeID` is empty.
+	ListShadowCopies(volumeID string) ([]ShadowCopy, error)
+	// DeleteShadowCopy deletes a shadow copy, it succeeds if the shadow copy doesn't exist.
+	DeleteShadowCopy(shadowCopyID string) error
+	// ExposeShadowCopy creates `path` as a directory symbolic link to the root of a shadow copy.
+	ExposeShadowCopy(shadowCopyID, path string) error
+}
+
+// SnapshotAPI implements the API interface on the Win32_ShadowCopy WMI class.
+type SnapshotAPI struct{}
+
+// verifies that the API is implemented
+var _ API = &SnapshotAPI{}
+
+// New SnapshotAPI implementation.
+func New() SnapshotAPI {
+	return SnapshotAPI{}
+}
+
+func (SnapshotAPI) CreateShadowCopy(volumeID string) (*ShadowCopy, error) {
+	var shadowCopy *ShadowCopy
+	err := wmi.WithCOMThread(func() error {
+		return wmi.WithScope(func(scope *wmi.Scope) error {
+			id, err := wmi.CreateShadowCopy(volumeID)
+			if err != nil {
+				return fmt.Errorf("error creating shadow copy of volume (%s). error: %w", volumeID, err)
+			}
+
+			object, err := wmi.QueryShadowCopyByID(scope, id, wmi.ShadowCopySelectorList)
+			if err != nil {
+				return fmt.Errorf("error querying shadow copy (%s) of volume (%s). error: %w", id, volumeID, err)
+			}
+
+			shadowCopy, err = getShadowCopy(object)
+			return err
+		})
+	})
+	return shadowCopy, err
+}
+
+func (SnapshotAPI) ListShadowCopies(volumeID string) ([]ShadowCopy, error) {
+	var shadowCopies []ShadowCopy
+	err := wmi.WithCOMThread(func() error {
+		return wmi.WithScope(func(scope *wmi.Scope) error {
+			objects, err := wmi.QueryShadowCopies(scope, volumeID, wmi.ShadowCopySelectorList)
+			if err != nil {
+				return err
+			}
+
+			return wmi.ForEach(objects, func(object *wmi.COMDispatchObject) error {
+				shadowCopy, err := getShadowCopy(object)
+				if err != nil {
+					return err
+				}
+				shadowCopies = append(shadowCopies, *shadowCopy)
+				return nil
+			})
+		})
+	})
+	return shadowCopies, err
+}
+
+func (SnapshotAPI) DeleteShadowCopy(shadowCopyID string) error {
+	return wmi.WithCOMThread(func() error {
+		return wmi.WithScope(func(scope *wmi.Scope) error {
+			object, err := wmi.QueryShadowCopyByID(scope, shadowCopyID, wmi.ShadowCopySelectorList)
+			if wmi.IsNotFound(err) {
+				klog.V(2).Infof("shadow copy (%s) not found, nothing to delete", shadowCopyID)
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+
+			if err := wmi.DeleteShadowCopy(object); err != nil {
+				return fmt.Errorf("error deleting shadow copy (%s). error: %w", shadowCopyID, err)
+			}
+			return nil
+		})
+	})
+}
+
+func (SnapshotAPI) ExposeShadowCopy(shadowCopyID, path string) error {
+	var deviceObject string
+	err := wmi.WithCOMThread(func() error {
+		return wmi.WithScope(func(scope *wmi.Scope) error {
+			object, err := wmi.QueryShadowCopyByID(scope, shadowCopyID, wmi.ShadowCopySelectorList)
+			if err != nil {
+				return err
+			}
+
+			deviceObject, err = wmi.GetShadowCopyDeviceObject(object)
+			if err != nil {
+				return fmt.Errorf("error getting the device object of shadow copy (%s). error: %w", shadowCopyID, err)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	// the device object is the root directory of the shadow copy only with a trailing backslash
+	target := strings.TrimSuffix(deviceObject, `\`) + `\`
+	pathPtr, err := windows.UTF16PtrFromString(path)
+	if err != nil {
+		return err
+	}
+	targetPtr, err := windows.UTF16PtrFromString(target)
+	if err != nil {
+		return err
+	}
+	if err := windows.CreateSymbolicLink(pathPtr, targetPtr, windows.SYMBOLIC_LINK_FLAG_DIRECTORY); err != nil {
+		return fmt.Errorf("error linking (%s) to shadow copy (%s) at (%s). error: %w", path, shadowCopyID, target, err)
+	}
+	return nil
+}
+
+// getShadowCopy returns the shadow copy of a Win32_ShadowCopy object.
+func getShadowCopy(object *wmi.COMDispatchObject) (*ShadowCopy, error) {
+	id, err := wmi.GetShadowCopyID(object)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query shadow copy ID: %w", err)
+	}
+
+	volumeName, err := wmi.GetShadowCopyVolumeName(object)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the volume of shadow copy (%s): %w", id, err)
+	}
+
+	deviceObject, err := wmi.GetShadowCopyDeviceObject(object)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the device object of shadow copy (%s): %w", id, err)
+	}
+
+	creationTime, err := wmi.GetShadowCopyInstallDate(object)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the creation time of shadow copy (%s): %w", id, err)
+	}
+
+	return &ShadowCopy{
+		ID:           id,
+		VolumeID:     volumeName,
+		DeviceObject: deviceObject,
+		CreationTime: creationTime,
+	}, nil
+}
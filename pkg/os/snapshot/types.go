@@ -0,0 +1,15 @@
+package snapshot
+
+import "time"
+
+// ShadowCopy is a Volume Shadow Copy Service (VSS) shadow copy of a volume.
+type ShadowCopy struct {
+	// ID is the ID of the shadow copy, e.g. {1fb1a4b6-7b1a-4b27-9a0a-cb5a9d8f1f5e}.
+	ID string
+	// VolumeID is the volume device ID of the volume, e.g. \\?\Volume{...}\.
+	VolumeID string
+	// DeviceObject is the device object of the shadow copy, e.g. \\?\GLOBALROOT\Device\HarddiskVolumeShadowCopy1.
+	DeviceObject string
+	// CreationTime is the time the shadow copy was created.
+	CreationTime time.Time
+}
@@ -25,14 +25,17 @@ type API interface {
 	MountVolume(volumeID, targetPath string) error
 	// UnmountVolume gracefully dismounts a volume.
 	UnmountVolume(volumeID, targetPath string) error
-	// IsVolumeFormatted checks if a volume is formatted with NTFS.
-	IsVolumeFormatted(volumeID string) (bool, error)
-	// FormatVolume formats a volume with the NTFS format.
-	FormatVolume(volumeID string) error
-	// ResizeVolume performs resizing of the partition and file system for a block based volume.
-	ResizeVolume(volumeID string, sizeBytes int64) error
-	// GetVolumeStats gets the volume information.
-	GetVolumeStats(volumeID string) (int64, int64, error)
+	// GetVolumeFileSystem returns the file system of a volume, e.g. NTFS, or an empty string if it isn't formatted.
+	GetVolumeFileSystem(volumeID string) (string, error)
+	// FormatVolume formats a volume with the file system and the options in `options`.
+	FormatVolume(volumeID string, options FormatOptions) error
+	// ResizeVolume performs resizing of the partition and file system for a block based volume, shrinking
+	// them only if `allowShrink` is set, and reports the sizes before and after.
+	ResizeVolume(volumeID string, sizeBytes int64, allowShrink bool) (*ResizeResult, error)
+	// GetVolumeStats gets the size and the health of a volume.
+	GetVolumeStats(volumeID string) (*VolumeStats, error)
+	// RepairVolume scans the file system of a volume for errors, and fixes them unless `mode` is RepairModeScan.
+	RepairVolume(volumeID string, mode RepairMode) (*RepairResult, error)
 	// GetDiskNumberFromVolumeID returns the disk number for a given volumeID.
 	GetDiskNumberFromVolumeID(volumeID string) (uint32, error)
 	// GetVolumeIDFromTargetPath returns the volume id of a given target path.
@@ -41,6 +44,8 @@ type API interface {
 	WriteVolumeCache(volumeID string) error
 	// GetClosestVolumeIDFromTargetPath returns the volume id of a given target path.
 	GetClosestVolumeIDFromTargetPath(targetPath string) (string, error)
+	// CheckHealth returns an error if the volumes can't be queried.
+	CheckHealth() error
 }
 
 // VolumeAPI implements the internal Volume APIs
@@ -102,8 +107,27 @@ func (VolumeAPI) ListVolumesOnDisk(diskNumber uint32, partitionNumber uint32) (v
 	return
 }
 
-// FormatVolume - Formats a volume with the NTFS format.
-func (VolumeAPI) FormatVolume(volumeID string) (err error) {
+// FormatVolume - Formats a volume with the file system and the options in `options`.
+func (VolumeAPI) FormatVolume(volumeID string, options FormatOptions) (err error) {
+	fileSystem := options.FileSystem
+	if fileSystem == "" {
+		fileSystem = FileSystemNTFS
+	}
+	// nil leaves the parameters to the defaults of the file system
+	var allocationUnitSize, compress, setIntegrityStreams, useLargeFRS interface{}
+	if options.AllocationUnitSize > 0 {
+		allocationUnitSize = options.AllocationUnitSize
+	}
+	if options.Compress {
+		compress = true
+	}
+	if options.SetIntegrityStreams {
+		setIntegrityStreams = true
+	}
+	if options.UseLargeFRS {
+		useLargeFRS = true
+	}
+
 	return wmi.WithCOMThread(func() error {
 		return wmi.WithScope(func(scope *wmi.Scope) error {
 			volume, err := wmi.QueryVolumeByUniqueID(scope, volumeID, nil)
@@ -112,19 +136,19 @@ func (VolumeAPI) FormatVolume(volumeID string) (err error) {
 			}
 
 			err = wmi.FormatVolume(volume,
-				"NTFS", // Format,
-				"",     // FileSystemLabel,
-				nil,    // AllocationUnitSize,
-				false,  // Full,
-				true,   // Force
-				nil,    // Compress,
-				nil,    // ShortFileNameSupport,
-				nil,    // SetIntegrityStreams,
-				nil,    // UseLargeFRS,
-				nil,    // DisableHeatGathering,
+				fileSystem,          // Format,
+				options.Label,       // FileSystemLabel,
+				allocationUnitSize,  // AllocationUnitSize,
+				options.Full,        // Full,
+				true,                // Force
+				compress,            // Compress,
+				nil,                 // ShortFileNameSupport,
+				setIntegrityStreams, // SetIntegrityStreams,
+				useLargeFRS,         // UseLargeFRS,
+				nil,                 // DisableHeatGathering,
 			)
 			if err != nil {
-				return fmt.Errorf("error formatting volume (%s). error: %w", volumeID, err)
+				return fmt.Errorf("error formatting volume (%s) with %s. error: %w", volumeID, fileSystem, err)
 			}
 			return nil
 		})
@@ -136,12 +160,12 @@ func (VolumeAPI) WriteVolumeCache(volumeID string) (err error) {
 	return writeCache(volumeID)
 }
 
-// IsVolumeFormatted - Check if the volume is formatted with the pre specified filesystem(typically ntfs).
-func (VolumeAPI) IsVolumeFormatted(volumeID string) (bool, error) {
-	var formatted bool
+// GetVolumeFileSystem - Returns the file system of the volume, or an empty string if it isn't formatted.
+func (VolumeAPI) GetVolumeFileSystem(volumeID string) (string, error) {
+	var fileSystem string
 	err := wmi.WithCOMThread(func() error {
 		return wmi.WithScope(func(scope *wmi.Scope) error {
-			volume, err := wmi.QueryVolumeByUniqueID(scope, volumeID, wmi.VolumeSelectorListForFileSystemType)
+			volume, err := wmi.QueryVolumeByUniqueID(scope, volumeID, wmi.VolumeSelectorListForFileSystem)
 			if err != nil {
 				return fmt.Errorf("error querying volume (%s). error: %w", volumeID, err)
 			}
@@ -151,11 +175,19 @@ func (VolumeAPI) IsVolumeFormatted(volumeID string) (bool, error) {
 				return fmt.Errorf("failed to query volume file system type (%s): %w", volumeID, err)
 			}
 
-			formatted = fsType != wmi.FileSystemUnknown
+			fileSystem, err = wmi.GetVolumeFileSystem(volume)
+			if err != nil {
+				return fmt.Errorf("failed to query volume file system (%s): %w", volumeID, err)
+			}
+
+			// some file systems, e.g. exFAT, have no FileSystemType but are named
+			if fsType == wmi.FileSystemUnknown && (fileSystem == "" || strings.EqualFold(fileSystem, "RAW")) {
+				fileSystem = ""
+			}
 			return nil
 		})
 	})
-	return formatted, err
+	return fileSystem, err
 }
 
 // MountVolume - mounts a volume to a path. This is done using Win32 API SetVolumeMountPoint for presenting the volume via a path.
@@ -203,52 +235,67 @@ func (VolumeAPI) UnmountVolume(volumeID, path string) error {
 	return nil
 }
 
-// ResizeVolume - resizes a volume with the given size, if size == 0 then max supported size is used
-func (VolumeAPI) ResizeVolume(volumeID string, size int64) error {
-	return wmi.WithCOMThread(func() error {
+// ResizeVolume - resizes the partition and the file system of a volume to `size`, the maximum size if 0.
+// Growing by less than 100MB is skipped, as is shrinking unless `allowShrink` is set. A volume is never
+// shrunk below the minimum size of its partition, which depends on the data of the file system.
+func (VolumeAPI) ResizeVolume(volumeID string, size int64, allowShrink bool) (*ResizeResult, error) {
+	if size < 0 {
+		return nil, fmt.Errorf("invalid negative size %d for volume (%s)", size, volumeID)
+	}
+
+	result := &ResizeResult{}
+	err := wmi.WithCOMThread(func() error {
 		return wmi.WithScope(func(scope *wmi.Scope) error {
-			var err error
-			var finalSize uint64
 			part, err := wmi.GetPartitionByVolumeUniqueID(scope, volumeID)
 			if err != nil {
 				return err
 			}
 
-			// If size is 0 then we will resize to the maximum size possible, otherwise just resize to size
-			if size == 0 {
-				var status string
-				_, finalSize, status, err = wmi.GetPartitionSupportedSize(part)
-				if err != nil {
-					return fmt.Errorf("error getting sizeMin, sizeMax from volume (%s). status: %s, error: %w", volumeID, status, err)
-				}
+			currentSize, err := wmi.GetPartitionSize(part)
+			if err != nil {
+				return fmt.Errorf("error getting the current size of volume (%s) with error (%w)", volumeID, err)
+			}
+			result.PartitionSizeBefore = int64(currentSize)
+			result.PartitionSizeAfter = result.PartitionSizeBefore
 
-			} else {
-				if size < 0 {
-					return fmt.Errorf("invalid negative size %d for volume (%s)", size, volumeID)
-				}
-				finalSize = uint64(size)
+			fileSystemSize, err := getFileSystemSize(scope, volumeID)
+			if err != nil {
+				return err
 			}
+			result.FileSystemSizeBefore = fileSystemSize
+			result.FileSystemSizeAfter = fileSystemSize
 
-			currentSize, err := wmi.GetPartitionSize(part)
+			sizeMin, sizeMax, status, err := wmi.GetPartitionSupportedSize(part)
 			if err != nil {
-				return fmt.Errorf("error getting the current size of volume (%s) with error (%w)", volumeID, err)
+				return fmt.Errorf("error getting sizeMin, sizeMax from volume (%s). status: %s, error: %w", volumeID, status, err)
 			}
 
-			// only resize if finalSize - currentSize is greater than 100MB
-			if finalSize < uint64(currentSize)+minimumResizeSize {
-				klog.V(2).Infof("minimum resize difference (100MB) not met, skipping resize. volumeID=%s currentSize=%d finalSize=%d", volumeID, currentSize, finalSize)
-				return nil
+			// If size is 0 then we will resize to the maximum size possible, otherwise just resize to size
+			finalSize := sizeMax
+			if size > 0 {
+				finalSize = uint64(size)
 			}
 
-			//if the partition's size is already the size we want this is a noop, just return
-			if currentSize >= finalSize {
-				klog.V(2).Infof("Attempted to resize volume (%s) to a lower size, from currentBytes=%d wantedBytes=%d", volumeID, currentSize, finalSize)
+			switch {
+			case finalSize == currentSize:
+				result.skip("the partition already has the requested size")
+				return nil
+			case finalSize > currentSize && finalSize < currentSize+minimumResizeSize:
+				// only resize if finalSize - currentSize is greater than 100MB
+				result.skip(fmt.Sprintf("the partition would grow by less than the minimum of %d bytes", minimumResizeSize))
+				return nil
+			case finalSize < currentSize && !allowShrink:
+				result.skip("the requested size is smaller than the partition, and shrinking isn't allowed")
 				return nil
+			case finalSize < sizeMin:
+				return fmt.Errorf("%w: volume (%s) can't be shrunk to %d bytes, its minimum size is %d bytes", ErrBelowMinimumSize, volumeID, finalSize, sizeMin)
+			case finalSize > sizeMax:
+				return fmt.Errorf("%w: volume (%s) can't be grown to %d bytes, its maximum size is %d bytes", ErrAboveMaximumSize, volumeID, finalSize, sizeMax)
 			}
 
-			_, err = wmi.ResizePartition(part, finalSize)
+			status, err = wmi.ResizePartition(part, finalSize)
 			if err != nil {
-				return fmt.Errorf("error resizing volume (%s). size:%v, finalSize %v, error: %w", volumeID, size, finalSize, err)
+				return fmt.Errorf("error resizing volume (%s). size:%v, finalSize %v, status: %s, error: %w", volumeID, size, finalSize, status, err)
 			}
 
 			diskNumber, err := wmi.GetPartitionDiskNumber(part)
@@ -266,16 +313,48 @@ func (VolumeAPI) ResizeVolume(volumeID string, size int64) error {
 				return fmt.Errorf("error rescan disk (%d). error: %w", diskNumber, err)
 			}
 
-			return nil
+			// the partition and the volume are queried again for their new sizes
+			part, err = wmi.GetPartitionByVolumeUniqueID(scope, volumeID)
+			if err != nil {
+				return err
+			}
+			newSize, err := wmi.GetPartitionSize(part)
+			if err != nil {
+				return fmt.Errorf("error getting the new size of volume (%s) with error (%w)", volumeID, err)
+			}
+			result.PartitionSizeAfter = int64(newSize)
+
+			result.FileSystemSizeAfter, err = getFileSystemSize(scope, volumeID)
+			return err
 		})
 	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Skipped {
+		klog.V(2).Infof("Skipped resizing volume (%s) to %d bytes: %s", volumeID, size, result.SkipReason)
+	}
+	return result, nil
+}
+
+// getFileSystemSize returns the size of the file system of a volume.
+func getFileSystemSize(scope *wmi.Scope, volumeID string) (int64, error) {
+	volume, err := wmi.QueryVolumeByUniqueID(scope, volumeID, wmi.VolumeSelectorListForStats)
+	if err != nil {
+		return 0, fmt.Errorf("error querying volume (%s). error: %w", volumeID, err)
+	}
+
+	size, err := wmi.GetVolumeSize(volume)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query volume size (%s): %w", volumeID, err)
+	}
+	return int64(size), nil
 }
 
 // GetVolumeStats - retrieves the volume stats for a given volume
-func (VolumeAPI) GetVolumeStats(volumeID string) (volumeSize, volumeUsedSize int64, err error) {
-	volumeSize = -1
-	volumeUsedSize = -1
-	err = wmi.WithCOMThread(func() error {
+func (VolumeAPI) GetVolumeStats(volumeID string) (*VolumeStats, error) {
+	stats := &VolumeStats{}
+	err := wmi.WithCOMThread(func() error {
 		return wmi.WithScope(func(scope *wmi.Scope) error {
 			volume, err := wmi.QueryVolumeByUniqueID(scope, volumeID, wmi.VolumeSelectorListForStats)
 			if err != nil {
@@ -292,12 +371,76 @@ func (VolumeAPI) GetVolumeStats(volumeID string) (volumeSize, volumeUsedSize int
 				return fmt.Errorf("failed to query volume remaining size (%s): %w", volumeID, err)
 			}
 
-			volumeSize = int64(getVolumeSize)
-			volumeUsedSize = volumeSize - int64(volumeSizeRemaining)
+			stats.TotalBytes = int64(getVolumeSize)
+			stats.UsedBytes = stats.TotalBytes - int64(volumeSizeRemaining)
+
+			stats.HealthStatus, stats.OperationalStatus, err = getVolumeHealth(volume)
+			if err != nil {
+				return fmt.Errorf("failed to query volume health (%s): %w", volumeID, err)
+			}
 			return nil
 		})
 	})
-	return
+	if err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// RepairVolume - scans a volume for errors and fixes them with a spot fix or an offline scan and fix,
+// depending on `mode`, then reports the health of the volume and its dirty bit.
+func (VolumeAPI) RepairVolume(volumeID string, mode RepairMode) (*RepairResult, error) {
+	result := &RepairResult{}
+	err := wmi.WithCOMThread(func() error {
+		return wmi.WithScope(func(scope *wmi.Scope) error {
+			volume, err := wmi.QueryVolumeByUniqueID(scope, volumeID, wmi.VolumeSelectorListForHealth)
+			if err != nil {
+				return fmt.Errorf("error querying volume (%s). error: %w", volumeID, err)
+			}
+
+			result.Output, err = wmi.RepairVolume(volume, mode == RepairModeOfflineScanAndFix, mode == RepairModeScan, mode == RepairModeSpotFix)
+			if err != nil {
+				return fmt.Errorf("error repairing volume (%s) with mode %d. error: %w", volumeID, mode, err)
+			}
+
+			// the volume is queried again for its health after the repair
+			volume, err = wmi.QueryVolumeByUniqueID(scope, volumeID, wmi.VolumeSelectorListForHealth)
+			if err != nil {
+				return fmt.Errorf("error querying volume (%s). error: %w", volumeID, err)
+			}
+			result.HealthStatus, result.OperationalStatus, err = getVolumeHealth(volume)
+			if err != nil {
+				return fmt.Errorf("failed to query volume health (%s): %w", volumeID, err)
+			}
+
+			win32Volume, err := wmi.QueryWin32VolumeByDeviceID(scope, volumeID, wmi.Win32VolumeSelectorListForDirtyBit)
+			if err != nil {
+				return err
+			}
+			result.Dirty, err = wmi.IsVolumeDirty(win32Volume)
+			if err != nil {
+				return fmt.Errorf("failed to query the dirty bit of volume (%s): %w", volumeID, err)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// getVolumeHealth returns the health status and the operational statuses of a MSFT_Volume.
+func getVolumeHealth(volume *wmi.COMDispatchObject) (uint16, []uint16, error) {
+	healthStatus, err := wmi.GetVolumeHealthStatus(volume)
+	if err != nil {
+		return 0, nil, err
+	}
+	operationalStatus, err := wmi.GetVolumeOperationalStatus(volume)
+	if err != nil {
+		return 0, nil, err
+	}
+	return healthStatus, operationalStatus, nil
 }
 
 // GetDiskNumberFromVolumeID - gets the disk number where the volume is.
@@ -472,3 +615,7 @@ func writeCache(volumeID string) error {
 		})
 	})
 }
+
+func (VolumeAPI) CheckHealth() error {
+	return wmi.CheckClass(wmi.WMINamespaceStorage, wmi.MSFTVolumeClass)
+}
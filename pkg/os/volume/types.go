@@ -0,0 +1,86 @@
+package volume
+
+import "errors"
+
+// ErrBelowMinimumSize is returned by ResizeVolume when asked to shrink a volume below the minimum size of its
+// partition.
+var ErrBelowMinimumSize = errors.New("size below the minimum size of the partition")
+
+// ErrAboveMaximumSize is returned by ResizeVolume when asked to grow a volume beyond the maximum size of its
+// partition, e.g. because the disk doesn't have enough free space after it.
+var ErrAboveMaximumSize = errors.New("size above the maximum size of the partition")
+
+// File systems FormatVolume supports, as named by MSFT_Volume FileSystem.
+const (
+	FileSystemNTFS  = "NTFS"
+	FileSystemReFS  = "ReFS"
+	FileSystemExFAT = "exFAT"
+	FileSystemFAT32 = "FAT32"
+)
+
+// FormatOptions are the options of FormatVolume, the zero values select the defaults of MSFT_Volume Format.
+type FormatOptions struct {
+	// FileSystem is one of the supported file systems, NTFS if empty
+	FileSystem string
+	Label      string
+	// AllocationUnitSize is the cluster size in bytes, 0 for the default of the file system
+	AllocationUnitSize uint32
+	// Full writes zeros to the volume instead of a quick format
+	Full bool
+	// Compress enables the NTFS compression
+	Compress bool
+	// UseLargeFRS uses large NTFS file record segments, for volumes with many fragmented files
+	UseLargeFRS bool
+	// SetIntegrityStreams enables the ReFS integrity streams
+	SetIntegrityStreams bool
+}
+
+// ResizeResult reports the sizes in bytes of the partition and the file system of a volume
+// before and after ResizeVolume.
+type ResizeResult struct {
+	PartitionSizeBefore  int64
+	PartitionSizeAfter   int64
+	FileSystemSizeBefore int64
+	FileSystemSizeAfter  int64
+	// Skipped is set when ResizeVolume left the volume alone, for SkipReason
+	Skipped    bool
+	SkipReason string
+}
+
+func (r *ResizeResult) skip(reason string) {
+	r.Skipped = true
+	r.SkipReason = reason
+}
+
+// VolumeStats are the size and the health of a volume.
+type VolumeStats struct {
+	TotalBytes int64
+	UsedBytes  int64
+	// HealthStatus is the MSFT_Volume HealthStatus, 0 (Healthy), 1 (Warning), 2 (Unhealthy) or 5 (Unknown)
+	HealthStatus uint16
+	// OperationalStatus are the MSFT_Volume OperationalStatus, e.g. 2 (OK) or 0xD00D (Scan Needed)
+	OperationalStatus []uint16
+}
+
+// RepairMode is how RepairVolume checks the file system of a volume.
+type RepairMode uint32
+
+const (
+	// RepairModeScan scans the volume online for errors, without fixing them.
+	RepairModeScan RepairMode = iota
+	// RepairModeSpotFix takes the volume offline briefly to fix the errors found by a scan.
+	RepairModeSpotFix
+	// RepairModeOfflineScanAndFix takes the volume offline to scan it and fix its errors.
+	RepairModeOfflineScanAndFix
+)
+
+// RepairResult is the outcome of RepairVolume and the health of the volume after it.
+type RepairResult struct {
+	// Output is the MSFT_Volume Repair output, 0 (No errors found), 1 (Errors fixed),
+	// 2 (Spot fix needed) or 3 (Full repair needed)
+	Output            uint32
+	HealthStatus      uint16
+	OperationalStatus []uint16
+	// Dirty is true if the dirty bit of the volume is set
+	Dirty bool
+}
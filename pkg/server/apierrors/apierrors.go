@@ -0,0 +1,207 @@
+package apierrors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+
+	"github.com/go-ole/go-ole"
+	"github.com/kubernetes-csi/csi-proxy/pkg/wmi"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// Domain is the domain of the ErrorInfo details of the errors returned by csi-proxy.
+	Domain = "csiproxy.k8s.io"
+
+	// ReasonWMIMethodFailed is the ErrorInfo reason of the errors returned by a WMI method,
+	// its metadata holds the WMI class, method and return value.
+	ReasonWMIMethodFailed = "WMI_METHOD_FAILED"
+	// ReasonCOMError is the ErrorInfo reason of the COM errors, its metadata holds the HRESULT.
+	ReasonCOMError = "COM_ERROR"
+
+	// MetadataClass, MetadataMethod, MetadataReturnValue and MetadataTarget are the
+	// ErrorInfo metadata keys of ReasonWMIMethodFailed.
+	MetadataClass       = "class"
+	MetadataMethod      = "method"
+	MetadataReturnValue = "returnValue"
+	MetadataTarget      = "target"
+	// MetadataHResult is the ErrorInfo metadata key of ReasonCOMError.
+	MetadataHResult = "hresult"
+	// MetadataRetryable is "true" if the request may succeed when retried as is, e.g. after a timeout.
+	MetadataRetryable = "retryable"
+)
+
+// wmiReturnValueCodes maps the return values common to the Storage Management API methods, see e.g.
+// https://learn.microsoft.com/en-us/windows-hardware/drivers/storage/createpartition-msft-disk
+var wmiReturnValueCodes = map[uint32]codes.Code{
+	1:     codes.Unimplemented,     // Not Supported
+	2:     codes.Unknown,           // Unspecified Error
+	3:     codes.DeadlineExceeded,  // Timeout
+	4:     codes.Internal,          // Failed
+	5:     codes.InvalidArgument,   // Invalid Parameter
+	4097:  codes.InvalidArgument,   // Size Not Supported
+	40000: codes.Unimplemented,     // Not Supported
+	40001: codes.PermissionDenied,  // Access denied
+	40002: codes.ResourceExhausted, // There are not enough resources to complete the operation
+	42002: codes.AlreadyExists,     // The access path is already in use
+}
+
+// hresultCodes maps the COM errors returned by WMI, see
+// https://learn.microsoft.com/en-us/windows/win32/wmisdk/wmi-error-constants
+var hresultCodes = map[uint32]codes.Code{
+	0x80041002: codes.NotFound,          // WBEM_E_NOT_FOUND
+	0x80041003: codes.PermissionDenied,  // WBEM_E_ACCESS_DENIED
+	0x80070005: codes.PermissionDenied,  // E_ACCESSDENIED
+	0x80041006: codes.ResourceExhausted, // WBEM_E_OUT_OF_MEMORY
+	0x80041008: codes.InvalidArgument,   // WBEM_E_INVALID_PARAMETER
+	0x80070057: codes.InvalidArgument,   // E_INVALIDARG
+	0x8004100C: codes.Unimplemented,     // WBEM_E_NOT_SUPPORTED
+	0x80041010: codes.Unimplemented,     // WBEM_E_INVALID_CLASS
+	0x80041014: codes.Unavailable,       // WBEM_E_INITIALIZATION_FAILURE
+	0x80041015: codes.Unavailable,       // WBEM_E_TRANSPORT_FAILURE
+	0x80041017: codes.Internal,          // WBEM_E_INVALID_QUERY
+	0x80041032: codes.Canceled,          // WBEM_E_CALL_CANCELLED
+	0x80010108: codes.Unavailable,       // RPC_E_DISCONNECTED
+	0x800706BA: codes.Unavailable,       // RPC_S_SERVER_UNAVAILABLE
+}
+
+// wmiClassReturnValueCodes maps the return values of the WMI classes outside of the Storage Management API,
+// which have their own, e.g. https://learn.microsoft.com/en-us/previous-versions/windows/desktop/vsswmi/create-method-in-class-win32-shadowcopy
+var wmiClassReturnValueCodes = map[string]map[uint32]codes.Code{
+	wmi.Win32ShadowCopyClass: {
+		1:  codes.PermissionDenied,   // Access denied
+		2:  codes.InvalidArgument,    // Invalid argument
+		3:  codes.NotFound,           // Specified volume not found
+		4:  codes.FailedPrecondition, // Specified volume not supported
+		5:  codes.Unimplemented,      // Unsupported shadow copy context
+		6:  codes.ResourceExhausted,  // Insufficient storage
+		7:  codes.Aborted,            // Volume is in use
+		8:  codes.ResourceExhausted,  // Maximum number of shadow copies reached
+		9:  codes.Aborted,            // Another shadow copy operation is already in progress
+		10: codes.FailedPrecondition, // Shadow copy provider vetoed the operation
+		11: codes.Unimplemented,      // Shadow copy provider not registered
+		12: codes.Internal,           // Shadow copy provider failure
+	},
+	wmi.Win32EncryptableVolumeClass: {
+		0x80070005: codes.PermissionDenied,   // E_ACCESSDENIED
+		0x80310000: codes.FailedPrecondition, // FVE_E_LOCKED_VOLUME
+		0x80310001: codes.FailedPrecondition, // FVE_E_NOT_ENCRYPTED
+		0x80310008: codes.FailedPrecondition, // FVE_E_NOT_ACTIVATED
+		0x80310027: codes.PermissionDenied,   // FVE_E_FAILED_AUTHENTICATION
+		0x80310030: codes.InvalidArgument,    // FVE_E_INVALID_PASSWORD_FORMAT
+	},
+}
+
+// wmiReturnValueCode returns the code of a WMI class method's return value. The return values
+// of the 41000, 42000 and 43000 ranges report the state of the disk, partition or volume
+// preventing the operation, e.g. 41001 "The disk is read only".
+func wmiReturnValueCode(class string, returnValue uint32) codes.Code {
+	if classCodes, ok := wmiClassReturnValueCodes[class]; ok {
+		if code, ok := classCodes[returnValue]; ok {
+			return code
+		}
+		return codes.Unknown
+	}
+	if code, ok := wmiReturnValueCodes[returnValue]; ok {
+		return code
+	}
+	if returnValue >= 41000 && returnValue < 44000 {
+		return codes.FailedPrecondition
+	}
+	return codes.Unknown
+}
+
+// IsRetryable returns true if a request failing with code may succeed when retried as is.
+func IsRetryable(code codes.Code) bool {
+	switch code {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		return true
+	}
+	return false
+}
+
+// ToStatus converts err to a GRPC status error. Status errors are returned as is, WMI and COM
+// errors get the code matching their return value or HRESULT along with ErrorInfo details,
+// "not found" errors get NotFound, and the other errors keep the Unknown code.
+func ToStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	var wmiErr *wmi.WMIError
+	if errors.As(err, &wmiErr) {
+		code := wmiReturnValueCode(wmiErr.Class, wmiErr.Code)
+		return withErrorInfo(status.New(code, err.Error()), ReasonWMIMethodFailed, map[string]string{
+			MetadataClass:       wmiErr.Class,
+			MetadataMethod:      wmiErr.Method,
+			MetadataReturnValue: fmt.Sprintf("%d", wmiErr.Code),
+			MetadataTarget:      wmiErr.Target,
+			MetadataRetryable:   fmt.Sprintf("%t", IsRetryable(code)),
+		})
+	}
+
+	var oleErr *ole.OleError
+	if errors.As(err, &oleErr) {
+		hresult := uint32(oleErr.Code())
+		code, ok := hresultCodes[hresult]
+		if !ok {
+			code = codes.Unknown
+		}
+		return withErrorInfo(status.New(code, err.Error()), ReasonCOMError, map[string]string{
+			MetadataHResult:   fmt.Sprintf("0x%08X", hresult),
+			MetadataRetryable: fmt.Sprintf("%t", IsRetryable(code)),
+		})
+	}
+
+	switch {
+	case wmi.IsNotFound(err), errors.Is(err, fs.ErrNotExist):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, fs.ErrPermission):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, fs.ErrExist):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	}
+	return status.Error(codes.Unknown, err.Error())
+}
+
+// withErrorInfo returns the error of st with an ErrorInfo detail.
+func withErrorInfo(st *status.Status, reason string, metadata map[string]string) error {
+	withDetails, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   reason,
+		Domain:   Domain,
+		Metadata: metadata,
+	})
+	if err != nil {
+		klog.Warningf("failed to add the error details to %v: %v", st, err)
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+// UnaryServerInterceptor converts the errors returned by the handlers to GRPC status errors, see ToStatus.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		return resp, ToStatus(err)
+	}
+}
+
+// StreamServerInterceptor converts the errors returned by the handlers to GRPC status errors, see ToStatus.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return ToStatus(handler(srv, ss))
+	}
+}
@@ -0,0 +1,146 @@
+package apierrors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"testing"
+
+	"github.com/go-ole/go-ole"
+	"github.com/kubernetes-csi/csi-proxy/pkg/wmi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestToStatus(t *testing.T) {
+	testCases := []struct {
+		name             string
+		err              error
+		expectedCode     codes.Code
+		expectedReason   string
+		expectedMetadata map[string]string
+	}{
+		{
+			name:           "WMI method return value",
+			err:            fmt.Errorf("failed to create partition: %w", &wmi.WMIError{Class: "MSFT_Disk", Method: "CreatePartition", Target: "disk 1", Code: 42002}),
+			expectedCode:   codes.AlreadyExists,
+			expectedReason: ReasonWMIMethodFailed,
+			expectedMetadata: map[string]string{
+				MetadataClass:       "MSFT_Disk",
+				MetadataMethod:      "CreatePartition",
+				MetadataReturnValue: "42002",
+				MetadataTarget:      "disk 1",
+				MetadataRetryable:   "false",
+			},
+		},
+		{
+			name:           "WMI method return value for the disk state",
+			err:            &wmi.WMIError{Class: "MSFT_Disk", Method: "Initialize", Code: 41001},
+			expectedCode:   codes.FailedPrecondition,
+			expectedReason: ReasonWMIMethodFailed,
+		},
+		{
+			name:           "WMI method timeout",
+			err:            &wmi.WMIError{Class: "MSFT_Volume", Method: "Format", Code: 3},
+			expectedCode:   codes.DeadlineExceeded,
+			expectedReason: ReasonWMIMethodFailed,
+		},
+		{
+			name:           "WMI method return value of a class outside of the Storage Management API",
+			err:            &wmi.WMIError{Class: wmi.Win32ShadowCopyClass, Method: "Create", Code: 3},
+			expectedCode:   codes.NotFound,
+			expectedReason: ReasonWMIMethodFailed,
+		},
+		{
+			name:           "BitLocker method return value",
+			err:            &wmi.WMIError{Class: wmi.Win32EncryptableVolumeClass, Method: "UnlockWithPassphrase", Code: 0x80310027},
+			expectedCode:   codes.PermissionDenied,
+			expectedReason: ReasonWMIMethodFailed,
+		},
+		{
+			name:             "COM error",
+			err:              fmt.Errorf("failed to query disks: %w", ole.NewError(0x80041003)),
+			expectedCode:     codes.PermissionDenied,
+			expectedReason:   ReasonCOMError,
+			expectedMetadata: map[string]string{MetadataHResult: "0x80041003", MetadataRetryable: "false"},
+		},
+		{
+			name:             "COM transport failure",
+			err:              ole.NewError(0x80041015),
+			expectedCode:     codes.Unavailable,
+			expectedReason:   ReasonCOMError,
+			expectedMetadata: map[string]string{MetadataHResult: "0x80041015", MetadataRetryable: "true"},
+		},
+		{
+			name:         "WMI object not found",
+			err:          fmt.Errorf("failed to query disk 3. error: %w", wmi.ErrNotFound),
+			expectedCode: codes.NotFound,
+		},
+		{
+			name:         "file not found",
+			err:          fmt.Errorf("failed to stat: %w", fs.ErrNotExist),
+			expectedCode: codes.NotFound,
+		},
+		{
+			name:         "status error",
+			err:          status.Error(codes.InvalidArgument, "invalid path"),
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "wrapped status error",
+			err:          fmt.Errorf("failed to validate path: %w", status.Error(codes.PermissionDenied, "outside of the working directories")),
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "deadline exceeded",
+			err:          fmt.Errorf("timed out waiting for the service: %w", context.DeadlineExceeded),
+			expectedCode: codes.DeadlineExceeded,
+		},
+		{
+			name:         "other error",
+			err:          errors.New("something went wrong"),
+			expectedCode: codes.Unknown,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			st, ok := status.FromError(ToStatus(tc.err))
+			require.True(t, ok)
+			assert.Equal(t, tc.expectedCode, st.Code())
+			if _, isStatus := status.FromError(tc.err); !isStatus {
+				assert.Equal(t, tc.err.Error(), st.Message())
+			}
+
+			if tc.expectedReason == "" {
+				assert.Empty(t, st.Details())
+				return
+			}
+			require.Len(t, st.Details(), 1)
+			errorInfo, ok := st.Details()[0].(*errdetails.ErrorInfo)
+			require.True(t, ok)
+			assert.Equal(t, Domain, errorInfo.Domain)
+			assert.Equal(t, tc.expectedReason, errorInfo.Reason)
+			if tc.expectedMetadata != nil {
+				assert.Equal(t, tc.expectedMetadata, errorInfo.Metadata)
+			}
+		})
+	}
+}
+
+func TestToStatusNil(t *testing.T) {
+	assert.Nil(t, ToStatus(nil))
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	interceptor := UnaryServerInterceptor()
+	_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, fmt.Errorf("failed to format volume: %w", &wmi.WMIError{Class: "MSFT_Volume", Method: "Format", Code: 40001})
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
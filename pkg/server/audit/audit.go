@@ -0,0 +1,172 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/caller"
+	srvtypes "github.com/kubernetes-csi/csi-proxy/pkg/server/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+// Record is the audit record of a mutating request, written as one JSON object per line.
+type Record struct {
+	Time       time.Time       `json:"time"`
+	Group      string          `json:"group"`
+	Version    string          `json:"version"`
+	Method     string          `json:"method"`
+	Request    json.RawMessage `json:"request,omitempty"`
+	Caller     caller.Info     `json:"caller"`
+	DurationMs float64         `json:"durationMs"`
+	// Result is the GRPC status code of the response, e.g. "OK" or "Internal".
+	Result string `json:"result"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Logger writes audit records to an io.Writer.
+type Logger struct {
+	mutex   sync.Mutex
+	encoder *json.Encoder
+	writer  io.Writer
+}
+
+// NewLogger returns a Logger writing to w, e.g. a RotatingFile.
+func NewLogger(w io.Writer) *Logger {
+	return &Logger{
+		encoder: json.NewEncoder(w),
+		writer:  w,
+	}
+}
+
+// Log writes the given record.
+func (l *Logger) Log(record *Record) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if err := l.encoder.Encode(record); err != nil {
+		klog.Errorf("failed to write audit record for %s/%s %s: %v", record.Group, record.Version, record.Method, err)
+	}
+}
+
+// Close closes the underlying writer, if it's an io.Closer.
+func (l *Logger) Close() error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if closer, ok := l.writer.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// readOnlyMethodPrefixes are the prefixes of the methods that don't change the host's state,
+// and aren't audited.
+var readOnlyMethodPrefixes = []string{"Get", "List", "Is", "Path", "Find", "Wait", "Watch", "Discover"}
+
+// readOnlyMethods are the read-only methods not matching any of readOnlyMethodPrefixes.
+var readOnlyMethods = map[string]bool{
+	"DiskStats":         true,
+	"VolumeStats":       true,
+	"VolumeDiskNumber":  true,
+	"VolumeIDFromMount": true,
+}
+
+// IsMutating returns true if the given method, e.g. "FormatVolume", changes the host's state.
+func IsMutating(method string) bool {
+	if readOnlyMethods[method] {
+		return false
+	}
+	for _, prefix := range readOnlyMethodPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// UnaryServerInterceptor returns an interceptor writing an audit record to logger for each
+// mutating request to an API group, see IsMutating.
+func UnaryServerInterceptor(logger *Logger, resolve srvtypes.ResolveMethodFunc) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		group, version, method := resolve(info.FullMethod)
+		if group == "" || !IsMutating(method) {
+			return handler(ctx, req)
+		}
+
+		// the caller is looked up while its connection is still open
+		callerInfo := caller.FromContext(ctx)
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		record := &Record{
+			Time:       start.UTC(),
+			Group:      group,
+			Version:    version,
+			Method:     method,
+			Request:    sanitizedRequest(req),
+			Caller:     callerInfo,
+			DurationMs: float64(time.Since(start).Microseconds()) / 1000,
+			Result:     status.Code(err).String(),
+		}
+		if err != nil {
+			record.Error = err.Error()
+		}
+		logger.Log(record)
+
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor returns an interceptor writing an audit record to logger for each
+// mutating streaming request to an API group, e.g. ClearDisk, once its stream ends.
+func StreamServerInterceptor(logger *Logger, resolve srvtypes.ResolveMethodFunc) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		group, version, method := resolve(info.FullMethod)
+		if group == "" || !IsMutating(method) {
+			return handler(srv, ss)
+		}
+
+		callerInfo := caller.FromContext(ss.Context())
+		start := time.Now()
+		stream := &requestRecordingStream{ServerStream: ss}
+		err := handler(srv, stream)
+
+		record := &Record{
+			Time:       start.UTC(),
+			Group:      group,
+			Version:    version,
+			Method:     method,
+			Request:    sanitizedRequest(stream.request),
+			Caller:     callerInfo,
+			DurationMs: float64(time.Since(start).Microseconds()) / 1000,
+			Result:     status.Code(err).String(),
+		}
+		if err != nil {
+			record.Error = err.Error()
+		}
+		logger.Log(record)
+
+		return err
+	}
+}
+
+// requestRecordingStream keeps the first message received on a server stream, which is the
+// request of the server-streaming methods.
+type requestRecordingStream struct {
+	grpc.ServerStream
+	request interface{}
+}
+
+func (s *requestRecordingStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil && s.request == nil {
+		s.request = m
+	}
+	return err
+}
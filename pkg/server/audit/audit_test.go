@@ -0,0 +1,214 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	diskv2alpha1 "github.com/kubernetes-csi/csi-proxy/client/api/disk/v2alpha1"
+	encryptionv1alpha1 "github.com/kubernetes-csi/csi-proxy/client/api/encryption/v1alpha1"
+	iscsiv1alpha2 "github.com/kubernetes-csi/csi-proxy/client/api/iscsi/v1alpha2"
+	smbv1 "github.com/kubernetes-csi/csi-proxy/client/api/smb/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+func TestIsMutating(t *testing.T) {
+	testCases := []struct {
+		method   string
+		mutating bool
+	}{
+		{method: "FormatVolume", mutating: true},
+		{method: "SetDiskState", mutating: true},
+		{method: "Rmdir", mutating: true},
+		{method: "RemoveSmbGlobalMapping", mutating: true},
+		{method: "StopService", mutating: true},
+		{method: "GetVolumeStats", mutating: false},
+		{method: "ListDiskIDs", mutating: false},
+		{method: "IsVolumeFormatted", mutating: false},
+		{method: "PathExists", mutating: false},
+		{method: "DiscoverTargetPortal", mutating: false},
+		{method: "VolumeStats", mutating: false},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.mutating, IsMutating(tc.method), "method %s", tc.method)
+	}
+}
+
+func resolve(fullMethod string) (string, string, string) {
+	parts := strings.Split(fullMethod, "/")
+	switch parts[1] {
+	case "v1.Smb":
+		return "smb", "v1", parts[2]
+	case "v1alpha2.Iscsi":
+		return "iscsi", "v1alpha2", parts[2]
+	case "v1alpha1.Encryption":
+		return "encryption", "v1alpha1", parts[2]
+	case "v2alpha1.Disk":
+		return "disk", "v2alpha1", parts[2]
+	}
+	return "", "", parts[2]
+}
+
+func intercept(t *testing.T, fullMethod string, req interface{}, err error) *Record {
+	var buffer bytes.Buffer
+	interceptor := UnaryServerInterceptor(NewLogger(&buffer), resolve)
+
+	_, actualErr := interceptor(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: fullMethod}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, err
+	})
+	assert.Equal(t, err, actualErr)
+
+	if buffer.Len() == 0 {
+		return nil
+	}
+	record := &Record{}
+	require.Nil(t, json.Unmarshal(buffer.Bytes(), record))
+	return record
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	t.Run("SMB password is redacted", func(t *testing.T) {
+		record := intercept(t, "/v1.Smb/NewSmbGlobalMapping", &smbv1.NewSmbGlobalMappingRequest{
+			RemotePath: `\\server\share`,
+			Username:   "user",
+			Password:   "hunter2",
+		}, nil)
+		require.NotNil(t, record)
+
+		assert.Equal(t, "smb", record.Group)
+		assert.Equal(t, "v1", record.Version)
+		assert.Equal(t, "NewSmbGlobalMapping", record.Method)
+		assert.Equal(t, "OK", record.Result)
+		assert.Empty(t, record.Error)
+		assert.NotContains(t, string(record.Request), "hunter2")
+
+		var request map[string]string
+		require.Nil(t, json.Unmarshal(record.Request, &request))
+		assert.Equal(t, map[string]string{
+			"remote_path": `\\server\share`,
+			"username":    "user",
+			"password":    redacted,
+		}, request)
+	})
+
+	t.Run("CHAP secrets are redacted", func(t *testing.T) {
+		record := intercept(t, "/v1alpha2.Iscsi/ConnectTarget", &iscsiv1alpha2.ConnectTargetRequest{
+			TargetPortal: &iscsiv1alpha2.TargetPortal{TargetAddress: "10.0.0.1"},
+			Iqn:          "iqn.2000-01.com.example:target",
+			ChapUsername: "user",
+			ChapSecret:   "chapsecret",
+		}, nil)
+		require.NotNil(t, record)
+
+		assert.NotContains(t, string(record.Request), "chapsecret")
+		assert.Contains(t, string(record.Request), "10.0.0.1")
+		assert.Contains(t, string(record.Request), redacted)
+	})
+
+	t.Run("BitLocker secrets are redacted", func(t *testing.T) {
+		record := intercept(t, "/v1alpha1.Encryption/EnableEncryption", &encryptionv1alpha1.EnableEncryptionRequest{
+			VolumeId: `\\?\Volume{a}\`,
+			Secret:   "123456-123456-123456-123456-123456-123456-123456-123456",
+		}, nil)
+		require.NotNil(t, record)
+
+		assert.Equal(t, "encryption", record.Group)
+		assert.NotContains(t, string(record.Request), "123456")
+		assert.Contains(t, string(record.Request), redacted)
+	})
+
+	t.Run("errors are recorded", func(t *testing.T) {
+		record := intercept(t, "/v1.Smb/RemoveSmbGlobalMapping", &smbv1.RemoveSmbGlobalMappingRequest{RemotePath: `\\server\share`},
+			status.Error(codes.NotFound, "no such mapping"))
+		require.NotNil(t, record)
+
+		assert.Equal(t, "NotFound", record.Result)
+		assert.Contains(t, record.Error, "no such mapping")
+	})
+
+	t.Run("plain errors are recorded as unknown", func(t *testing.T) {
+		record := intercept(t, "/v1.Smb/RemoveSmbGlobalMapping", &smbv1.RemoveSmbGlobalMappingRequest{}, errors.New("boom"))
+		require.NotNil(t, record)
+
+		assert.Equal(t, "Unknown", record.Result)
+	})
+
+	t.Run("read-only methods aren't audited", func(t *testing.T) {
+		assert.Nil(t, intercept(t, "/v1alpha2.Iscsi/ListTargetPortals", &iscsiv1alpha2.ListTargetPortalsRequest{}, nil))
+	})
+}
+
+// fakeServerStream receives request, as the stream of a server-streaming method.
+type fakeServerStream struct {
+	grpc.ServerStream
+	request protoadapt.MessageV1
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *fakeServerStream) RecvMsg(m interface{}) error {
+	proto.Merge(protoadapt.MessageV2Of(m.(protoadapt.MessageV1)), protoadapt.MessageV2Of(s.request))
+	return nil
+}
+
+// interceptStream returns the audit record of the streaming request req to fullMethod whose
+// handler fails with err, or nil if none is written.
+func interceptStream(t *testing.T, fullMethod string, req protoadapt.MessageV1, err error) *Record {
+	var buffer bytes.Buffer
+	interceptor := StreamServerInterceptor(NewLogger(&buffer), resolve)
+
+	actualErr := interceptor(nil, &fakeServerStream{request: req}, &grpc.StreamServerInfo{FullMethod: fullMethod, IsServerStream: true}, func(srv interface{}, stream grpc.ServerStream) error {
+		request := &diskv2alpha1.ClearDiskRequest{}
+		require.Nil(t, stream.RecvMsg(request))
+		return err
+	})
+	assert.Equal(t, err, actualErr)
+
+	if buffer.Len() == 0 {
+		return nil
+	}
+	record := &Record{}
+	require.Nil(t, json.Unmarshal(buffer.Bytes(), record))
+	return record
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	t.Run("ClearDisk is recorded", func(t *testing.T) {
+		record := interceptStream(t, "/v2alpha1.Disk/ClearDisk", &diskv2alpha1.ClearDiskRequest{DiskNumber: 3}, nil)
+		require.NotNil(t, record)
+
+		assert.Equal(t, "disk", record.Group)
+		assert.Equal(t, "v2alpha1", record.Version)
+		assert.Equal(t, "ClearDisk", record.Method)
+		assert.Equal(t, "OK", record.Result)
+
+		var request map[string]interface{}
+		require.Nil(t, json.Unmarshal(record.Request, &request))
+		assert.Equal(t, float64(3), request["disk_number"])
+	})
+
+	t.Run("errors are recorded", func(t *testing.T) {
+		record := interceptStream(t, "/v2alpha1.Disk/ClearDisk", &diskv2alpha1.ClearDiskRequest{DiskNumber: 3},
+			status.Error(codes.Canceled, "context canceled"))
+		require.NotNil(t, record)
+
+		assert.Equal(t, "Canceled", record.Result)
+		assert.Contains(t, record.Error, "context canceled")
+	})
+
+	t.Run("read-only methods aren't audited", func(t *testing.T) {
+		assert.Nil(t, interceptStream(t, "/v2alpha1.Disk/WatchDisks", &diskv2alpha1.ClearDiskRequest{}, nil))
+	})
+}
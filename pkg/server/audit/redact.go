@@ -0,0 +1,83 @@
+package audit
+
+import (
+	"encoding/json"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"k8s.io/klog/v2"
+)
+
+// redacted replaces the value of sensitive fields in audit records.
+const redacted = "[REDACTED]"
+
+// sensitiveFieldSubstrings are the (lower case) substrings of the names of the fields
+// redacted from audit records, e.g. SMB passwords, iSCSI CHAP secrets and BitLocker secrets.
+var sensitiveFieldSubstrings = []string{"password", "secret"}
+
+// sanitizedRequest returns the JSON representation of req, with sensitive fields redacted.
+func sanitizedRequest(req interface{}) json.RawMessage {
+	v1Message, ok := req.(protoadapt.MessageV1)
+	if !ok {
+		return nil
+	}
+
+	message := proto.Clone(protoadapt.MessageV2Of(v1Message))
+	redact(message.ProtoReflect())
+
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(message)
+	if err != nil {
+		klog.Warningf("failed to marshal audited request: %v", err)
+		return nil
+	}
+	return data
+}
+
+// redact replaces the value of message's sensitive fields, recursing into nested messages.
+func redact(message protoreflect.Message) {
+	message.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch {
+		case field.IsList():
+			if isMessage(field) {
+				list := value.List()
+				for i := 0; i < list.Len(); i++ {
+					redact(list.Get(i).Message())
+				}
+			}
+		case field.IsMap():
+			if isMessage(field.MapValue()) {
+				value.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+					redact(v.Message())
+					return true
+				})
+			}
+		case isMessage(field):
+			redact(value.Message())
+		case isSensitive(field):
+			switch field.Kind() {
+			case protoreflect.StringKind:
+				message.Set(field, protoreflect.ValueOfString(redacted))
+			case protoreflect.BytesKind:
+				message.Set(field, protoreflect.ValueOfBytes([]byte(redacted)))
+			}
+		}
+		return true
+	})
+}
+
+func isMessage(field protoreflect.FieldDescriptor) bool {
+	return field.Kind() == protoreflect.MessageKind || field.Kind() == protoreflect.GroupKind
+}
+
+func isSensitive(field protoreflect.FieldDescriptor) bool {
+	name := strings.ToLower(string(field.Name()))
+	for _, substring := range sensitiveFieldSubstrings {
+		if strings.Contains(name, substring) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,63 @@
+package audit
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readFile(t *testing.T, path string) string {
+	contents, err := os.ReadFile(path)
+	require.Nil(t, err)
+	return string(contents)
+}
+
+func TestRotatingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	f, err := NewRotatingFile(path, 10, 2)
+	require.Nil(t, err)
+	defer f.Close()
+
+	for _, line := range []string{"first\n", "second\n", "third\n", "fourth\n"} {
+		_, err := f.Write([]byte(line))
+		require.Nil(t, err)
+	}
+
+	assert.Equal(t, "fourth\n", readFile(t, path))
+	assert.Equal(t, "third\n", readFile(t, path+".1"))
+	assert.Equal(t, "second\n", readFile(t, path+".2"))
+	_, err = os.Stat(path + ".3")
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestRotatingFileAppends(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	require.Nil(t, os.WriteFile(path, []byte("old\n"), 0600))
+
+	f, err := NewRotatingFile(path, 10, 1)
+	require.Nil(t, err)
+	_, err = f.Write([]byte("new\n"))
+	require.Nil(t, err)
+	// would exceed the maximum size
+	_, err = f.Write([]byte("newer\n"))
+	require.Nil(t, err)
+	require.Nil(t, f.Close())
+
+	assert.Equal(t, "newer\n", readFile(t, path))
+	assert.Equal(t, "old\nnew\n", readFile(t, path+".1"))
+
+	_, err = f.Write([]byte("closed\n"))
+	assert.NotNil(t, err)
+}
+
+func TestNewRotatingFileValidation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	_, err := NewRotatingFile(path, 0, 1)
+	assert.NotNil(t, err)
+	_, err = NewRotatingFile(path, 10, -1)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,93 @@
+package authz
+
+import (
+	"context"
+
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/caller"
+	srvtypes "github.com/kubernetes-csi/csi-proxy/pkg/server/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+// IdentityProvider returns the identity of the client making a request.
+type IdentityProvider interface {
+	// Identity returns the identity of the client making the request whose context is ctx,
+	// or nil if it's unknown.
+	Identity(ctx context.Context) (*caller.Identity, error)
+}
+
+// CallerIdentityProvider returns the identity recorded by the listeners wrapped with caller.WrapListener.
+type CallerIdentityProvider struct{}
+
+// Identity implements IdentityProvider.
+func (CallerIdentityProvider) Identity(ctx context.Context) (*caller.Identity, error) {
+	return caller.FromContext(ctx).Identity, nil
+}
+
+// Authorizer allows or denies requests according to a Policy.
+type Authorizer struct {
+	policy     *Policy
+	identities IdentityProvider
+	resolve    srvtypes.ResolveMethodFunc
+}
+
+// NewAuthorizer returns an Authorizer enforcing policy on the clients identified by identities.
+func NewAuthorizer(policy *Policy, identities IdentityProvider, resolve srvtypes.ResolveMethodFunc) *Authorizer {
+	return &Authorizer{
+		policy:     policy,
+		identities: identities,
+		resolve:    resolve,
+	}
+}
+
+// authorize returns a PermissionDenied error if the client making the request whose
+// context is ctx isn't allowed to call fullMethod. Requests to services that aren't
+// part of an API group, e.g. health checks, are always allowed.
+func (a *Authorizer) authorize(ctx context.Context, fullMethod string) error {
+	group, version, method := a.resolve(fullMethod)
+	if group == "" {
+		return nil
+	}
+
+	identity, err := a.identities.Identity(ctx)
+	if err != nil {
+		klog.Errorf("failed to get the identity of the client calling %s: %v", fullMethod, err)
+		return status.Errorf(codes.PermissionDenied, "unable to identify the client calling %s.%s", group, method)
+	}
+
+	if a.policy.Allows(identity, group, method) {
+		return nil
+	}
+
+	user := "unknown client"
+	if identity != nil {
+		user = identity.User
+		if user == "" {
+			user = identity.ID
+		}
+	}
+	klog.Warningf("denied %s (pid %d) calling %s/%s %s", user, caller.FromContext(ctx).PID, group, version, method)
+	return status.Errorf(codes.PermissionDenied, "%s is not allowed to call %s.%s", user, group, method)
+}
+
+// UnaryServerInterceptor returns an interceptor denying the unary requests not allowed by the policy.
+func (a *Authorizer) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := a.authorize(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns an interceptor denying the streaming requests not allowed by the policy.
+func (a *Authorizer) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := a.authorize(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
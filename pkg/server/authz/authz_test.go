@@ -0,0 +1,95 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/caller"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeIdentityProvider returns the same identity for all requests.
+type fakeIdentityProvider struct {
+	identity *caller.Identity
+	err      error
+}
+
+func (f *fakeIdentityProvider) Identity(context.Context) (*caller.Identity, error) {
+	return f.identity, f.err
+}
+
+func resolve(fullMethod string) (string, string, string) {
+	parts := strings.Split(fullMethod, "/")
+	switch parts[1] {
+	case "v1alpha1.System":
+		return "system", "v1alpha1", parts[2]
+	case "v1.Disk":
+		return "disk", "v1", parts[2]
+	}
+	return "", "", parts[2]
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	policy := &Policy{Rules: []Rule{{
+		Principals: []string{`HOST\operator`},
+		Methods:    []string{"system.StopService", "disk.*"},
+	}}}
+
+	testCases := []struct {
+		name         string
+		identities   *fakeIdentityProvider
+		fullMethod   string
+		expectedCode codes.Code
+	}{
+		{
+			name:         "allowed",
+			identities:   &fakeIdentityProvider{identity: &caller.Identity{User: `HOST\operator`}},
+			fullMethod:   "/v1alpha1.System/StopService",
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "denied",
+			identities:   &fakeIdentityProvider{identity: &caller.Identity{User: `HOST\guest`}},
+			fullMethod:   "/v1alpha1.System/StopService",
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "unknown identity",
+			identities:   &fakeIdentityProvider{},
+			fullMethod:   "/v1.Disk/ListDiskIDs",
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "identity lookup failure",
+			identities:   &fakeIdentityProvider{err: errors.New("no token")},
+			fullMethod:   "/v1.Disk/ListDiskIDs",
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "services outside API groups are always allowed",
+			identities:   &fakeIdentityProvider{},
+			fullMethod:   "/grpc.health.v1.Health/Check",
+			expectedCode: codes.OK,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authorizer := NewAuthorizer(policy, tc.identities, resolve)
+
+			called := false
+			_, err := authorizer.UnaryServerInterceptor()(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: tc.fullMethod}, func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				return nil, nil
+			})
+
+			assert.Equal(t, tc.expectedCode, status.Code(err))
+			assert.Equal(t, tc.expectedCode == codes.OK, called)
+		})
+	}
+}
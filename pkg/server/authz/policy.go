@@ -0,0 +1,127 @@
+package authz
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/caller"
+	"sigs.k8s.io/yaml"
+)
+
+// Wildcard matches any principal, API group or method.
+const Wildcard = "*"
+
+// Policy is the authorization policy of the proxy's clients, loaded from a YAML or JSON file, e.g.
+//
+//	securityDescriptors:
+//	  "*": "D:P(A;;GA;;;SY)(A;;GA;;;BA)"
+//	rules:
+//	- principals: ["NT AUTHORITY\\SYSTEM", "BUILTIN\\Administrators"]
+//	  methods: ["*"]
+//	- principals: ["S-1-5-21-1004336348-1177238915-682003330-1001"]
+//	  methods: ["disk.*", "volume.*", "system.GetService"]
+type Policy struct {
+	// SecurityDescriptors are the SDDL security descriptors of the named pipes by API group,
+	// the Wildcard entry applies to the API groups not listed and to the multiplexed endpoint.
+	// Pipes get the system's default security descriptor if there's no entry for them.
+	SecurityDescriptors map[string]string `json:"securityDescriptors,omitempty"`
+	// Rules are the methods each principal is allowed to call; requests not allowed by
+	// any rule are denied.
+	Rules []Rule `json:"rules"`
+}
+
+// Rule allows its principals to call its methods.
+type Rule struct {
+	// Principals are user or group names or IDs, e.g. `NT AUTHORITY\SYSTEM`, `BUILTIN\Administrators`
+	// or "S-1-5-18", matched case-insensitively, or Wildcard to match any client.
+	Principals []string `json:"principals"`
+	// Methods are "<group>.<method>" patterns, e.g. "system.StopService", where either the group
+	// or the method may be Wildcard, e.g. "disk.*"; or Wildcard to match all methods.
+	Methods []string `json:"methods"`
+}
+
+// LoadPolicy loads the policy from the YAML or JSON file located at path.
+func LoadPolicy(path string) (*Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := ParsePolicy(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid authorization policy %q: %w", path, err)
+	}
+	return policy, nil
+}
+
+// ParsePolicy parses and validates a YAML or JSON policy.
+func ParsePolicy(data []byte) (*Policy, error) {
+	policy := &Policy{}
+	if err := yaml.UnmarshalStrict(data, policy); err != nil {
+		return nil, err
+	}
+
+	for i, rule := range policy.Rules {
+		if len(rule.Principals) == 0 {
+			return nil, fmt.Errorf("rule %d has no principals", i)
+		}
+		if len(rule.Methods) == 0 {
+			return nil, fmt.Errorf("rule %d has no methods", i)
+		}
+		for _, method := range rule.Methods {
+			if method == Wildcard {
+				continue
+			}
+			if group, name, found := strings.Cut(method, "."); !found || group == "" || name == "" {
+				return nil, fmt.Errorf("rule %d: invalid method %q, expected \"<group>.<method>\"", i, method)
+			}
+		}
+	}
+
+	return policy, nil
+}
+
+// Allows returns true if a client with the given identity is allowed to call method of
+// the API group group. A nil identity only matches Wildcard principals.
+func (p *Policy) Allows(identity *caller.Identity, group, method string) bool {
+	for _, rule := range p.Rules {
+		if rule.matchesPrincipal(identity) && rule.matchesMethod(group, method) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Rule) matchesPrincipal(identity *caller.Identity) bool {
+	for _, principal := range r.Principals {
+		if principal == Wildcard {
+			return true
+		}
+		if identity == nil {
+			continue
+		}
+		if strings.EqualFold(principal, identity.User) || strings.EqualFold(principal, identity.ID) {
+			return true
+		}
+		for _, group := range identity.Groups {
+			if strings.EqualFold(principal, group) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (r *Rule) matchesMethod(group, method string) bool {
+	for _, pattern := range r.Methods {
+		if pattern == Wildcard {
+			return true
+		}
+		patternGroup, patternMethod, _ := strings.Cut(pattern, ".")
+		if (patternGroup == Wildcard || patternGroup == group) && (patternMethod == Wildcard || patternMethod == method) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,93 @@
+package authz
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/caller"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPolicy = `
+securityDescriptors:
+  "*": "D:P(A;;GA;;;SY)(A;;GA;;;BA)"
+  system: "D:P(A;;GA;;;SY)"
+rules:
+- principals: ["NT AUTHORITY\\SYSTEM", "BUILTIN\\Administrators"]
+  methods: ["*"]
+- principals: ["S-1-5-21-1001"]
+  methods: ["disk.*", "system.GetService"]
+- principals: ["*"]
+  methods: ["*.GetVolumeStats"]
+`
+
+func TestParsePolicy(t *testing.T) {
+	policy, err := ParsePolicy([]byte(testPolicy))
+	require.Nil(t, err)
+
+	assert.Equal(t, "D:P(A;;GA;;;SY)", policy.SecurityDescriptors["system"])
+	assert.Len(t, policy.Rules, 3)
+	assert.Equal(t, []string{`NT AUTHORITY\SYSTEM`, `BUILTIN\Administrators`}, policy.Rules[0].Principals)
+
+	invalidPolicies := map[string]string{
+		"unknown field":           `rulez: []`,
+		"rule with no methods":    `rules: [{principals: ["*"]}]`,
+		"rule with no principals": `rules: [{methods: ["*"]}]`,
+		"method without group":    `rules: [{principals: ["*"], methods: ["StopService"]}]`,
+		"method with empty name":  `rules: [{principals: ["*"], methods: ["system."]}]`,
+	}
+	for name, data := range invalidPolicies {
+		_, err := ParsePolicy([]byte(data))
+		assert.NotNil(t, err, name)
+	}
+}
+
+func TestLoadPolicy(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy.json")
+	require.Nil(t, os.WriteFile(path, []byte(`{"rules": [{"principals": ["*"], "methods": ["*"]}]}`), 0600))
+
+	policy, err := LoadPolicy(path)
+	require.Nil(t, err)
+	assert.True(t, policy.Allows(nil, "disk", "ListDiskIDs"))
+
+	_, err = LoadPolicy(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.NotNil(t, err)
+}
+
+func TestPolicyAllows(t *testing.T) {
+	policy, err := ParsePolicy([]byte(testPolicy))
+	require.Nil(t, err)
+
+	system := &caller.Identity{User: `NT AUTHORITY\SYSTEM`, ID: "S-1-5-18"}
+	admin := &caller.Identity{User: `HOST\admin`, ID: "S-1-5-21-500", Groups: []string{"S-1-5-32-544", `BUILTIN\Administrators`}}
+	driver := &caller.Identity{User: `HOST\csi-driver`, ID: "S-1-5-21-1001"}
+	other := &caller.Identity{User: `HOST\other`, ID: "S-1-5-21-1002"}
+
+	testCases := []struct {
+		name     string
+		identity *caller.Identity
+		group    string
+		method   string
+		allowed  bool
+	}{
+		{name: "system by user name", identity: system, group: "system", method: "StopService", allowed: true},
+		{name: "admin by group name", identity: admin, group: "volume", method: "FormatVolume", allowed: true},
+		{name: "case-insensitive user name", identity: &caller.Identity{User: `nt authority\system`}, group: "smb", method: "NewSmbGlobalMapping", allowed: true},
+		{name: "driver by SID in allowed group", identity: driver, group: "disk", method: "SetDiskState", allowed: true},
+		{name: "driver allowed method", identity: driver, group: "system", method: "GetService", allowed: true},
+		{name: "driver denied method", identity: driver, group: "system", method: "StopService", allowed: false},
+		{name: "driver denied group", identity: driver, group: "volume", method: "FormatVolume", allowed: false},
+		{name: "anyone allowed method in any group", identity: other, group: "volume", method: "GetVolumeStats", allowed: true},
+		{name: "other denied", identity: other, group: "disk", method: "ListDiskIDs", allowed: false},
+		{name: "unknown identity allowed by wildcard principal", identity: nil, group: "volume", method: "GetVolumeStats", allowed: true},
+		{name: "unknown identity denied", identity: nil, group: "disk", method: "ListDiskIDs", allowed: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.allowed, policy.Allows(tc.identity, tc.group, tc.method))
+		})
+	}
+}
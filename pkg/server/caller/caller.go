@@ -0,0 +1,110 @@
+package caller
+
+import (
+	"context"
+	"net"
+	"sync"
+
+	"google.golang.org/grpc/peer"
+	"k8s.io/klog/v2"
+)
+
+// Info describes the client at the other end of a request's connection.
+type Info struct {
+	// Endpoint is the address the request was received on, e.g. the named pipe's path.
+	Endpoint string `json:"endpoint,omitempty"`
+	// PID is the client's process ID, 0 if it couldn't be determined, e.g. for TCP connections.
+	PID int `json:"pid,omitempty"`
+	// User is the account the client runs as, empty if it couldn't be determined.
+	User string `json:"user,omitempty"`
+	// Identity is the client's identity, nil if it couldn't be determined.
+	Identity *Identity `json:"-"`
+}
+
+// Identity is the account a client process runs as.
+type Identity struct {
+	// User is the account's name, e.g. `NT AUTHORITY\SYSTEM` on Windows or "root" on Linux.
+	User string
+	// ID is the account's security identifier on Windows, e.g. "S-1-5-18", or user ID on Linux.
+	ID string
+	// Groups holds the names and IDs of the groups the account belongs to,
+	// e.g. `BUILTIN\Administrators` and "S-1-5-32-544".
+	Groups []string
+}
+
+// Addr is the remote address of the connections accepted by the listeners returned
+// by WrapListener, it reports the client's process ID and identity, see Credentials.
+type Addr struct {
+	net.Addr
+	conn net.Conn
+
+	once     sync.Once
+	pid      int
+	identity *Identity
+}
+
+// Credentials returns the client's process ID and identity, 0 and nil if they can't be determined.
+// They're looked up the first time they're needed by a request received on the connection, rather
+// than when it's accepted, as a named pipe's client can only be impersonated once it sent data, and
+// so that slow account lookups don't hold up accepting other connections.
+func (a *Addr) Credentials() (int, *Identity) {
+	a.once.Do(func() {
+		pid, identity, err := clientCredentials(a.conn)
+		if err != nil {
+			klog.V(4).Infof("unable to get the credentials of the client connected to %s: %v", a.conn.LocalAddr(), err)
+		}
+		a.pid, a.identity = pid, identity
+	})
+	return a.pid, a.identity
+}
+
+// FromContext returns the caller information of the request whose context is ctx.
+func FromContext(ctx context.Context) Info {
+	var info Info
+
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return info
+	}
+	if p.LocalAddr != nil {
+		info.Endpoint = p.LocalAddr.String()
+	}
+	if addr, ok := p.Addr.(*Addr); ok {
+		info.PID, info.Identity = addr.Credentials()
+		if info.Identity != nil {
+			info.User = info.Identity.User
+		}
+	}
+	return info
+}
+
+// WrapListener returns a listener whose accepted connections report the client's
+// process ID and identity through their remote address, see Addr.
+func WrapListener(listener net.Listener) net.Listener {
+	return &callerListener{Listener: listener}
+}
+
+type callerListener struct {
+	net.Listener
+}
+
+func (l *callerListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+
+	return &callerConn{
+		Conn:       conn,
+		remoteAddr: &Addr{Addr: conn.RemoteAddr(), conn: conn},
+	}, nil
+}
+
+type callerConn struct {
+	net.Conn
+	remoteAddr *Addr
+}
+
+func (c *callerConn) RemoteAddr() net.Addr {
+	return c.remoteAddr
+}
@@ -0,0 +1,62 @@
+//go:build linux
+// +build linux
+
+package caller
+
+import (
+	"net"
+	"os/user"
+	"strconv"
+
+	"golang.org/x/sys/unix"
+)
+
+// clientCredentials returns the process ID and identity of the client connected to a unix domain socket.
+func clientCredentials(conn net.Conn) (int, *Identity, error) {
+	unixConn, ok := conn.(*net.UnixConn)
+	if !ok {
+		return 0, nil, nil
+	}
+
+	rawConn, err := unixConn.SyscallConn()
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var cred *unix.Ucred
+	var credErr error
+	if err := rawConn.Control(func(fd uintptr) {
+		cred, credErr = unix.GetsockoptUcred(int(fd), unix.SOL_SOCKET, unix.SO_PEERCRED)
+	}); err != nil {
+		return 0, nil, err
+	}
+	if credErr != nil {
+		return 0, nil, credErr
+	}
+
+	return int(cred.Pid), userIdentity(strconv.Itoa(int(cred.Uid))), nil
+}
+
+// userIdentity returns the identity of the user whose ID is uid, with the names
+// of its groups if they can be looked up.
+func userIdentity(uid string) *Identity {
+	identity := &Identity{ID: uid}
+
+	u, err := user.LookupId(uid)
+	if err != nil {
+		return identity
+	}
+	identity.User = u.Username
+
+	groupIDs, err := u.GroupIds()
+	if err != nil {
+		return identity
+	}
+	for _, groupID := range groupIDs {
+		identity.Groups = append(identity.Groups, groupID)
+		if group, err := user.LookupGroupId(groupID); err == nil {
+			identity.Groups = append(identity.Groups, group.Name)
+		}
+	}
+	return identity
+}
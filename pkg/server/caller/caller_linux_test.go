@@ -0,0 +1,43 @@
+//go:build linux
+// +build linux
+
+package caller
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWrapListenerUnixSocket(t *testing.T) {
+	listener, err := net.Listen("unix", filepath.Join(t.TempDir(), "caller.sock"))
+	require.Nil(t, err)
+	listener = WrapListener(listener)
+	defer listener.Close()
+
+	go func() {
+		conn, err := net.Dial("unix", listener.Addr().String())
+		if err == nil {
+			defer conn.Close()
+			// wait for the server to close the connection
+			conn.Read(make([]byte, 1))
+		}
+	}()
+
+	conn, err := listener.Accept()
+	require.Nil(t, err)
+	defer conn.Close()
+
+	addr, ok := conn.RemoteAddr().(*Addr)
+	require.True(t, ok, "unexpected remote address type %T", conn.RemoteAddr())
+	pid, identity := addr.Credentials()
+	assert.Equal(t, os.Getpid(), pid)
+	if assert.NotNil(t, identity) {
+		assert.Equal(t, strconv.Itoa(os.Getuid()), identity.ID)
+	}
+}
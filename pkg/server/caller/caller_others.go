@@ -0,0 +1,13 @@
+//go:build !windows && !linux
+// +build !windows,!linux
+
+package caller
+
+import (
+	"net"
+)
+
+// clientCredentials isn't supported on this platform.
+func clientCredentials(net.Conn) (int, *Identity, error) {
+	return 0, nil, nil
+}
@@ -0,0 +1,123 @@
+//go:build windows
+// +build windows
+
+package caller
+
+import (
+	"net"
+	"runtime"
+
+	"golang.org/x/sys/windows"
+)
+
+var (
+	modadvapi32                    = windows.NewLazySystemDLL("advapi32.dll")
+	procImpersonateNamedPipeClient = modadvapi32.NewProc("ImpersonateNamedPipeClient")
+)
+
+// clientCredentials returns the process ID and identity of the client connected to a named pipe.
+func clientCredentials(conn net.Conn) (int, *Identity, error) {
+	pipe, ok := conn.(interface{ Fd() uintptr })
+	if !ok {
+		return 0, nil, nil
+	}
+	handle := windows.Handle(pipe.Fd())
+
+	var pid uint32
+	if err := windows.GetNamedPipeClientProcessId(handle, &pid); err != nil {
+		return 0, nil, err
+	}
+
+	identity, err := pipeClientIdentity(handle)
+	return int(pid), identity, err
+}
+
+// pipeClientIdentity returns the identity of the client connected to the named pipe, from the
+// token it impersonates. Unlike the token of the process whose ID the pipe reports, it can't
+// belong to another process that reused the ID after the client exited.
+func pipeClientIdentity(pipe windows.Handle) (*Identity, error) {
+	token, err := pipeClientToken(pipe)
+	if err != nil {
+		return nil, err
+	}
+	defer token.Close()
+
+	return tokenIdentity(token)
+}
+
+// pipeClientToken returns the token of the client connected to the named pipe, by impersonating
+// it on a dedicated OS thread: if the impersonation can't be reverted, the thread is terminated
+// with its goroutine, rather than run other goroutines as the client.
+func pipeClientToken(pipe windows.Handle) (windows.Token, error) {
+	type result struct {
+		token windows.Token
+		err   error
+	}
+	resultChan := make(chan result, 1)
+
+	go func() {
+		runtime.LockOSThread()
+
+		if r1, _, err := procImpersonateNamedPipeClient.Call(uintptr(pipe)); r1 == 0 {
+			runtime.UnlockOSThread()
+			resultChan <- result{err: err}
+			return
+		}
+
+		var token windows.Token
+		err := windows.OpenThreadToken(windows.CurrentThread(), windows.TOKEN_QUERY, true, &token)
+		if revertErr := windows.RevertToSelf(); revertErr != nil {
+			if err == nil {
+				token.Close()
+			}
+			resultChan <- result{err: revertErr}
+			return
+		}
+		runtime.UnlockOSThread()
+		resultChan <- result{token: token, err: err}
+	}()
+
+	r := <-resultChan
+	return r.token, r.err
+}
+
+// tokenIdentity returns the identity of token's user and enabled groups.
+func tokenIdentity(token windows.Token) (*Identity, error) {
+	tokenUser, err := token.GetTokenUser()
+	if err != nil {
+		return nil, err
+	}
+	identity := &Identity{
+		User: accountName(tokenUser.User.Sid),
+		ID:   tokenUser.User.Sid.String(),
+	}
+
+	tokenGroups, err := token.GetTokenGroups()
+	if err != nil {
+		return nil, err
+	}
+	for _, group := range tokenGroups.AllGroups() {
+		if group.Attributes&windows.SE_GROUP_ENABLED == 0 {
+			continue
+		}
+		identity.Groups = append(identity.Groups, group.Sid.String())
+		if name := accountName(group.Sid); name != "" {
+			identity.Groups = append(identity.Groups, name)
+		}
+	}
+
+	return identity, nil
+}
+
+// accountName returns the `DOMAIN\name` of the account whose SID is sid, or an empty string
+// if it can't be looked up.
+func accountName(sid *windows.SID) string {
+	account, domain, _, err := sid.LookupAccount("")
+	if err != nil {
+		return ""
+	}
+	if domain == "" {
+		return account
+	}
+	return domain + `\` + account
+}
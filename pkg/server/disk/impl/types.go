@@ -18,9 +18,86 @@ type ListDiskLocationsResponse struct {
 type PartitionDiskRequest struct {
 	// Disk device ID of the disk to partition
 	DiskNumber uint32
+
+	// Partition style to initialize the disk with, GPT if unknown
+	PartitionStyle PartitionStyle
+	// Size, offset and alignment in bytes, 0 for the defaults
+	Size      uint64
+	Offset    uint64
+	Alignment uint32
+	GptType   string
+	MbrType   uint32
+	Name      string
+	// Create a partition even if the disk already has partitions
+	Append bool
 }
 
 type PartitionDiskResponse struct {
+	// Partition number of the created or existing partition
+	PartitionNumber uint32
+}
+
+type ListPartitionsRequest struct {
+	DiskNumber uint32
+}
+
+type PartitionInfo struct {
+	DiskNumber      uint32
+	PartitionNumber uint32
+	Offset          uint64
+	Size            uint64
+	MbrType         uint32
+	GptType         string
+	Guid            string
+	DriveLetter     string
+	IsBoot          bool
+	IsSystem        bool
+	IsHidden        bool
+	IsReadOnly      bool
+	IsOffline       bool
+}
+
+type ListPartitionsResponse struct {
+	Partitions []*PartitionInfo
+}
+
+type DeletePartitionRequest struct {
+	DiskNumber      uint32
+	PartitionNumber uint32
+}
+
+type DeletePartitionResponse struct {
+}
+
+type ZeroMode uint32
+
+const (
+	ZeroModeNone ZeroMode = iota
+	ZeroModeEdges
+	ZeroModeEntire
+)
+
+type ClearDiskRequest struct {
+	// Disk device number of the disk to clear
+	DiskNumber uint32
+	ZeroMode   ZeroMode
+	// Size zeroed at both the beginning and the end of the disk with ZeroModeEdges, 32 if 0
+	ZeroMegabytes uint32
+	RemoveOem     bool
+}
+
+type ClearDiskStage uint32
+
+const (
+	ClearDiskStageClearing ClearDiskStage = iota
+	ClearDiskStageZeroing
+	ClearDiskStageDone
+)
+
+type ClearDiskResponse struct {
+	Stage       ClearDiskStage
+	BytesZeroed uint64
+	BytesToZero uint64
 }
 
 type RescanRequest struct {
@@ -43,8 +120,60 @@ type ListDiskIDsRequest struct {
 
 type DiskIDs struct {
 	// Map of Disk ID types and Disk ID values
-	Page83       string
-	SerialNumber string
+	Page83             string
+	SerialNumber       string
+	DeviceSerialNumber string
+	Identifiers        []*DiskIdentifier
+}
+
+type IdentifierType uint32
+
+type IdentifierAssociation uint32
+
+type DiskIdentifier struct {
+	Type        IdentifierType
+	Association IdentifierAssociation
+	// Value of the identifier, hex encoded if binary
+	Value string
+}
+
+type IdentifierKind uint32
+
+const (
+	IdentifierKindAny IdentifierKind = iota
+	IdentifierKindPage83
+	IdentifierKindSerialNumber
+	IdentifierKindVendorSpecific
+	IdentifierKindT10VendorID
+	IdentifierKindEUI64
+	IdentifierKindNAA
+	IdentifierKindSCSINameString
+)
+
+type FindDiskRequest struct {
+	// Identifier to match, ignored if empty
+	Identifier     string
+	IdentifierKind IdentifierKind
+	// Location to match, its empty fields match any value, ignored if nil
+	Location *DiskLocation
+}
+
+type FindDiskResponse struct {
+	DiskNumber uint32
+}
+
+type WaitForDiskRequest struct {
+	// Identifier, IdentifierKind and Location select the disk as in FindDiskRequest
+	Identifier     string
+	IdentifierKind IdentifierKind
+	Location       *DiskLocation
+	// Bring the disk online if it shows up offline
+	SetOnline bool
+}
+
+type WaitForDiskResponse struct {
+	DiskNumber uint32
+	Disk       *DiskInfo
 }
 
 type ListDiskIDsResponse struct {
@@ -81,6 +210,121 @@ type GetDiskStateResponse struct {
 	IsOnline bool
 }
 
+type BusType uint32
+
+type PartitionStyle uint32
+
+const (
+	PartitionStyleUnknown PartitionStyle = iota
+	PartitionStyleMBR
+	PartitionStyleGPT
+)
+
+type OfflineReason uint32
+
+// SanPolicy values match the MSFT_StorageSetting NewDiskPolicy values
+type SanPolicy uint32
+
+const (
+	SanPolicyUnknown SanPolicy = iota
+	SanPolicyOnlineAll
+	SanPolicyOfflineShared
+	SanPolicyOfflineAll
+	SanPolicyOfflineInternal
+)
+
+type DiskAttributes struct {
+	IsReadOnly    bool
+	IsOffline     bool
+	OfflineReason OfflineReason
+	SanPolicy     SanPolicy
+}
+
+type SetDiskAttributesRequest struct {
+	// Disk device number of the disk
+	DiskNumber uint32
+	// Set the read only attribute to IsReadOnly
+	SetReadOnly bool
+	IsReadOnly  bool
+	// SAN policy of the host, unchanged if unknown
+	SanPolicy SanPolicy
+}
+
+type SetDiskAttributesResponse struct {
+	Attributes *DiskAttributes
+}
+
+type GetDiskAttributesRequest struct {
+	// Disk device number of the disk
+	DiskNumber uint32
+}
+
+type GetDiskAttributesResponse struct {
+	Attributes *DiskAttributes
+}
+
+type DiskInfo struct {
+	// Disk device number of the disk
+	DiskNumber         uint32
+	Size               uint64
+	LogicalSectorSize  uint32
+	PhysicalSectorSize uint32
+	BusType            BusType
+	FriendlyName       string
+	Model              string
+	SerialNumber       string
+	Page83             string
+	PartitionStyle     PartitionStyle
+	IsBoot             bool
+	IsSystem           bool
+	IsClustered        bool
+	IsReadOnly         bool
+	IsOffline          bool
+	OfflineReason      OfflineReason
+	Location           *DiskLocation
+}
+
+type ListDisksRequest struct {
+}
+
+type ListDisksResponse struct {
+	// Map of disk device numbers and information of each disk device
+	Disks map[uint32]*DiskInfo
+}
+
+type GetDiskInfoRequest struct {
+	// Disk device number of the disk
+	DiskNumber uint32
+}
+
+type GetDiskInfoResponse struct {
+	Disk *DiskInfo
+}
+
+type WatchDisksRequest struct {
+	// Interval in seconds at which the disks are checked for changes, 5 if 0
+	PollIntervalSeconds uint32
+}
+
+type DiskEventType uint32
+
+const (
+	DiskEventTypeExisting DiskEventType = iota
+	DiskEventTypeSynced
+	DiskEventTypeAdded
+	DiskEventTypeRemoved
+	DiskEventTypeOnline
+	DiskEventTypeOffline
+	DiskEventTypeResized
+)
+
+type WatchDisksResponse struct {
+	Type DiskEventType
+	// Disk device number of the disk, unset for DiskEventTypeSynced
+	DiskNumber uint32
+	Disk       *DiskInfo
+}
+
 // These structs are used in pre v1beta3 API versions
 
 type DiskStatsRequest struct {
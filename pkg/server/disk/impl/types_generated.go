@@ -15,15 +15,25 @@ type VersionedAPI interface {
 
 // All the functions this group's server needs to define.
 type ServerInterface interface {
+	ClearDisk(context.Context, *ClearDiskRequest, func(*ClearDiskResponse) error, apiversion.Version) error
+	DeletePartition(context.Context, *DeletePartitionRequest, apiversion.Version) (*DeletePartitionResponse, error)
 	DiskStats(context.Context, *DiskStatsRequest, apiversion.Version) (*DiskStatsResponse, error)
+	FindDisk(context.Context, *FindDiskRequest, apiversion.Version) (*FindDiskResponse, error)
 	GetAttachState(context.Context, *GetAttachStateRequest, apiversion.Version) (*GetAttachStateResponse, error)
+	GetDiskAttributes(context.Context, *GetDiskAttributesRequest, apiversion.Version) (*GetDiskAttributesResponse, error)
+	GetDiskInfo(context.Context, *GetDiskInfoRequest, apiversion.Version) (*GetDiskInfoResponse, error)
 	GetDiskNumberByName(context.Context, *GetDiskNumberByNameRequest, apiversion.Version) (*GetDiskNumberByNameResponse, error)
 	GetDiskState(context.Context, *GetDiskStateRequest, apiversion.Version) (*GetDiskStateResponse, error)
 	GetDiskStats(context.Context, *GetDiskStatsRequest, apiversion.Version) (*GetDiskStatsResponse, error)
 	ListDiskIDs(context.Context, *ListDiskIDsRequest, apiversion.Version) (*ListDiskIDsResponse, error)
 	ListDiskLocations(context.Context, *ListDiskLocationsRequest, apiversion.Version) (*ListDiskLocationsResponse, error)
+	ListDisks(context.Context, *ListDisksRequest, apiversion.Version) (*ListDisksResponse, error)
+	ListPartitions(context.Context, *ListPartitionsRequest, apiversion.Version) (*ListPartitionsResponse, error)
 	PartitionDisk(context.Context, *PartitionDiskRequest, apiversion.Version) (*PartitionDiskResponse, error)
 	Rescan(context.Context, *RescanRequest, apiversion.Version) (*RescanResponse, error)
 	SetAttachState(context.Context, *SetAttachStateRequest, apiversion.Version) (*SetAttachStateResponse, error)
+	SetDiskAttributes(context.Context, *SetDiskAttributesRequest, apiversion.Version) (*SetDiskAttributesResponse, error)
 	SetDiskState(context.Context, *SetDiskStateRequest, apiversion.Version) (*SetDiskStateResponse, error)
+	WaitForDisk(context.Context, *WaitForDiskRequest, apiversion.Version) (*WaitForDiskResponse, error)
+	WatchDisks(context.Context, *WatchDisksRequest, func(*WatchDisksResponse) error, apiversion.Version) error
 }
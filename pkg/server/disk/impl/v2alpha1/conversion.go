@@ -0,0 +1,101 @@
+package v2alpha1
+
+import (
+	"github.com/kubernetes-csi/csi-proxy/client/api/disk/v2alpha1"
+	impl "github.com/kubernetes-csi/csi-proxy/pkg/server/disk/impl"
+)
+
+// Add manual conversion functions here to override automatic conversion functions
+
+func Convert_impl_ListDiskIDsResponse_To_v2alpha1_ListDiskIDsResponse(in *impl.ListDiskIDsResponse, out *v2alpha1.ListDiskIDsResponse) error {
+	if in.DiskIDs != nil {
+		in, out := &in.DiskIDs, &out.DiskIDs
+		*out = make(map[uint32]*v2alpha1.DiskIDs, len(*in))
+		for key, val := range *in {
+
+			// This function is almost generated correctly, it has an issue in the type of arguments sent
+			// e.g.  if err := Convert_impl_DiskIDs_To_v2alpha1_DiskIDs(*&val, *newVal); err != nil {
+			newVal := new(v2alpha1.DiskIDs)
+			if err := Convert_impl_DiskIDs_To_v2alpha1_DiskIDs(val, newVal); err != nil {
+				return err
+			}
+			(*out)[key] = newVal
+
+		}
+	} else {
+		out.DiskIDs = nil
+	}
+	return nil
+}
+
+func Convert_impl_ListDiskLocationsResponse_To_v2alpha1_ListDiskLocationsResponse(in *impl.ListDiskLocationsResponse, out *v2alpha1.ListDiskLocationsResponse) error {
+	if in.DiskLocations != nil {
+		in, out := &in.DiskLocations, &out.DiskLocations
+		*out = make(map[uint32]*v2alpha1.DiskLocation, len(*in))
+		for key, val := range *in {
+
+			// This function is almost generated correctly, it has an issue in the type of arguments sent
+			// e.g.  if err := Convert_impl_DiskLocation_To_v2alpha1_DiskLocation(*&val, *newVal); err != nil {
+			newVal := new(v2alpha1.DiskLocation)
+			if err := Convert_impl_DiskLocation_To_v2alpha1_DiskLocation(val, newVal); err != nil {
+				return err
+			}
+			(*out)[key] = newVal
+		}
+	} else {
+		out.DiskLocations = nil
+	}
+	return nil
+}
+
+func Convert_impl_ListDisksResponse_To_v2alpha1_ListDisksResponse(in *impl.ListDisksResponse, out *v2alpha1.ListDisksResponse) error {
+	if in.Disks != nil {
+		in, out := &in.Disks, &out.Disks
+		*out = make(map[uint32]*v2alpha1.DiskInfo, len(*in))
+		for key, val := range *in {
+			newVal := new(v2alpha1.DiskInfo)
+			if err := Convert_impl_DiskInfo_To_v2alpha1_DiskInfo(val, newVal); err != nil {
+				return err
+			}
+			(*out)[key] = newVal
+		}
+	} else {
+		out.Disks = nil
+	}
+	return nil
+}
+
+func Convert_impl_ListPartitionsResponse_To_v2alpha1_ListPartitionsResponse(in *impl.ListPartitionsResponse, out *v2alpha1.ListPartitionsResponse) error {
+	if in.Partitions != nil {
+		in, out := &in.Partitions, &out.Partitions
+		*out = make([]*v2alpha1.PartitionInfo, len(*in))
+		for i := range *in {
+			(*out)[i] = new(v2alpha1.PartitionInfo)
+			if err := Convert_impl_PartitionInfo_To_v2alpha1_PartitionInfo((*in)[i], (*out)[i]); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Partitions = nil
+	}
+	return nil
+}
+
+func Convert_impl_DiskIDs_To_v2alpha1_DiskIDs(in *impl.DiskIDs, out *v2alpha1.DiskIDs) error {
+	out.Page83 = in.Page83
+	out.SerialNumber = in.SerialNumber
+	out.DeviceSerialNumber = in.DeviceSerialNumber
+	if in.Identifiers != nil {
+		in, out := &in.Identifiers, &out.Identifiers
+		*out = make([]*v2alpha1.DiskIdentifier, len(*in))
+		for i := range *in {
+			(*out)[i] = new(v2alpha1.DiskIdentifier)
+			if err := Convert_impl_DiskIdentifier_To_v2alpha1_DiskIdentifier((*in)[i], (*out)[i]); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Identifiers = nil
+	}
+	return nil
+}
@@ -0,0 +1,1075 @@
+// Code generated by csi-proxy-api-gen. DO NOT EDIT.
+
+package v2alpha1
+
+import (
+	v2alpha1 "github.com/kubernetes-csi/csi-proxy/client/api/disk/v2alpha1"
+	impl "github.com/kubernetes-csi/csi-proxy/pkg/server/disk/impl"
+)
+
+func autoConvert_v2alpha1_ClearDiskRequest_To_impl_ClearDiskRequest(in *v2alpha1.ClearDiskRequest, out *impl.ClearDiskRequest) error {
+	out.DiskNumber = in.DiskNumber
+	out.ZeroMode = impl.ZeroMode(in.ZeroMode)
+	out.ZeroMegabytes = in.ZeroMegabytes
+	out.RemoveOem = in.RemoveOem
+	return nil
+}
+
+// Convert_v2alpha1_ClearDiskRequest_To_impl_ClearDiskRequest is an autogenerated conversion function.
+func Convert_v2alpha1_ClearDiskRequest_To_impl_ClearDiskRequest(in *v2alpha1.ClearDiskRequest, out *impl.ClearDiskRequest) error {
+	return autoConvert_v2alpha1_ClearDiskRequest_To_impl_ClearDiskRequest(in, out)
+}
+
+func autoConvert_impl_ClearDiskRequest_To_v2alpha1_ClearDiskRequest(in *impl.ClearDiskRequest, out *v2alpha1.ClearDiskRequest) error {
+	out.DiskNumber = in.DiskNumber
+	out.ZeroMode = v2alpha1.ZeroMode(in.ZeroMode)
+	out.ZeroMegabytes = in.ZeroMegabytes
+	out.RemoveOem = in.RemoveOem
+	return nil
+}
+
+// Convert_impl_ClearDiskRequest_To_v2alpha1_ClearDiskRequest is an autogenerated conversion function.
+func Convert_impl_ClearDiskRequest_To_v2alpha1_ClearDiskRequest(in *impl.ClearDiskRequest, out *v2alpha1.ClearDiskRequest) error {
+	return autoConvert_impl_ClearDiskRequest_To_v2alpha1_ClearDiskRequest(in, out)
+}
+
+func autoConvert_v2alpha1_ClearDiskResponse_To_impl_ClearDiskResponse(in *v2alpha1.ClearDiskResponse, out *impl.ClearDiskResponse) error {
+	out.Stage = impl.ClearDiskStage(in.Stage)
+	out.BytesZeroed = in.BytesZeroed
+	out.BytesToZero = in.BytesToZero
+	return nil
+}
+
+// Convert_v2alpha1_ClearDiskResponse_To_impl_ClearDiskResponse is an autogenerated conversion function.
+func Convert_v2alpha1_ClearDiskResponse_To_impl_ClearDiskResponse(in *v2alpha1.ClearDiskResponse, out *impl.ClearDiskResponse) error {
+	return autoConvert_v2alpha1_ClearDiskResponse_To_impl_ClearDiskResponse(in, out)
+}
+
+func autoConvert_impl_ClearDiskResponse_To_v2alpha1_ClearDiskResponse(in *impl.ClearDiskResponse, out *v2alpha1.ClearDiskResponse) error {
+	out.Stage = v2alpha1.ClearDiskStage(in.Stage)
+	out.BytesZeroed = in.BytesZeroed
+	out.BytesToZero = in.BytesToZero
+	return nil
+}
+
+// Convert_impl_ClearDiskResponse_To_v2alpha1_ClearDiskResponse is an autogenerated conversion function.
+func Convert_impl_ClearDiskResponse_To_v2alpha1_ClearDiskResponse(in *impl.ClearDiskResponse, out *v2alpha1.ClearDiskResponse) error {
+	return autoConvert_impl_ClearDiskResponse_To_v2alpha1_ClearDiskResponse(in, out)
+}
+
+func autoConvert_v2alpha1_DeletePartitionRequest_To_impl_DeletePartitionRequest(in *v2alpha1.DeletePartitionRequest, out *impl.DeletePartitionRequest) error {
+	out.DiskNumber = in.DiskNumber
+	out.PartitionNumber = in.PartitionNumber
+	return nil
+}
+
+// Convert_v2alpha1_DeletePartitionRequest_To_impl_DeletePartitionRequest is an autogenerated conversion function.
+func Convert_v2alpha1_DeletePartitionRequest_To_impl_DeletePartitionRequest(in *v2alpha1.DeletePartitionRequest, out *impl.DeletePartitionRequest) error {
+	return autoConvert_v2alpha1_DeletePartitionRequest_To_impl_DeletePartitionRequest(in, out)
+}
+
+func autoConvert_impl_DeletePartitionRequest_To_v2alpha1_DeletePartitionRequest(in *impl.DeletePartitionRequest, out *v2alpha1.DeletePartitionRequest) error {
+	out.DiskNumber = in.DiskNumber
+	out.PartitionNumber = in.PartitionNumber
+	return nil
+}
+
+// Convert_impl_DeletePartitionRequest_To_v2alpha1_DeletePartitionRequest is an autogenerated conversion function.
+func Convert_impl_DeletePartitionRequest_To_v2alpha1_DeletePartitionRequest(in *impl.DeletePartitionRequest, out *v2alpha1.DeletePartitionRequest) error {
+	return autoConvert_impl_DeletePartitionRequest_To_v2alpha1_DeletePartitionRequest(in, out)
+}
+
+func autoConvert_v2alpha1_DeletePartitionResponse_To_impl_DeletePartitionResponse(in *v2alpha1.DeletePartitionResponse, out *impl.DeletePartitionResponse) error {
+	return nil
+}
+
+// Convert_v2alpha1_DeletePartitionResponse_To_impl_DeletePartitionResponse is an autogenerated conversion function.
+func Convert_v2alpha1_DeletePartitionResponse_To_impl_DeletePartitionResponse(in *v2alpha1.DeletePartitionResponse, out *impl.DeletePartitionResponse) error {
+	return autoConvert_v2alpha1_DeletePartitionResponse_To_impl_DeletePartitionResponse(in, out)
+}
+
+func autoConvert_impl_DeletePartitionResponse_To_v2alpha1_DeletePartitionResponse(in *impl.DeletePartitionResponse, out *v2alpha1.DeletePartitionResponse) error {
+	return nil
+}
+
+// Convert_impl_DeletePartitionResponse_To_v2alpha1_DeletePartitionResponse is an autogenerated conversion function.
+func Convert_impl_DeletePartitionResponse_To_v2alpha1_DeletePartitionResponse(in *impl.DeletePartitionResponse, out *v2alpha1.DeletePartitionResponse) error {
+	return autoConvert_impl_DeletePartitionResponse_To_v2alpha1_DeletePartitionResponse(in, out)
+}
+
+func autoConvert_v2alpha1_DiskAttributes_To_impl_DiskAttributes(in *v2alpha1.DiskAttributes, out *impl.DiskAttributes) error {
+	out.IsReadOnly = in.IsReadOnly
+	out.IsOffline = in.IsOffline
+	out.OfflineReason = impl.OfflineReason(in.OfflineReason)
+	out.SanPolicy = impl.SanPolicy(in.SanPolicy)
+	return nil
+}
+
+// Convert_v2alpha1_DiskAttributes_To_impl_DiskAttributes is an autogenerated conversion function.
+func Convert_v2alpha1_DiskAttributes_To_impl_DiskAttributes(in *v2alpha1.DiskAttributes, out *impl.DiskAttributes) error {
+	return autoConvert_v2alpha1_DiskAttributes_To_impl_DiskAttributes(in, out)
+}
+
+func autoConvert_impl_DiskAttributes_To_v2alpha1_DiskAttributes(in *impl.DiskAttributes, out *v2alpha1.DiskAttributes) error {
+	out.IsReadOnly = in.IsReadOnly
+	out.IsOffline = in.IsOffline
+	out.OfflineReason = v2alpha1.OfflineReason(in.OfflineReason)
+	out.SanPolicy = v2alpha1.SanPolicy(in.SanPolicy)
+	return nil
+}
+
+// Convert_impl_DiskAttributes_To_v2alpha1_DiskAttributes is an autogenerated conversion function.
+func Convert_impl_DiskAttributes_To_v2alpha1_DiskAttributes(in *impl.DiskAttributes, out *v2alpha1.DiskAttributes) error {
+	return autoConvert_impl_DiskAttributes_To_v2alpha1_DiskAttributes(in, out)
+}
+
+func autoConvert_v2alpha1_DiskIDs_To_impl_DiskIDs(in *v2alpha1.DiskIDs, out *impl.DiskIDs) error {
+	out.Page83 = in.Page83
+	out.SerialNumber = in.SerialNumber
+	out.DeviceSerialNumber = in.DeviceSerialNumber
+	if in.Identifiers != nil {
+		in, out := &in.Identifiers, &out.Identifiers
+		*out = make([]*impl.DiskIdentifier, len(*in))
+		for i := range *in {
+			if err := Convert_v2alpha1_DiskIdentifier_To_impl_DiskIdentifier(*&(*in)[i], *&(*out)[i]); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Identifiers = nil
+	}
+	return nil
+}
+
+// Convert_v2alpha1_DiskIDs_To_impl_DiskIDs is an autogenerated conversion function.
+func Convert_v2alpha1_DiskIDs_To_impl_DiskIDs(in *v2alpha1.DiskIDs, out *impl.DiskIDs) error {
+	return autoConvert_v2alpha1_DiskIDs_To_impl_DiskIDs(in, out)
+}
+
+// detected external conversion function
+// Convert_impl_DiskIDs_To_v2alpha1_DiskIDs(in *impl.DiskIDs, out *v2alpha1.DiskIDs) error
+// skipping generation of the auto function
+
+func autoConvert_v2alpha1_DiskIdentifier_To_impl_DiskIdentifier(in *v2alpha1.DiskIdentifier, out *impl.DiskIdentifier) error {
+	out.Type = impl.IdentifierType(in.Type)
+	out.Association = impl.IdentifierAssociation(in.Association)
+	out.Value = in.Value
+	return nil
+}
+
+// Convert_v2alpha1_DiskIdentifier_To_impl_DiskIdentifier is an autogenerated conversion function.
+func Convert_v2alpha1_DiskIdentifier_To_impl_DiskIdentifier(in *v2alpha1.DiskIdentifier, out *impl.DiskIdentifier) error {
+	return autoConvert_v2alpha1_DiskIdentifier_To_impl_DiskIdentifier(in, out)
+}
+
+func autoConvert_impl_DiskIdentifier_To_v2alpha1_DiskIdentifier(in *impl.DiskIdentifier, out *v2alpha1.DiskIdentifier) error {
+	out.Type = v2alpha1.IdentifierType(in.Type)
+	out.Association = v2alpha1.IdentifierAssociation(in.Association)
+	out.Value = in.Value
+	return nil
+}
+
+// Convert_impl_DiskIdentifier_To_v2alpha1_DiskIdentifier is an autogenerated conversion function.
+func Convert_impl_DiskIdentifier_To_v2alpha1_DiskIdentifier(in *impl.DiskIdentifier, out *v2alpha1.DiskIdentifier) error {
+	return autoConvert_impl_DiskIdentifier_To_v2alpha1_DiskIdentifier(in, out)
+}
+
+func autoConvert_v2alpha1_DiskInfo_To_impl_DiskInfo(in *v2alpha1.DiskInfo, out *impl.DiskInfo) error {
+	out.DiskNumber = in.DiskNumber
+	out.Size = in.Size
+	out.LogicalSectorSize = in.LogicalSectorSize
+	out.PhysicalSectorSize = in.PhysicalSectorSize
+	out.BusType = impl.BusType(in.BusType)
+	out.FriendlyName = in.FriendlyName
+	out.Model = in.Model
+	out.SerialNumber = in.SerialNumber
+	out.Page83 = in.Page83
+	out.PartitionStyle = impl.PartitionStyle(in.PartitionStyle)
+	out.IsBoot = in.IsBoot
+	out.IsSystem = in.IsSystem
+	out.IsReadOnly = in.IsReadOnly
+	out.IsOffline = in.IsOffline
+	out.OfflineReason = impl.OfflineReason(in.OfflineReason)
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = new(impl.DiskLocation)
+		if err := Convert_v2alpha1_DiskLocation_To_impl_DiskLocation(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.Location = nil
+	}
+	out.IsClustered = in.IsClustered
+	return nil
+}
+
+// Convert_v2alpha1_DiskInfo_To_impl_DiskInfo is an autogenerated conversion function.
+func Convert_v2alpha1_DiskInfo_To_impl_DiskInfo(in *v2alpha1.DiskInfo, out *impl.DiskInfo) error {
+	return autoConvert_v2alpha1_DiskInfo_To_impl_DiskInfo(in, out)
+}
+
+func autoConvert_impl_DiskInfo_To_v2alpha1_DiskInfo(in *impl.DiskInfo, out *v2alpha1.DiskInfo) error {
+	out.DiskNumber = in.DiskNumber
+	out.Size = in.Size
+	out.LogicalSectorSize = in.LogicalSectorSize
+	out.PhysicalSectorSize = in.PhysicalSectorSize
+	out.BusType = v2alpha1.BusType(in.BusType)
+	out.FriendlyName = in.FriendlyName
+	out.Model = in.Model
+	out.SerialNumber = in.SerialNumber
+	out.Page83 = in.Page83
+	out.PartitionStyle = v2alpha1.PartitionStyle(in.PartitionStyle)
+	out.IsBoot = in.IsBoot
+	out.IsSystem = in.IsSystem
+	out.IsClustered = in.IsClustered
+	out.IsReadOnly = in.IsReadOnly
+	out.IsOffline = in.IsOffline
+	out.OfflineReason = v2alpha1.OfflineReason(in.OfflineReason)
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = new(v2alpha1.DiskLocation)
+		if err := Convert_impl_DiskLocation_To_v2alpha1_DiskLocation(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.Location = nil
+	}
+	return nil
+}
+
+// Convert_impl_DiskInfo_To_v2alpha1_DiskInfo is an autogenerated conversion function.
+func Convert_impl_DiskInfo_To_v2alpha1_DiskInfo(in *impl.DiskInfo, out *v2alpha1.DiskInfo) error {
+	return autoConvert_impl_DiskInfo_To_v2alpha1_DiskInfo(in, out)
+}
+
+func autoConvert_v2alpha1_DiskLocation_To_impl_DiskLocation(in *v2alpha1.DiskLocation, out *impl.DiskLocation) error {
+	out.Adapter = in.Adapter
+	out.Bus = in.Bus
+	out.Target = in.Target
+	out.LUNID = in.LUNID
+	return nil
+}
+
+// Convert_v2alpha1_DiskLocation_To_impl_DiskLocation is an autogenerated conversion function.
+func Convert_v2alpha1_DiskLocation_To_impl_DiskLocation(in *v2alpha1.DiskLocation, out *impl.DiskLocation) error {
+	return autoConvert_v2alpha1_DiskLocation_To_impl_DiskLocation(in, out)
+}
+
+func autoConvert_impl_DiskLocation_To_v2alpha1_DiskLocation(in *impl.DiskLocation, out *v2alpha1.DiskLocation) error {
+	out.Adapter = in.Adapter
+	out.Bus = in.Bus
+	out.Target = in.Target
+	out.LUNID = in.LUNID
+	return nil
+}
+
+// Convert_impl_DiskLocation_To_v2alpha1_DiskLocation is an autogenerated conversion function.
+func Convert_impl_DiskLocation_To_v2alpha1_DiskLocation(in *impl.DiskLocation, out *v2alpha1.DiskLocation) error {
+	return autoConvert_impl_DiskLocation_To_v2alpha1_DiskLocation(in, out)
+}
+
+func autoConvert_v2alpha1_FindDiskRequest_To_impl_FindDiskRequest(in *v2alpha1.FindDiskRequest, out *impl.FindDiskRequest) error {
+	out.Identifier = in.Identifier
+	out.IdentifierKind = impl.IdentifierKind(in.IdentifierKind)
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = new(impl.DiskLocation)
+		if err := Convert_v2alpha1_DiskLocation_To_impl_DiskLocation(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.Location = nil
+	}
+	return nil
+}
+
+// Convert_v2alpha1_FindDiskRequest_To_impl_FindDiskRequest is an autogenerated conversion function.
+func Convert_v2alpha1_FindDiskRequest_To_impl_FindDiskRequest(in *v2alpha1.FindDiskRequest, out *impl.FindDiskRequest) error {
+	return autoConvert_v2alpha1_FindDiskRequest_To_impl_FindDiskRequest(in, out)
+}
+
+func autoConvert_impl_FindDiskRequest_To_v2alpha1_FindDiskRequest(in *impl.FindDiskRequest, out *v2alpha1.FindDiskRequest) error {
+	out.Identifier = in.Identifier
+	out.IdentifierKind = v2alpha1.IdentifierKind(in.IdentifierKind)
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = new(v2alpha1.DiskLocation)
+		if err := Convert_impl_DiskLocation_To_v2alpha1_DiskLocation(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.Location = nil
+	}
+	return nil
+}
+
+// Convert_impl_FindDiskRequest_To_v2alpha1_FindDiskRequest is an autogenerated conversion function.
+func Convert_impl_FindDiskRequest_To_v2alpha1_FindDiskRequest(in *impl.FindDiskRequest, out *v2alpha1.FindDiskRequest) error {
+	return autoConvert_impl_FindDiskRequest_To_v2alpha1_FindDiskRequest(in, out)
+}
+
+func autoConvert_v2alpha1_FindDiskResponse_To_impl_FindDiskResponse(in *v2alpha1.FindDiskResponse, out *impl.FindDiskResponse) error {
+	out.DiskNumber = in.DiskNumber
+	return nil
+}
+
+// Convert_v2alpha1_FindDiskResponse_To_impl_FindDiskResponse is an autogenerated conversion function.
+func Convert_v2alpha1_FindDiskResponse_To_impl_FindDiskResponse(in *v2alpha1.FindDiskResponse, out *impl.FindDiskResponse) error {
+	return autoConvert_v2alpha1_FindDiskResponse_To_impl_FindDiskResponse(in, out)
+}
+
+func autoConvert_impl_FindDiskResponse_To_v2alpha1_FindDiskResponse(in *impl.FindDiskResponse, out *v2alpha1.FindDiskResponse) error {
+	out.DiskNumber = in.DiskNumber
+	return nil
+}
+
+// Convert_impl_FindDiskResponse_To_v2alpha1_FindDiskResponse is an autogenerated conversion function.
+func Convert_impl_FindDiskResponse_To_v2alpha1_FindDiskResponse(in *impl.FindDiskResponse, out *v2alpha1.FindDiskResponse) error {
+	return autoConvert_impl_FindDiskResponse_To_v2alpha1_FindDiskResponse(in, out)
+}
+
+func autoConvert_v2alpha1_GetDiskAttributesRequest_To_impl_GetDiskAttributesRequest(in *v2alpha1.GetDiskAttributesRequest, out *impl.GetDiskAttributesRequest) error {
+	out.DiskNumber = in.DiskNumber
+	return nil
+}
+
+// Convert_v2alpha1_GetDiskAttributesRequest_To_impl_GetDiskAttributesRequest is an autogenerated conversion function.
+func Convert_v2alpha1_GetDiskAttributesRequest_To_impl_GetDiskAttributesRequest(in *v2alpha1.GetDiskAttributesRequest, out *impl.GetDiskAttributesRequest) error {
+	return autoConvert_v2alpha1_GetDiskAttributesRequest_To_impl_GetDiskAttributesRequest(in, out)
+}
+
+func autoConvert_impl_GetDiskAttributesRequest_To_v2alpha1_GetDiskAttributesRequest(in *impl.GetDiskAttributesRequest, out *v2alpha1.GetDiskAttributesRequest) error {
+	out.DiskNumber = in.DiskNumber
+	return nil
+}
+
+// Convert_impl_GetDiskAttributesRequest_To_v2alpha1_GetDiskAttributesRequest is an autogenerated conversion function.
+func Convert_impl_GetDiskAttributesRequest_To_v2alpha1_GetDiskAttributesRequest(in *impl.GetDiskAttributesRequest, out *v2alpha1.GetDiskAttributesRequest) error {
+	return autoConvert_impl_GetDiskAttributesRequest_To_v2alpha1_GetDiskAttributesRequest(in, out)
+}
+
+func autoConvert_v2alpha1_GetDiskAttributesResponse_To_impl_GetDiskAttributesResponse(in *v2alpha1.GetDiskAttributesResponse, out *impl.GetDiskAttributesResponse) error {
+	if in.Attributes != nil {
+		in, out := &in.Attributes, &out.Attributes
+		*out = new(impl.DiskAttributes)
+		if err := Convert_v2alpha1_DiskAttributes_To_impl_DiskAttributes(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.Attributes = nil
+	}
+	return nil
+}
+
+// Convert_v2alpha1_GetDiskAttributesResponse_To_impl_GetDiskAttributesResponse is an autogenerated conversion function.
+func Convert_v2alpha1_GetDiskAttributesResponse_To_impl_GetDiskAttributesResponse(in *v2alpha1.GetDiskAttributesResponse, out *impl.GetDiskAttributesResponse) error {
+	return autoConvert_v2alpha1_GetDiskAttributesResponse_To_impl_GetDiskAttributesResponse(in, out)
+}
+
+func autoConvert_impl_GetDiskAttributesResponse_To_v2alpha1_GetDiskAttributesResponse(in *impl.GetDiskAttributesResponse, out *v2alpha1.GetDiskAttributesResponse) error {
+	if in.Attributes != nil {
+		in, out := &in.Attributes, &out.Attributes
+		*out = new(v2alpha1.DiskAttributes)
+		if err := Convert_impl_DiskAttributes_To_v2alpha1_DiskAttributes(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.Attributes = nil
+	}
+	return nil
+}
+
+// Convert_impl_GetDiskAttributesResponse_To_v2alpha1_GetDiskAttributesResponse is an autogenerated conversion function.
+func Convert_impl_GetDiskAttributesResponse_To_v2alpha1_GetDiskAttributesResponse(in *impl.GetDiskAttributesResponse, out *v2alpha1.GetDiskAttributesResponse) error {
+	return autoConvert_impl_GetDiskAttributesResponse_To_v2alpha1_GetDiskAttributesResponse(in, out)
+}
+
+func autoConvert_v2alpha1_GetDiskInfoRequest_To_impl_GetDiskInfoRequest(in *v2alpha1.GetDiskInfoRequest, out *impl.GetDiskInfoRequest) error {
+	out.DiskNumber = in.DiskNumber
+	return nil
+}
+
+// Convert_v2alpha1_GetDiskInfoRequest_To_impl_GetDiskInfoRequest is an autogenerated conversion function.
+func Convert_v2alpha1_GetDiskInfoRequest_To_impl_GetDiskInfoRequest(in *v2alpha1.GetDiskInfoRequest, out *impl.GetDiskInfoRequest) error {
+	return autoConvert_v2alpha1_GetDiskInfoRequest_To_impl_GetDiskInfoRequest(in, out)
+}
+
+func autoConvert_impl_GetDiskInfoRequest_To_v2alpha1_GetDiskInfoRequest(in *impl.GetDiskInfoRequest, out *v2alpha1.GetDiskInfoRequest) error {
+	out.DiskNumber = in.DiskNumber
+	return nil
+}
+
+// Convert_impl_GetDiskInfoRequest_To_v2alpha1_GetDiskInfoRequest is an autogenerated conversion function.
+func Convert_impl_GetDiskInfoRequest_To_v2alpha1_GetDiskInfoRequest(in *impl.GetDiskInfoRequest, out *v2alpha1.GetDiskInfoRequest) error {
+	return autoConvert_impl_GetDiskInfoRequest_To_v2alpha1_GetDiskInfoRequest(in, out)
+}
+
+func autoConvert_v2alpha1_GetDiskInfoResponse_To_impl_GetDiskInfoResponse(in *v2alpha1.GetDiskInfoResponse, out *impl.GetDiskInfoResponse) error {
+	if in.Disk != nil {
+		in, out := &in.Disk, &out.Disk
+		*out = new(impl.DiskInfo)
+		if err := Convert_v2alpha1_DiskInfo_To_impl_DiskInfo(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.Disk = nil
+	}
+	return nil
+}
+
+// Convert_v2alpha1_GetDiskInfoResponse_To_impl_GetDiskInfoResponse is an autogenerated conversion function.
+func Convert_v2alpha1_GetDiskInfoResponse_To_impl_GetDiskInfoResponse(in *v2alpha1.GetDiskInfoResponse, out *impl.GetDiskInfoResponse) error {
+	return autoConvert_v2alpha1_GetDiskInfoResponse_To_impl_GetDiskInfoResponse(in, out)
+}
+
+func autoConvert_impl_GetDiskInfoResponse_To_v2alpha1_GetDiskInfoResponse(in *impl.GetDiskInfoResponse, out *v2alpha1.GetDiskInfoResponse) error {
+	if in.Disk != nil {
+		in, out := &in.Disk, &out.Disk
+		*out = new(v2alpha1.DiskInfo)
+		if err := Convert_impl_DiskInfo_To_v2alpha1_DiskInfo(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.Disk = nil
+	}
+	return nil
+}
+
+// Convert_impl_GetDiskInfoResponse_To_v2alpha1_GetDiskInfoResponse is an autogenerated conversion function.
+func Convert_impl_GetDiskInfoResponse_To_v2alpha1_GetDiskInfoResponse(in *impl.GetDiskInfoResponse, out *v2alpha1.GetDiskInfoResponse) error {
+	return autoConvert_impl_GetDiskInfoResponse_To_v2alpha1_GetDiskInfoResponse(in, out)
+}
+
+func autoConvert_v2alpha1_GetDiskStateRequest_To_impl_GetDiskStateRequest(in *v2alpha1.GetDiskStateRequest, out *impl.GetDiskStateRequest) error {
+	out.DiskNumber = in.DiskNumber
+	return nil
+}
+
+// Convert_v2alpha1_GetDiskStateRequest_To_impl_GetDiskStateRequest is an autogenerated conversion function.
+func Convert_v2alpha1_GetDiskStateRequest_To_impl_GetDiskStateRequest(in *v2alpha1.GetDiskStateRequest, out *impl.GetDiskStateRequest) error {
+	return autoConvert_v2alpha1_GetDiskStateRequest_To_impl_GetDiskStateRequest(in, out)
+}
+
+func autoConvert_impl_GetDiskStateRequest_To_v2alpha1_GetDiskStateRequest(in *impl.GetDiskStateRequest, out *v2alpha1.GetDiskStateRequest) error {
+	out.DiskNumber = in.DiskNumber
+	return nil
+}
+
+// Convert_impl_GetDiskStateRequest_To_v2alpha1_GetDiskStateRequest is an autogenerated conversion function.
+func Convert_impl_GetDiskStateRequest_To_v2alpha1_GetDiskStateRequest(in *impl.GetDiskStateRequest, out *v2alpha1.GetDiskStateRequest) error {
+	return autoConvert_impl_GetDiskStateRequest_To_v2alpha1_GetDiskStateRequest(in, out)
+}
+
+func autoConvert_v2alpha1_GetDiskStateResponse_To_impl_GetDiskStateResponse(in *v2alpha1.GetDiskStateResponse, out *impl.GetDiskStateResponse) error {
+	out.IsOnline = in.IsOnline
+	return nil
+}
+
+// Convert_v2alpha1_GetDiskStateResponse_To_impl_GetDiskStateResponse is an autogenerated conversion function.
+func Convert_v2alpha1_GetDiskStateResponse_To_impl_GetDiskStateResponse(in *v2alpha1.GetDiskStateResponse, out *impl.GetDiskStateResponse) error {
+	return autoConvert_v2alpha1_GetDiskStateResponse_To_impl_GetDiskStateResponse(in, out)
+}
+
+func autoConvert_impl_GetDiskStateResponse_To_v2alpha1_GetDiskStateResponse(in *impl.GetDiskStateResponse, out *v2alpha1.GetDiskStateResponse) error {
+	out.IsOnline = in.IsOnline
+	return nil
+}
+
+// Convert_impl_GetDiskStateResponse_To_v2alpha1_GetDiskStateResponse is an autogenerated conversion function.
+func Convert_impl_GetDiskStateResponse_To_v2alpha1_GetDiskStateResponse(in *impl.GetDiskStateResponse, out *v2alpha1.GetDiskStateResponse) error {
+	return autoConvert_impl_GetDiskStateResponse_To_v2alpha1_GetDiskStateResponse(in, out)
+}
+
+func autoConvert_v2alpha1_GetDiskStatsRequest_To_impl_GetDiskStatsRequest(in *v2alpha1.GetDiskStatsRequest, out *impl.GetDiskStatsRequest) error {
+	out.DiskNumber = in.DiskNumber
+	return nil
+}
+
+// Convert_v2alpha1_GetDiskStatsRequest_To_impl_GetDiskStatsRequest is an autogenerated conversion function.
+func Convert_v2alpha1_GetDiskStatsRequest_To_impl_GetDiskStatsRequest(in *v2alpha1.GetDiskStatsRequest, out *impl.GetDiskStatsRequest) error {
+	return autoConvert_v2alpha1_GetDiskStatsRequest_To_impl_GetDiskStatsRequest(in, out)
+}
+
+func autoConvert_impl_GetDiskStatsRequest_To_v2alpha1_GetDiskStatsRequest(in *impl.GetDiskStatsRequest, out *v2alpha1.GetDiskStatsRequest) error {
+	out.DiskNumber = in.DiskNumber
+	return nil
+}
+
+// Convert_impl_GetDiskStatsRequest_To_v2alpha1_GetDiskStatsRequest is an autogenerated conversion function.
+func Convert_impl_GetDiskStatsRequest_To_v2alpha1_GetDiskStatsRequest(in *impl.GetDiskStatsRequest, out *v2alpha1.GetDiskStatsRequest) error {
+	return autoConvert_impl_GetDiskStatsRequest_To_v2alpha1_GetDiskStatsRequest(in, out)
+}
+
+func autoConvert_v2alpha1_GetDiskStatsResponse_To_impl_GetDiskStatsResponse(in *v2alpha1.GetDiskStatsResponse, out *impl.GetDiskStatsResponse) error {
+	out.TotalBytes = in.TotalBytes
+	return nil
+}
+
+// Convert_v2alpha1_GetDiskStatsResponse_To_impl_GetDiskStatsResponse is an autogenerated conversion function.
+func Convert_v2alpha1_GetDiskStatsResponse_To_impl_GetDiskStatsResponse(in *v2alpha1.GetDiskStatsResponse, out *impl.GetDiskStatsResponse) error {
+	return autoConvert_v2alpha1_GetDiskStatsResponse_To_impl_GetDiskStatsResponse(in, out)
+}
+
+func autoConvert_impl_GetDiskStatsResponse_To_v2alpha1_GetDiskStatsResponse(in *impl.GetDiskStatsResponse, out *v2alpha1.GetDiskStatsResponse) error {
+	out.TotalBytes = in.TotalBytes
+	return nil
+}
+
+// Convert_impl_GetDiskStatsResponse_To_v2alpha1_GetDiskStatsResponse is an autogenerated conversion function.
+func Convert_impl_GetDiskStatsResponse_To_v2alpha1_GetDiskStatsResponse(in *impl.GetDiskStatsResponse, out *v2alpha1.GetDiskStatsResponse) error {
+	return autoConvert_impl_GetDiskStatsResponse_To_v2alpha1_GetDiskStatsResponse(in, out)
+}
+
+func autoConvert_v2alpha1_ListDiskIDsRequest_To_impl_ListDiskIDsRequest(in *v2alpha1.ListDiskIDsRequest, out *impl.ListDiskIDsRequest) error {
+	return nil
+}
+
+// Convert_v2alpha1_ListDiskIDsRequest_To_impl_ListDiskIDsRequest is an autogenerated conversion function.
+func Convert_v2alpha1_ListDiskIDsRequest_To_impl_ListDiskIDsRequest(in *v2alpha1.ListDiskIDsRequest, out *impl.ListDiskIDsRequest) error {
+	return autoConvert_v2alpha1_ListDiskIDsRequest_To_impl_ListDiskIDsRequest(in, out)
+}
+
+func autoConvert_impl_ListDiskIDsRequest_To_v2alpha1_ListDiskIDsRequest(in *impl.ListDiskIDsRequest, out *v2alpha1.ListDiskIDsRequest) error {
+	return nil
+}
+
+// Convert_impl_ListDiskIDsRequest_To_v2alpha1_ListDiskIDsRequest is an autogenerated conversion function.
+func Convert_impl_ListDiskIDsRequest_To_v2alpha1_ListDiskIDsRequest(in *impl.ListDiskIDsRequest, out *v2alpha1.ListDiskIDsRequest) error {
+	return autoConvert_impl_ListDiskIDsRequest_To_v2alpha1_ListDiskIDsRequest(in, out)
+}
+
+func autoConvert_v2alpha1_ListDiskIDsResponse_To_impl_ListDiskIDsResponse(in *v2alpha1.ListDiskIDsResponse, out *impl.ListDiskIDsResponse) error {
+	if in.DiskIDs != nil {
+		in, out := &in.DiskIDs, &out.DiskIDs
+		*out = make(map[uint32]*impl.DiskIDs, len(*in))
+		for key, val := range *in {
+			newVal := new(*impl.DiskIDs)
+			if err := Convert_v2alpha1_DiskIDs_To_impl_DiskIDs(*&val, *newVal); err != nil {
+				return err
+			}
+			(*out)[key] = *newVal
+		}
+	} else {
+		out.DiskIDs = nil
+	}
+	return nil
+}
+
+// Convert_v2alpha1_ListDiskIDsResponse_To_impl_ListDiskIDsResponse is an autogenerated conversion function.
+func Convert_v2alpha1_ListDiskIDsResponse_To_impl_ListDiskIDsResponse(in *v2alpha1.ListDiskIDsResponse, out *impl.ListDiskIDsResponse) error {
+	return autoConvert_v2alpha1_ListDiskIDsResponse_To_impl_ListDiskIDsResponse(in, out)
+}
+
+// detected external conversion function
+// Convert_impl_ListDiskIDsResponse_To_v2alpha1_ListDiskIDsResponse(in *impl.ListDiskIDsResponse, out *v2alpha1.ListDiskIDsResponse) error
+// skipping generation of the auto function
+
+func autoConvert_v2alpha1_ListDiskLocationsRequest_To_impl_ListDiskLocationsRequest(in *v2alpha1.ListDiskLocationsRequest, out *impl.ListDiskLocationsRequest) error {
+	return nil
+}
+
+// Convert_v2alpha1_ListDiskLocationsRequest_To_impl_ListDiskLocationsRequest is an autogenerated conversion function.
+func Convert_v2alpha1_ListDiskLocationsRequest_To_impl_ListDiskLocationsRequest(in *v2alpha1.ListDiskLocationsRequest, out *impl.ListDiskLocationsRequest) error {
+	return autoConvert_v2alpha1_ListDiskLocationsRequest_To_impl_ListDiskLocationsRequest(in, out)
+}
+
+func autoConvert_impl_ListDiskLocationsRequest_To_v2alpha1_ListDiskLocationsRequest(in *impl.ListDiskLocationsRequest, out *v2alpha1.ListDiskLocationsRequest) error {
+	return nil
+}
+
+// Convert_impl_ListDiskLocationsRequest_To_v2alpha1_ListDiskLocationsRequest is an autogenerated conversion function.
+func Convert_impl_ListDiskLocationsRequest_To_v2alpha1_ListDiskLocationsRequest(in *impl.ListDiskLocationsRequest, out *v2alpha1.ListDiskLocationsRequest) error {
+	return autoConvert_impl_ListDiskLocationsRequest_To_v2alpha1_ListDiskLocationsRequest(in, out)
+}
+
+func autoConvert_v2alpha1_ListDiskLocationsResponse_To_impl_ListDiskLocationsResponse(in *v2alpha1.ListDiskLocationsResponse, out *impl.ListDiskLocationsResponse) error {
+	if in.DiskLocations != nil {
+		in, out := &in.DiskLocations, &out.DiskLocations
+		*out = make(map[uint32]*impl.DiskLocation, len(*in))
+		for key, val := range *in {
+			newVal := new(*impl.DiskLocation)
+			if err := Convert_v2alpha1_DiskLocation_To_impl_DiskLocation(*&val, *newVal); err != nil {
+				return err
+			}
+			(*out)[key] = *newVal
+		}
+	} else {
+		out.DiskLocations = nil
+	}
+	return nil
+}
+
+// Convert_v2alpha1_ListDiskLocationsResponse_To_impl_ListDiskLocationsResponse is an autogenerated conversion function.
+func Convert_v2alpha1_ListDiskLocationsResponse_To_impl_ListDiskLocationsResponse(in *v2alpha1.ListDiskLocationsResponse, out *impl.ListDiskLocationsResponse) error {
+	return autoConvert_v2alpha1_ListDiskLocationsResponse_To_impl_ListDiskLocationsResponse(in, out)
+}
+
+// detected external conversion function
+// Convert_impl_ListDiskLocationsResponse_To_v2alpha1_ListDiskLocationsResponse(in *impl.ListDiskLocationsResponse, out *v2alpha1.ListDiskLocationsResponse) error
+// skipping generation of the auto function
+
+func autoConvert_v2alpha1_ListDisksRequest_To_impl_ListDisksRequest(in *v2alpha1.ListDisksRequest, out *impl.ListDisksRequest) error {
+	return nil
+}
+
+// Convert_v2alpha1_ListDisksRequest_To_impl_ListDisksRequest is an autogenerated conversion function.
+func Convert_v2alpha1_ListDisksRequest_To_impl_ListDisksRequest(in *v2alpha1.ListDisksRequest, out *impl.ListDisksRequest) error {
+	return autoConvert_v2alpha1_ListDisksRequest_To_impl_ListDisksRequest(in, out)
+}
+
+func autoConvert_impl_ListDisksRequest_To_v2alpha1_ListDisksRequest(in *impl.ListDisksRequest, out *v2alpha1.ListDisksRequest) error {
+	return nil
+}
+
+// Convert_impl_ListDisksRequest_To_v2alpha1_ListDisksRequest is an autogenerated conversion function.
+func Convert_impl_ListDisksRequest_To_v2alpha1_ListDisksRequest(in *impl.ListDisksRequest, out *v2alpha1.ListDisksRequest) error {
+	return autoConvert_impl_ListDisksRequest_To_v2alpha1_ListDisksRequest(in, out)
+}
+
+func autoConvert_v2alpha1_ListDisksResponse_To_impl_ListDisksResponse(in *v2alpha1.ListDisksResponse, out *impl.ListDisksResponse) error {
+	if in.Disks != nil {
+		in, out := &in.Disks, &out.Disks
+		*out = make(map[uint32]*impl.DiskInfo, len(*in))
+		for key, val := range *in {
+			newVal := new(*impl.DiskInfo)
+			if err := Convert_v2alpha1_DiskInfo_To_impl_DiskInfo(*&val, *newVal); err != nil {
+				return err
+			}
+			(*out)[key] = *newVal
+		}
+	} else {
+		out.Disks = nil
+	}
+	return nil
+}
+
+// Convert_v2alpha1_ListDisksResponse_To_impl_ListDisksResponse is an autogenerated conversion function.
+func Convert_v2alpha1_ListDisksResponse_To_impl_ListDisksResponse(in *v2alpha1.ListDisksResponse, out *impl.ListDisksResponse) error {
+	return autoConvert_v2alpha1_ListDisksResponse_To_impl_ListDisksResponse(in, out)
+}
+
+// detected external conversion function
+// Convert_impl_ListDisksResponse_To_v2alpha1_ListDisksResponse(in *impl.ListDisksResponse, out *v2alpha1.ListDisksResponse) error
+// skipping generation of the auto function
+
+func autoConvert_v2alpha1_ListPartitionsRequest_To_impl_ListPartitionsRequest(in *v2alpha1.ListPartitionsRequest, out *impl.ListPartitionsRequest) error {
+	out.DiskNumber = in.DiskNumber
+	return nil
+}
+
+// Convert_v2alpha1_ListPartitionsRequest_To_impl_ListPartitionsRequest is an autogenerated conversion function.
+func Convert_v2alpha1_ListPartitionsRequest_To_impl_ListPartitionsRequest(in *v2alpha1.ListPartitionsRequest, out *impl.ListPartitionsRequest) error {
+	return autoConvert_v2alpha1_ListPartitionsRequest_To_impl_ListPartitionsRequest(in, out)
+}
+
+func autoConvert_impl_ListPartitionsRequest_To_v2alpha1_ListPartitionsRequest(in *impl.ListPartitionsRequest, out *v2alpha1.ListPartitionsRequest) error {
+	out.DiskNumber = in.DiskNumber
+	return nil
+}
+
+// Convert_impl_ListPartitionsRequest_To_v2alpha1_ListPartitionsRequest is an autogenerated conversion function.
+func Convert_impl_ListPartitionsRequest_To_v2alpha1_ListPartitionsRequest(in *impl.ListPartitionsRequest, out *v2alpha1.ListPartitionsRequest) error {
+	return autoConvert_impl_ListPartitionsRequest_To_v2alpha1_ListPartitionsRequest(in, out)
+}
+
+func autoConvert_v2alpha1_ListPartitionsResponse_To_impl_ListPartitionsResponse(in *v2alpha1.ListPartitionsResponse, out *impl.ListPartitionsResponse) error {
+	if in.Partitions != nil {
+		in, out := &in.Partitions, &out.Partitions
+		*out = make([]*impl.PartitionInfo, len(*in))
+		for i := range *in {
+			if err := Convert_v2alpha1_PartitionInfo_To_impl_PartitionInfo(*&(*in)[i], *&(*out)[i]); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Partitions = nil
+	}
+	return nil
+}
+
+// Convert_v2alpha1_ListPartitionsResponse_To_impl_ListPartitionsResponse is an autogenerated conversion function.
+func Convert_v2alpha1_ListPartitionsResponse_To_impl_ListPartitionsResponse(in *v2alpha1.ListPartitionsResponse, out *impl.ListPartitionsResponse) error {
+	return autoConvert_v2alpha1_ListPartitionsResponse_To_impl_ListPartitionsResponse(in, out)
+}
+
+// detected external conversion function
+// Convert_impl_ListPartitionsResponse_To_v2alpha1_ListPartitionsResponse(in *impl.ListPartitionsResponse, out *v2alpha1.ListPartitionsResponse) error
+// skipping generation of the auto function
+
+func autoConvert_v2alpha1_PartitionDiskRequest_To_impl_PartitionDiskRequest(in *v2alpha1.PartitionDiskRequest, out *impl.PartitionDiskRequest) error {
+	out.DiskNumber = in.DiskNumber
+	out.PartitionStyle = impl.PartitionStyle(in.PartitionStyle)
+	out.Size = in.Size
+	out.Offset = in.Offset
+	out.Alignment = in.Alignment
+	out.GptType = in.GptType
+	out.MbrType = in.MbrType
+	out.Name = in.Name
+	out.Append = in.Append
+	return nil
+}
+
+// Convert_v2alpha1_PartitionDiskRequest_To_impl_PartitionDiskRequest is an autogenerated conversion function.
+func Convert_v2alpha1_PartitionDiskRequest_To_impl_PartitionDiskRequest(in *v2alpha1.PartitionDiskRequest, out *impl.PartitionDiskRequest) error {
+	return autoConvert_v2alpha1_PartitionDiskRequest_To_impl_PartitionDiskRequest(in, out)
+}
+
+func autoConvert_impl_PartitionDiskRequest_To_v2alpha1_PartitionDiskRequest(in *impl.PartitionDiskRequest, out *v2alpha1.PartitionDiskRequest) error {
+	out.DiskNumber = in.DiskNumber
+	out.PartitionStyle = v2alpha1.PartitionStyle(in.PartitionStyle)
+	out.Size = in.Size
+	out.Offset = in.Offset
+	out.Alignment = in.Alignment
+	out.GptType = in.GptType
+	out.MbrType = in.MbrType
+	out.Name = in.Name
+	out.Append = in.Append
+	return nil
+}
+
+// Convert_impl_PartitionDiskRequest_To_v2alpha1_PartitionDiskRequest is an autogenerated conversion function.
+func Convert_impl_PartitionDiskRequest_To_v2alpha1_PartitionDiskRequest(in *impl.PartitionDiskRequest, out *v2alpha1.PartitionDiskRequest) error {
+	return autoConvert_impl_PartitionDiskRequest_To_v2alpha1_PartitionDiskRequest(in, out)
+}
+
+func autoConvert_v2alpha1_PartitionDiskResponse_To_impl_PartitionDiskResponse(in *v2alpha1.PartitionDiskResponse, out *impl.PartitionDiskResponse) error {
+	out.PartitionNumber = in.PartitionNumber
+	return nil
+}
+
+// Convert_v2alpha1_PartitionDiskResponse_To_impl_PartitionDiskResponse is an autogenerated conversion function.
+func Convert_v2alpha1_PartitionDiskResponse_To_impl_PartitionDiskResponse(in *v2alpha1.PartitionDiskResponse, out *impl.PartitionDiskResponse) error {
+	return autoConvert_v2alpha1_PartitionDiskResponse_To_impl_PartitionDiskResponse(in, out)
+}
+
+func autoConvert_impl_PartitionDiskResponse_To_v2alpha1_PartitionDiskResponse(in *impl.PartitionDiskResponse, out *v2alpha1.PartitionDiskResponse) error {
+	out.PartitionNumber = in.PartitionNumber
+	return nil
+}
+
+// Convert_impl_PartitionDiskResponse_To_v2alpha1_PartitionDiskResponse is an autogenerated conversion function.
+func Convert_impl_PartitionDiskResponse_To_v2alpha1_PartitionDiskResponse(in *impl.PartitionDiskResponse, out *v2alpha1.PartitionDiskResponse) error {
+	return autoConvert_impl_PartitionDiskResponse_To_v2alpha1_PartitionDiskResponse(in, out)
+}
+
+func autoConvert_v2alpha1_PartitionInfo_To_impl_PartitionInfo(in *v2alpha1.PartitionInfo, out *impl.PartitionInfo) error {
+	out.DiskNumber = in.DiskNumber
+	out.PartitionNumber = in.PartitionNumber
+	out.Offset = in.Offset
+	out.Size = in.Size
+	out.MbrType = in.MbrType
+	out.GptType = in.GptType
+	out.Guid = in.Guid
+	out.DriveLetter = in.DriveLetter
+	out.IsBoot = in.IsBoot
+	out.IsSystem = in.IsSystem
+	out.IsHidden = in.IsHidden
+	out.IsReadOnly = in.IsReadOnly
+	out.IsOffline = in.IsOffline
+	return nil
+}
+
+// Convert_v2alpha1_PartitionInfo_To_impl_PartitionInfo is an autogenerated conversion function.
+func Convert_v2alpha1_PartitionInfo_To_impl_PartitionInfo(in *v2alpha1.PartitionInfo, out *impl.PartitionInfo) error {
+	return autoConvert_v2alpha1_PartitionInfo_To_impl_PartitionInfo(in, out)
+}
+
+func autoConvert_impl_PartitionInfo_To_v2alpha1_PartitionInfo(in *impl.PartitionInfo, out *v2alpha1.PartitionInfo) error {
+	out.DiskNumber = in.DiskNumber
+	out.PartitionNumber = in.PartitionNumber
+	out.Offset = in.Offset
+	out.Size = in.Size
+	out.MbrType = in.MbrType
+	out.GptType = in.GptType
+	out.Guid = in.Guid
+	out.DriveLetter = in.DriveLetter
+	out.IsBoot = in.IsBoot
+	out.IsSystem = in.IsSystem
+	out.IsHidden = in.IsHidden
+	out.IsReadOnly = in.IsReadOnly
+	out.IsOffline = in.IsOffline
+	return nil
+}
+
+// Convert_impl_PartitionInfo_To_v2alpha1_PartitionInfo is an autogenerated conversion function.
+func Convert_impl_PartitionInfo_To_v2alpha1_PartitionInfo(in *impl.PartitionInfo, out *v2alpha1.PartitionInfo) error {
+	return autoConvert_impl_PartitionInfo_To_v2alpha1_PartitionInfo(in, out)
+}
+
+func autoConvert_v2alpha1_RescanRequest_To_impl_RescanRequest(in *v2alpha1.RescanRequest, out *impl.RescanRequest) error {
+	return nil
+}
+
+// Convert_v2alpha1_RescanRequest_To_impl_RescanRequest is an autogenerated conversion function.
+func Convert_v2alpha1_RescanRequest_To_impl_RescanRequest(in *v2alpha1.RescanRequest, out *impl.RescanRequest) error {
+	return autoConvert_v2alpha1_RescanRequest_To_impl_RescanRequest(in, out)
+}
+
+func autoConvert_impl_RescanRequest_To_v2alpha1_RescanRequest(in *impl.RescanRequest, out *v2alpha1.RescanRequest) error {
+	return nil
+}
+
+// Convert_impl_RescanRequest_To_v2alpha1_RescanRequest is an autogenerated conversion function.
+func Convert_impl_RescanRequest_To_v2alpha1_RescanRequest(in *impl.RescanRequest, out *v2alpha1.RescanRequest) error {
+	return autoConvert_impl_RescanRequest_To_v2alpha1_RescanRequest(in, out)
+}
+
+func autoConvert_v2alpha1_RescanResponse_To_impl_RescanResponse(in *v2alpha1.RescanResponse, out *impl.RescanResponse) error {
+	return nil
+}
+
+// Convert_v2alpha1_RescanResponse_To_impl_RescanResponse is an autogenerated conversion function.
+func Convert_v2alpha1_RescanResponse_To_impl_RescanResponse(in *v2alpha1.RescanResponse, out *impl.RescanResponse) error {
+	return autoConvert_v2alpha1_RescanResponse_To_impl_RescanResponse(in, out)
+}
+
+func autoConvert_impl_RescanResponse_To_v2alpha1_RescanResponse(in *impl.RescanResponse, out *v2alpha1.RescanResponse) error {
+	return nil
+}
+
+// Convert_impl_RescanResponse_To_v2alpha1_RescanResponse is an autogenerated conversion function.
+func Convert_impl_RescanResponse_To_v2alpha1_RescanResponse(in *impl.RescanResponse, out *v2alpha1.RescanResponse) error {
+	return autoConvert_impl_RescanResponse_To_v2alpha1_RescanResponse(in, out)
+}
+
+func autoConvert_v2alpha1_SetDiskAttributesRequest_To_impl_SetDiskAttributesRequest(in *v2alpha1.SetDiskAttributesRequest, out *impl.SetDiskAttributesRequest) error {
+	out.DiskNumber = in.DiskNumber
+	out.SetReadOnly = in.SetReadOnly
+	out.IsReadOnly = in.IsReadOnly
+	out.SanPolicy = impl.SanPolicy(in.SanPolicy)
+	return nil
+}
+
+// Convert_v2alpha1_SetDiskAttributesRequest_To_impl_SetDiskAttributesRequest is an autogenerated conversion function.
+func Convert_v2alpha1_SetDiskAttributesRequest_To_impl_SetDiskAttributesRequest(in *v2alpha1.SetDiskAttributesRequest, out *impl.SetDiskAttributesRequest) error {
+	return autoConvert_v2alpha1_SetDiskAttributesRequest_To_impl_SetDiskAttributesRequest(in, out)
+}
+
+func autoConvert_impl_SetDiskAttributesRequest_To_v2alpha1_SetDiskAttributesRequest(in *impl.SetDiskAttributesRequest, out *v2alpha1.SetDiskAttributesRequest) error {
+	out.DiskNumber = in.DiskNumber
+	out.SetReadOnly = in.SetReadOnly
+	out.IsReadOnly = in.IsReadOnly
+	out.SanPolicy = v2alpha1.SanPolicy(in.SanPolicy)
+	return nil
+}
+
+// Convert_impl_SetDiskAttributesRequest_To_v2alpha1_SetDiskAttributesRequest is an autogenerated conversion function.
+func Convert_impl_SetDiskAttributesRequest_To_v2alpha1_SetDiskAttributesRequest(in *impl.SetDiskAttributesRequest, out *v2alpha1.SetDiskAttributesRequest) error {
+	return autoConvert_impl_SetDiskAttributesRequest_To_v2alpha1_SetDiskAttributesRequest(in, out)
+}
+
+func autoConvert_v2alpha1_SetDiskAttributesResponse_To_impl_SetDiskAttributesResponse(in *v2alpha1.SetDiskAttributesResponse, out *impl.SetDiskAttributesResponse) error {
+	if in.Attributes != nil {
+		in, out := &in.Attributes, &out.Attributes
+		*out = new(impl.DiskAttributes)
+		if err := Convert_v2alpha1_DiskAttributes_To_impl_DiskAttributes(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.Attributes = nil
+	}
+	return nil
+}
+
+// Convert_v2alpha1_SetDiskAttributesResponse_To_impl_SetDiskAttributesResponse is an autogenerated conversion function.
+func Convert_v2alpha1_SetDiskAttributesResponse_To_impl_SetDiskAttributesResponse(in *v2alpha1.SetDiskAttributesResponse, out *impl.SetDiskAttributesResponse) error {
+	return autoConvert_v2alpha1_SetDiskAttributesResponse_To_impl_SetDiskAttributesResponse(in, out)
+}
+
+func autoConvert_impl_SetDiskAttributesResponse_To_v2alpha1_SetDiskAttributesResponse(in *impl.SetDiskAttributesResponse, out *v2alpha1.SetDiskAttributesResponse) error {
+	if in.Attributes != nil {
+		in, out := &in.Attributes, &out.Attributes
+		*out = new(v2alpha1.DiskAttributes)
+		if err := Convert_impl_DiskAttributes_To_v2alpha1_DiskAttributes(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.Attributes = nil
+	}
+	return nil
+}
+
+// Convert_impl_SetDiskAttributesResponse_To_v2alpha1_SetDiskAttributesResponse is an autogenerated conversion function.
+func Convert_impl_SetDiskAttributesResponse_To_v2alpha1_SetDiskAttributesResponse(in *impl.SetDiskAttributesResponse, out *v2alpha1.SetDiskAttributesResponse) error {
+	return autoConvert_impl_SetDiskAttributesResponse_To_v2alpha1_SetDiskAttributesResponse(in, out)
+}
+
+func autoConvert_v2alpha1_SetDiskStateRequest_To_impl_SetDiskStateRequest(in *v2alpha1.SetDiskStateRequest, out *impl.SetDiskStateRequest) error {
+	out.DiskNumber = in.DiskNumber
+	out.IsOnline = in.IsOnline
+	return nil
+}
+
+// Convert_v2alpha1_SetDiskStateRequest_To_impl_SetDiskStateRequest is an autogenerated conversion function.
+func Convert_v2alpha1_SetDiskStateRequest_To_impl_SetDiskStateRequest(in *v2alpha1.SetDiskStateRequest, out *impl.SetDiskStateRequest) error {
+	return autoConvert_v2alpha1_SetDiskStateRequest_To_impl_SetDiskStateRequest(in, out)
+}
+
+func autoConvert_impl_SetDiskStateRequest_To_v2alpha1_SetDiskStateRequest(in *impl.SetDiskStateRequest, out *v2alpha1.SetDiskStateRequest) error {
+	out.DiskNumber = in.DiskNumber
+	out.IsOnline = in.IsOnline
+	return nil
+}
+
+// Convert_impl_SetDiskStateRequest_To_v2alpha1_SetDiskStateRequest is an autogenerated conversion function.
+func Convert_impl_SetDiskStateRequest_To_v2alpha1_SetDiskStateRequest(in *impl.SetDiskStateRequest, out *v2alpha1.SetDiskStateRequest) error {
+	return autoConvert_impl_SetDiskStateRequest_To_v2alpha1_SetDiskStateRequest(in, out)
+}
+
+func autoConvert_v2alpha1_SetDiskStateResponse_To_impl_SetDiskStateResponse(in *v2alpha1.SetDiskStateResponse, out *impl.SetDiskStateResponse) error {
+	return nil
+}
+
+// Convert_v2alpha1_SetDiskStateResponse_To_impl_SetDiskStateResponse is an autogenerated conversion function.
+func Convert_v2alpha1_SetDiskStateResponse_To_impl_SetDiskStateResponse(in *v2alpha1.SetDiskStateResponse, out *impl.SetDiskStateResponse) error {
+	return autoConvert_v2alpha1_SetDiskStateResponse_To_impl_SetDiskStateResponse(in, out)
+}
+
+func autoConvert_impl_SetDiskStateResponse_To_v2alpha1_SetDiskStateResponse(in *impl.SetDiskStateResponse, out *v2alpha1.SetDiskStateResponse) error {
+	return nil
+}
+
+// Convert_impl_SetDiskStateResponse_To_v2alpha1_SetDiskStateResponse is an autogenerated conversion function.
+func Convert_impl_SetDiskStateResponse_To_v2alpha1_SetDiskStateResponse(in *impl.SetDiskStateResponse, out *v2alpha1.SetDiskStateResponse) error {
+	return autoConvert_impl_SetDiskStateResponse_To_v2alpha1_SetDiskStateResponse(in, out)
+}
+
+func autoConvert_v2alpha1_WaitForDiskRequest_To_impl_WaitForDiskRequest(in *v2alpha1.WaitForDiskRequest, out *impl.WaitForDiskRequest) error {
+	out.Identifier = in.Identifier
+	out.IdentifierKind = impl.IdentifierKind(in.IdentifierKind)
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = new(impl.DiskLocation)
+		if err := Convert_v2alpha1_DiskLocation_To_impl_DiskLocation(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.Location = nil
+	}
+	out.SetOnline = in.SetOnline
+	return nil
+}
+
+// Convert_v2alpha1_WaitForDiskRequest_To_impl_WaitForDiskRequest is an autogenerated conversion function.
+func Convert_v2alpha1_WaitForDiskRequest_To_impl_WaitForDiskRequest(in *v2alpha1.WaitForDiskRequest, out *impl.WaitForDiskRequest) error {
+	return autoConvert_v2alpha1_WaitForDiskRequest_To_impl_WaitForDiskRequest(in, out)
+}
+
+func autoConvert_impl_WaitForDiskRequest_To_v2alpha1_WaitForDiskRequest(in *impl.WaitForDiskRequest, out *v2alpha1.WaitForDiskRequest) error {
+	out.Identifier = in.Identifier
+	out.IdentifierKind = v2alpha1.IdentifierKind(in.IdentifierKind)
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = new(v2alpha1.DiskLocation)
+		if err := Convert_impl_DiskLocation_To_v2alpha1_DiskLocation(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.Location = nil
+	}
+	out.SetOnline = in.SetOnline
+	return nil
+}
+
+// Convert_impl_WaitForDiskRequest_To_v2alpha1_WaitForDiskRequest is an autogenerated conversion function.
+func Convert_impl_WaitForDiskRequest_To_v2alpha1_WaitForDiskRequest(in *impl.WaitForDiskRequest, out *v2alpha1.WaitForDiskRequest) error {
+	return autoConvert_impl_WaitForDiskRequest_To_v2alpha1_WaitForDiskRequest(in, out)
+}
+
+func autoConvert_v2alpha1_WaitForDiskResponse_To_impl_WaitForDiskResponse(in *v2alpha1.WaitForDiskResponse, out *impl.WaitForDiskResponse) error {
+	out.DiskNumber = in.DiskNumber
+	if in.Disk != nil {
+		in, out := &in.Disk, &out.Disk
+		*out = new(impl.DiskInfo)
+		if err := Convert_v2alpha1_DiskInfo_To_impl_DiskInfo(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.Disk = nil
+	}
+	return nil
+}
+
+// Convert_v2alpha1_WaitForDiskResponse_To_impl_WaitForDiskResponse is an autogenerated conversion function.
+func Convert_v2alpha1_WaitForDiskResponse_To_impl_WaitForDiskResponse(in *v2alpha1.WaitForDiskResponse, out *impl.WaitForDiskResponse) error {
+	return autoConvert_v2alpha1_WaitForDiskResponse_To_impl_WaitForDiskResponse(in, out)
+}
+
+func autoConvert_impl_WaitForDiskResponse_To_v2alpha1_WaitForDiskResponse(in *impl.WaitForDiskResponse, out *v2alpha1.WaitForDiskResponse) error {
+	out.DiskNumber = in.DiskNumber
+	if in.Disk != nil {
+		in, out := &in.Disk, &out.Disk
+		*out = new(v2alpha1.DiskInfo)
+		if err := Convert_impl_DiskInfo_To_v2alpha1_DiskInfo(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.Disk = nil
+	}
+	return nil
+}
+
+// Convert_impl_WaitForDiskResponse_To_v2alpha1_WaitForDiskResponse is an autogenerated conversion function.
+func Convert_impl_WaitForDiskResponse_To_v2alpha1_WaitForDiskResponse(in *impl.WaitForDiskResponse, out *v2alpha1.WaitForDiskResponse) error {
+	return autoConvert_impl_WaitForDiskResponse_To_v2alpha1_WaitForDiskResponse(in, out)
+}
+
+func autoConvert_v2alpha1_WatchDisksRequest_To_impl_WatchDisksRequest(in *v2alpha1.WatchDisksRequest, out *impl.WatchDisksRequest) error {
+	out.PollIntervalSeconds = in.PollIntervalSeconds
+	return nil
+}
+
+// Convert_v2alpha1_WatchDisksRequest_To_impl_WatchDisksRequest is an autogenerated conversion function.
+func Convert_v2alpha1_WatchDisksRequest_To_impl_WatchDisksRequest(in *v2alpha1.WatchDisksRequest, out *impl.WatchDisksRequest) error {
+	return autoConvert_v2alpha1_WatchDisksRequest_To_impl_WatchDisksRequest(in, out)
+}
+
+func autoConvert_impl_WatchDisksRequest_To_v2alpha1_WatchDisksRequest(in *impl.WatchDisksRequest, out *v2alpha1.WatchDisksRequest) error {
+	out.PollIntervalSeconds = in.PollIntervalSeconds
+	return nil
+}
+
+// Convert_impl_WatchDisksRequest_To_v2alpha1_WatchDisksRequest is an autogenerated conversion function.
+func Convert_impl_WatchDisksRequest_To_v2alpha1_WatchDisksRequest(in *impl.WatchDisksRequest, out *v2alpha1.WatchDisksRequest) error {
+	return autoConvert_impl_WatchDisksRequest_To_v2alpha1_WatchDisksRequest(in, out)
+}
+
+func autoConvert_v2alpha1_WatchDisksResponse_To_impl_WatchDisksResponse(in *v2alpha1.WatchDisksResponse, out *impl.WatchDisksResponse) error {
+	out.Type = impl.DiskEventType(in.Type)
+	out.DiskNumber = in.DiskNumber
+	if in.Disk != nil {
+		in, out := &in.Disk, &out.Disk
+		*out = new(impl.DiskInfo)
+		if err := Convert_v2alpha1_DiskInfo_To_impl_DiskInfo(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.Disk = nil
+	}
+	return nil
+}
+
+// Convert_v2alpha1_WatchDisksResponse_To_impl_WatchDisksResponse is an autogenerated conversion function.
+func Convert_v2alpha1_WatchDisksResponse_To_impl_WatchDisksResponse(in *v2alpha1.WatchDisksResponse, out *impl.WatchDisksResponse) error {
+	return autoConvert_v2alpha1_WatchDisksResponse_To_impl_WatchDisksResponse(in, out)
+}
+
+func autoConvert_impl_WatchDisksResponse_To_v2alpha1_WatchDisksResponse(in *impl.WatchDisksResponse, out *v2alpha1.WatchDisksResponse) error {
+	out.Type = v2alpha1.DiskEventType(in.Type)
+	out.DiskNumber = in.DiskNumber
+	if in.Disk != nil {
+		in, out := &in.Disk, &out.Disk
+		*out = new(v2alpha1.DiskInfo)
+		if err := Convert_impl_DiskInfo_To_v2alpha1_DiskInfo(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.Disk = nil
+	}
+	return nil
+}
+
+// Convert_impl_WatchDisksResponse_To_v2alpha1_WatchDisksResponse is an autogenerated conversion function.
+func Convert_impl_WatchDisksResponse_To_v2alpha1_WatchDisksResponse(in *impl.WatchDisksResponse, out *v2alpha1.WatchDisksResponse) error {
+	return autoConvert_impl_WatchDisksResponse_To_v2alpha1_WatchDisksResponse(in, out)
+}
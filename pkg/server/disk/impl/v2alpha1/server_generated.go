@@ -0,0 +1,343 @@
+// Code generated by csi-proxy-api-gen. DO NOT EDIT.
+
+package v2alpha1
+
+import (
+	"context"
+
+	"github.com/kubernetes-csi/csi-proxy/client/api/disk/v2alpha1"
+	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/disk/impl"
+	"google.golang.org/grpc"
+)
+
+var version = apiversion.NewVersionOrPanic("v2alpha1")
+
+type versionedAPI struct {
+	apiGroupServer impl.ServerInterface
+}
+
+func NewVersionedServer(apiGroupServer impl.ServerInterface) impl.VersionedAPI {
+	return &versionedAPI{
+		apiGroupServer: apiGroupServer,
+	}
+}
+
+func (s *versionedAPI) Register(grpcServer *grpc.Server) {
+	v2alpha1.RegisterDiskServer(grpcServer, s)
+}
+
+func (s *versionedAPI) ClearDisk(versionedRequest *v2alpha1.ClearDiskRequest, stream v2alpha1.Disk_ClearDiskServer) error {
+	request := &impl.ClearDiskRequest{}
+	if err := Convert_v2alpha1_ClearDiskRequest_To_impl_ClearDiskRequest(versionedRequest, request); err != nil {
+		return err
+	}
+
+	return s.apiGroupServer.ClearDisk(stream.Context(), request, func(response *impl.ClearDiskResponse) error {
+		versionedResponse := &v2alpha1.ClearDiskResponse{}
+		if err := Convert_impl_ClearDiskResponse_To_v2alpha1_ClearDiskResponse(response, versionedResponse); err != nil {
+			return err
+		}
+		return stream.Send(versionedResponse)
+	}, version)
+}
+
+func (s *versionedAPI) DeletePartition(context context.Context, versionedRequest *v2alpha1.DeletePartitionRequest) (*v2alpha1.DeletePartitionResponse, error) {
+	request := &impl.DeletePartitionRequest{}
+	if err := Convert_v2alpha1_DeletePartitionRequest_To_impl_DeletePartitionRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.DeletePartition(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.DeletePartitionResponse{}
+	if err := Convert_impl_DeletePartitionResponse_To_v2alpha1_DeletePartitionResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) FindDisk(context context.Context, versionedRequest *v2alpha1.FindDiskRequest) (*v2alpha1.FindDiskResponse, error) {
+	request := &impl.FindDiskRequest{}
+	if err := Convert_v2alpha1_FindDiskRequest_To_impl_FindDiskRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.FindDisk(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.FindDiskResponse{}
+	if err := Convert_impl_FindDiskResponse_To_v2alpha1_FindDiskResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) GetDiskAttributes(context context.Context, versionedRequest *v2alpha1.GetDiskAttributesRequest) (*v2alpha1.GetDiskAttributesResponse, error) {
+	request := &impl.GetDiskAttributesRequest{}
+	if err := Convert_v2alpha1_GetDiskAttributesRequest_To_impl_GetDiskAttributesRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.GetDiskAttributes(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.GetDiskAttributesResponse{}
+	if err := Convert_impl_GetDiskAttributesResponse_To_v2alpha1_GetDiskAttributesResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) GetDiskInfo(context context.Context, versionedRequest *v2alpha1.GetDiskInfoRequest) (*v2alpha1.GetDiskInfoResponse, error) {
+	request := &impl.GetDiskInfoRequest{}
+	if err := Convert_v2alpha1_GetDiskInfoRequest_To_impl_GetDiskInfoRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.GetDiskInfo(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.GetDiskInfoResponse{}
+	if err := Convert_impl_GetDiskInfoResponse_To_v2alpha1_GetDiskInfoResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) GetDiskState(context context.Context, versionedRequest *v2alpha1.GetDiskStateRequest) (*v2alpha1.GetDiskStateResponse, error) {
+	request := &impl.GetDiskStateRequest{}
+	if err := Convert_v2alpha1_GetDiskStateRequest_To_impl_GetDiskStateRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.GetDiskState(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.GetDiskStateResponse{}
+	if err := Convert_impl_GetDiskStateResponse_To_v2alpha1_GetDiskStateResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) GetDiskStats(context context.Context, versionedRequest *v2alpha1.GetDiskStatsRequest) (*v2alpha1.GetDiskStatsResponse, error) {
+	request := &impl.GetDiskStatsRequest{}
+	if err := Convert_v2alpha1_GetDiskStatsRequest_To_impl_GetDiskStatsRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.GetDiskStats(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.GetDiskStatsResponse{}
+	if err := Convert_impl_GetDiskStatsResponse_To_v2alpha1_GetDiskStatsResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) ListDiskIDs(context context.Context, versionedRequest *v2alpha1.ListDiskIDsRequest) (*v2alpha1.ListDiskIDsResponse, error) {
+	request := &impl.ListDiskIDsRequest{}
+	if err := Convert_v2alpha1_ListDiskIDsRequest_To_impl_ListDiskIDsRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.ListDiskIDs(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.ListDiskIDsResponse{}
+	if err := Convert_impl_ListDiskIDsResponse_To_v2alpha1_ListDiskIDsResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) ListDiskLocations(context context.Context, versionedRequest *v2alpha1.ListDiskLocationsRequest) (*v2alpha1.ListDiskLocationsResponse, error) {
+	request := &impl.ListDiskLocationsRequest{}
+	if err := Convert_v2alpha1_ListDiskLocationsRequest_To_impl_ListDiskLocationsRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.ListDiskLocations(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.ListDiskLocationsResponse{}
+	if err := Convert_impl_ListDiskLocationsResponse_To_v2alpha1_ListDiskLocationsResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) ListDisks(context context.Context, versionedRequest *v2alpha1.ListDisksRequest) (*v2alpha1.ListDisksResponse, error) {
+	request := &impl.ListDisksRequest{}
+	if err := Convert_v2alpha1_ListDisksRequest_To_impl_ListDisksRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.ListDisks(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.ListDisksResponse{}
+	if err := Convert_impl_ListDisksResponse_To_v2alpha1_ListDisksResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) ListPartitions(context context.Context, versionedRequest *v2alpha1.ListPartitionsRequest) (*v2alpha1.ListPartitionsResponse, error) {
+	request := &impl.ListPartitionsRequest{}
+	if err := Convert_v2alpha1_ListPartitionsRequest_To_impl_ListPartitionsRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.ListPartitions(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.ListPartitionsResponse{}
+	if err := Convert_impl_ListPartitionsResponse_To_v2alpha1_ListPartitionsResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) PartitionDisk(context context.Context, versionedRequest *v2alpha1.PartitionDiskRequest) (*v2alpha1.PartitionDiskResponse, error) {
+	request := &impl.PartitionDiskRequest{}
+	if err := Convert_v2alpha1_PartitionDiskRequest_To_impl_PartitionDiskRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.PartitionDisk(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.PartitionDiskResponse{}
+	if err := Convert_impl_PartitionDiskResponse_To_v2alpha1_PartitionDiskResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) Rescan(context context.Context, versionedRequest *v2alpha1.RescanRequest) (*v2alpha1.RescanResponse, error) {
+	request := &impl.RescanRequest{}
+	if err := Convert_v2alpha1_RescanRequest_To_impl_RescanRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.Rescan(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.RescanResponse{}
+	if err := Convert_impl_RescanResponse_To_v2alpha1_RescanResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) SetDiskAttributes(context context.Context, versionedRequest *v2alpha1.SetDiskAttributesRequest) (*v2alpha1.SetDiskAttributesResponse, error) {
+	request := &impl.SetDiskAttributesRequest{}
+	if err := Convert_v2alpha1_SetDiskAttributesRequest_To_impl_SetDiskAttributesRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.SetDiskAttributes(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.SetDiskAttributesResponse{}
+	if err := Convert_impl_SetDiskAttributesResponse_To_v2alpha1_SetDiskAttributesResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) SetDiskState(context context.Context, versionedRequest *v2alpha1.SetDiskStateRequest) (*v2alpha1.SetDiskStateResponse, error) {
+	request := &impl.SetDiskStateRequest{}
+	if err := Convert_v2alpha1_SetDiskStateRequest_To_impl_SetDiskStateRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.SetDiskState(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.SetDiskStateResponse{}
+	if err := Convert_impl_SetDiskStateResponse_To_v2alpha1_SetDiskStateResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) WaitForDisk(context context.Context, versionedRequest *v2alpha1.WaitForDiskRequest) (*v2alpha1.WaitForDiskResponse, error) {
+	request := &impl.WaitForDiskRequest{}
+	if err := Convert_v2alpha1_WaitForDiskRequest_To_impl_WaitForDiskRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.WaitForDisk(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.WaitForDiskResponse{}
+	if err := Convert_impl_WaitForDiskResponse_To_v2alpha1_WaitForDiskResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) WatchDisks(versionedRequest *v2alpha1.WatchDisksRequest, stream v2alpha1.Disk_WatchDisksServer) error {
+	request := &impl.WatchDisksRequest{}
+	if err := Convert_v2alpha1_WatchDisksRequest_To_impl_WatchDisksRequest(versionedRequest, request); err != nil {
+		return err
+	}
+
+	return s.apiGroupServer.WatchDisks(stream.Context(), request, func(response *impl.WatchDisksResponse) error {
+		versionedResponse := &v2alpha1.WatchDisksResponse{}
+		if err := Convert_impl_WatchDisksResponse_To_v2alpha1_WatchDisksResponse(response, versionedResponse); err != nil {
+			return err
+		}
+		return stream.Send(versionedResponse)
+	}, version)
+}
@@ -0,0 +1,125 @@
+package disk
+
+import (
+	"time"
+
+	"github.com/kubernetes-csi/csi-proxy/pkg/os/disk"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/metrics"
+	shared "github.com/kubernetes-csi/csi-proxy/pkg/shared/disk"
+)
+
+// instrumentedAPI records the duration and the errors of the calls to the disk host API.
+type instrumentedAPI struct {
+	hostAPI disk.API
+}
+
+// NewInstrumentedAPI returns a disk.API recording the duration and the errors of the calls to hostAPI
+// in the host API metrics.
+func NewInstrumentedAPI(hostAPI disk.API) disk.API {
+	return &instrumentedAPI{hostAPI: hostAPI}
+}
+
+func (i *instrumentedAPI) ListDiskLocations() (_ map[uint32]shared.DiskLocation, err error) {
+	defer metrics.ObserveHostAPICall("disk", "ListDiskLocations", time.Now(), &err)
+	return i.hostAPI.ListDiskLocations()
+}
+
+func (i *instrumentedAPI) IsDiskInitialized(diskNumber uint32) (_ bool, err error) {
+	defer metrics.ObserveHostAPICall("disk", "IsDiskInitialized", time.Now(), &err)
+	return i.hostAPI.IsDiskInitialized(diskNumber)
+}
+
+func (i *instrumentedAPI) InitializeDisk(diskNumber uint32, partitionStyle uint16) (err error) {
+	defer metrics.ObserveHostAPICall("disk", "InitializeDisk", time.Now(), &err)
+	return i.hostAPI.InitializeDisk(diskNumber, partitionStyle)
+}
+
+func (i *instrumentedAPI) ListPartitions(diskNumber uint32) (_ []shared.PartitionInfo, err error) {
+	defer metrics.ObserveHostAPICall("disk", "ListPartitions", time.Now(), &err)
+	return i.hostAPI.ListPartitions(diskNumber)
+}
+
+func (i *instrumentedAPI) CreatePartition(diskNumber uint32, options shared.PartitionOptions) (_ uint32, err error) {
+	defer metrics.ObserveHostAPICall("disk", "CreatePartition", time.Now(), &err)
+	return i.hostAPI.CreatePartition(diskNumber, options)
+}
+
+func (i *instrumentedAPI) DeletePartition(diskNumber, partitionNumber uint32) (err error) {
+	defer metrics.ObserveHostAPICall("disk", "DeletePartition", time.Now(), &err)
+	return i.hostAPI.DeletePartition(diskNumber, partitionNumber)
+}
+
+func (i *instrumentedAPI) Rescan() (err error) {
+	defer metrics.ObserveHostAPICall("disk", "Rescan", time.Now(), &err)
+	return i.hostAPI.Rescan()
+}
+
+func (i *instrumentedAPI) GetDiskNumberByName(page83ID string) (_ uint32, err error) {
+	defer metrics.ObserveHostAPICall("disk", "GetDiskNumberByName", time.Now(), &err)
+	return i.hostAPI.GetDiskNumberByName(page83ID)
+}
+
+func (i *instrumentedAPI) ListDiskIDs() (_ map[uint32]shared.DiskIDs, err error) {
+	defer metrics.ObserveHostAPICall("disk", "ListDiskIDs", time.Now(), &err)
+	return i.hostAPI.ListDiskIDs()
+}
+
+func (i *instrumentedAPI) GetDiskStats(diskNumber uint32) (_ int64, err error) {
+	defer metrics.ObserveHostAPICall("disk", "GetDiskStats", time.Now(), &err)
+	return i.hostAPI.GetDiskStats(diskNumber)
+}
+
+func (i *instrumentedAPI) SetDiskState(diskNumber uint32, isOnline bool) (err error) {
+	defer metrics.ObserveHostAPICall("disk", "SetDiskState", time.Now(), &err)
+	return i.hostAPI.SetDiskState(diskNumber, isOnline)
+}
+
+func (i *instrumentedAPI) GetDiskState(diskNumber uint32) (_ bool, err error) {
+	defer metrics.ObserveHostAPICall("disk", "GetDiskState", time.Now(), &err)
+	return i.hostAPI.GetDiskState(diskNumber)
+}
+
+func (i *instrumentedAPI) ClearDisk(diskNumber uint32, removeOEM bool) (err error) {
+	defer metrics.ObserveHostAPICall("disk", "ClearDisk", time.Now(), &err)
+	return i.hostAPI.ClearDisk(diskNumber, removeOEM)
+}
+
+func (i *instrumentedAPI) ZeroDisk(diskNumber uint32, offset, length uint64, progress func(zeroed uint64) error) (err error) {
+	defer metrics.ObserveHostAPICall("disk", "ZeroDisk", time.Now(), &err)
+	return i.hostAPI.ZeroDisk(diskNumber, offset, length, progress)
+}
+
+func (i *instrumentedAPI) SetDiskReadOnly(diskNumber uint32, isReadOnly bool) (err error) {
+	defer metrics.ObserveHostAPICall("disk", "SetDiskReadOnly", time.Now(), &err)
+	return i.hostAPI.SetDiskReadOnly(diskNumber, isReadOnly)
+}
+
+func (i *instrumentedAPI) GetSANPolicy() (_ uint16, err error) {
+	defer metrics.ObserveHostAPICall("disk", "GetSANPolicy", time.Now(), &err)
+	return i.hostAPI.GetSANPolicy()
+}
+
+func (i *instrumentedAPI) SetSANPolicy(policy uint16) (err error) {
+	defer metrics.ObserveHostAPICall("disk", "SetSANPolicy", time.Now(), &err)
+	return i.hostAPI.SetSANPolicy(policy)
+}
+
+func (i *instrumentedAPI) ListDisks() (_ map[uint32]shared.DiskInfo, err error) {
+	defer metrics.ObserveHostAPICall("disk", "ListDisks", time.Now(), &err)
+	return i.hostAPI.ListDisks()
+}
+
+func (i *instrumentedAPI) GetDiskInfo(diskNumber uint32) (_ *shared.DiskInfo, err error) {
+	defer metrics.ObserveHostAPICall("disk", "GetDiskInfo", time.Now(), &err)
+	return i.hostAPI.GetDiskInfo(diskNumber)
+}
+
+func (i *instrumentedAPI) ListPageFileDisks() (_ []uint32, err error) {
+	defer metrics.ObserveHostAPICall("disk", "ListPageFileDisks", time.Now(), &err)
+	return i.hostAPI.ListPageFileDisks()
+}
+
+func (i *instrumentedAPI) CheckHealth() (err error) {
+	defer metrics.ObserveHostAPICall("disk", "CheckHealth", time.Now(), &err)
+	return i.hostAPI.CheckHealth()
+}
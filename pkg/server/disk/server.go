@@ -3,28 +3,64 @@ package disk
 import (
 	"context"
 	"fmt"
+	"math"
+	"sort"
 	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf16"
 
 	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
 	"github.com/kubernetes-csi/csi-proxy/pkg/os/disk"
 	internal "github.com/kubernetes-csi/csi-proxy/pkg/server/disk/impl"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/guard"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/locks"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/tracing"
+	shared "github.com/kubernetes-csi/csi-proxy/pkg/shared/disk"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"k8s.io/klog/v2"
 )
 
 type Server struct {
 	hostAPI disk.API
+	locks   *locks.Manager
+	guard   *guard.Guard
+	// shutdown is closed when the server stops, to end the WatchDisks streams.
+	shutdown     chan struct{}
+	shutdownOnce sync.Once
 }
 
 // check that Server implements internal.ServerInterface
 var _ internal.ServerInterface = &Server{}
 
-func NewServer(hostAPI disk.API) (*Server, error) {
+// NewServer returns a Server serializing its mutating operations on the same disk with lockManager,
+// and refusing the destructive ones on the disks diskGuard protects.
+func NewServer(hostAPI disk.API, lockManager *locks.Manager, diskGuard *guard.Guard) (*Server, error) {
 	return &Server{
-		hostAPI: hostAPI,
+		hostAPI:  hostAPI,
+		locks:    lockManager,
+		guard:    diskGuard,
+		shutdown: make(chan struct{}),
 	}, nil
 }
 
+// Shutdown ends the WatchDisks streams, which would otherwise only end when their clients cancel them.
+func (s *Server) Shutdown() {
+	s.shutdownOnce.Do(func() {
+		close(s.shutdown)
+	})
+}
+
+// CheckHealth returns an error if the disk host API is unavailable, e.g. because its WMI provider
+// is down. All the versions share the host API, their health is the same.
+func (s *Server) CheckHealth(version apiversion.Version) error {
+	return s.hostAPI.CheckHealth()
+}
+
 func (s *Server) ListDiskLocations(context context.Context, request *internal.ListDiskLocationsRequest, version apiversion.Version) (*internal.ListDiskLocationsResponse, error) {
+	defer tracing.StartHostAPISpan(context, "disk", "ListDiskLocations")()
 	klog.V(2).Infof("Request: ListDiskLocations: %+v", request)
 	response := &internal.ListDiskLocationsResponse{}
 	m, err := s.hostAPI.ListDiskLocations()
@@ -46,46 +82,283 @@ func (s *Server) ListDiskLocations(context context.Context, request *internal.Li
 }
 
 func (s *Server) PartitionDisk(context context.Context, request *internal.PartitionDiskRequest, version apiversion.Version) (*internal.PartitionDiskResponse, error) {
-	klog.V(2).Infof("Request: PartitionDisk with diskNumber=%d", request.DiskNumber)
+	defer tracing.StartHostAPISpan(context, "disk", "PartitionDisk")()
+	klog.V(2).Infof("Request: PartitionDisk: %+v", request)
 	response := &internal.PartitionDiskResponse{}
 	diskNumber := request.DiskNumber
 
+	if err := validatePartitionDiskRequest(request); err != nil {
+		klog.Errorf("PartitionDisk failed: %v", err)
+		return response, err
+	}
+
+	release, err := s.locks.TryAcquire(locks.DiskResource(diskNumber), "PartitionDisk")
+	if err != nil {
+		klog.Errorf("PartitionDisk failed: %v", err)
+		return response, err
+	}
+	defer release()
+
+	if err := s.guard.CheckDisk(diskNumber, "PartitionDisk"); err != nil {
+		klog.Errorf("PartitionDisk failed: %v", err)
+		return response, err
+	}
+
 	initialized, err := s.hostAPI.IsDiskInitialized(diskNumber)
 	if err != nil {
 		klog.Errorf("IsDiskInitialized failed: %v", err)
 		return response, err
 	}
 	if !initialized {
-		klog.V(4).Infof("Initializing disk %d", diskNumber)
-		err = s.hostAPI.InitializeDisk(diskNumber)
+		partitionStyle := request.PartitionStyle
+		if partitionStyle == internal.PartitionStyleUnknown {
+			partitionStyle = internal.PartitionStyleGPT
+		}
+		klog.V(4).Infof("Initializing disk %d with partition style %d", diskNumber, partitionStyle)
+		err = s.hostAPI.InitializeDisk(diskNumber, uint16(partitionStyle))
 		if err != nil {
 			klog.Errorf("failed InitializeDisk %v", err)
 			return response, err
 		}
 	} else {
 		klog.V(4).Infof("Disk %d already initialized", diskNumber)
+		if request.PartitionStyle != internal.PartitionStyleUnknown {
+			info, err := s.hostAPI.GetDiskInfo(diskNumber)
+			if err != nil {
+				klog.Errorf("failed GetDiskInfo %v", err)
+				return response, err
+			}
+			if internal.PartitionStyle(info.PartitionStyle) != request.PartitionStyle {
+				err = status.Errorf(codes.FailedPrecondition, "disk %d is already initialized with partition style %d", diskNumber, info.PartitionStyle)
+				klog.Errorf("PartitionDisk failed: %v", err)
+				return response, err
+			}
+		}
 	}
 
-	klog.V(4).Infof("Checking if disk %d has basic partitions", diskNumber)
-	partitioned, err := s.hostAPI.BasicPartitionsExist(diskNumber)
+	klog.V(4).Infof("Checking the partitions of disk %d", diskNumber)
+	partitions, err := s.hostAPI.ListPartitions(diskNumber)
 	if err != nil {
-		klog.Errorf("failed check BasicPartitionsExist %v", err)
+		klog.Errorf("failed ListPartitions %v", err)
 		return response, err
 	}
-	if !partitioned {
-		klog.V(4).Infof("Creating basic partition on disk %d", diskNumber)
-		err = s.hostAPI.CreateBasicPartition(diskNumber)
-		if err != nil {
-			klog.Errorf("failed CreateBasicPartition %v", err)
-			return response, err
+	for _, partition := range partitions {
+		// without append, any basic partition makes the disk partitioned already, with append only
+		// the partition created at the same offset by a previous attempt of the request
+		if (!request.Append && !partition.IsReserved) || (request.Append && request.Offset > 0 && partition.Offset == request.Offset) {
+			klog.V(4).Infof("Disk %d already partitioned, partition %d", diskNumber, partition.PartitionNumber)
+			response.PartitionNumber = partition.PartitionNumber
+			return response, nil
 		}
-	} else {
-		klog.V(4).Infof("Disk %d already partitioned", diskNumber)
 	}
+
+	klog.V(4).Infof("Creating partition on disk %d", diskNumber)
+	partitionNumber, err := s.hostAPI.CreatePartition(diskNumber, shared.PartitionOptions{
+		Size:      request.Size,
+		Offset:    request.Offset,
+		Alignment: request.Alignment,
+		MbrType:   uint16(request.MbrType),
+		GptType:   request.GptType,
+		Name:      request.Name,
+	})
+	if err != nil {
+		klog.Errorf("failed CreatePartition %v", err)
+		return response, err
+	}
+	response.PartitionNumber = partitionNumber
+	return response, nil
+}
+
+// maxPartitionNameLength is the number of UTF-16 characters of a GPT partition name.
+const maxPartitionNameLength = 36
+
+// validatePartitionDiskRequest returns an InvalidArgument status error if the partition options of request
+// contradict each other.
+func validatePartitionDiskRequest(request *internal.PartitionDiskRequest) error {
+	switch request.PartitionStyle {
+	case internal.PartitionStyleUnknown, internal.PartitionStyleGPT:
+		if request.MbrType != 0 {
+			return status.Errorf(codes.InvalidArgument, "MBR partition type %d requires the MBR partition style", request.MbrType)
+		}
+	case internal.PartitionStyleMBR:
+		if request.GptType != "" || request.Name != "" {
+			return status.Error(codes.InvalidArgument, "GPT partition types and names require the GPT partition style")
+		}
+	default:
+		return status.Errorf(codes.InvalidArgument, "invalid partition style %d", request.PartitionStyle)
+	}
+	if request.MbrType > math.MaxUint8 {
+		return status.Errorf(codes.InvalidArgument, "invalid MBR partition type %d", request.MbrType)
+	}
+	if len(utf16.Encode([]rune(request.Name))) > maxPartitionNameLength {
+		return status.Errorf(codes.InvalidArgument, "partition name %q is longer than %d characters", request.Name, maxPartitionNameLength)
+	}
+	return nil
+}
+
+func (s *Server) ListPartitions(context context.Context, request *internal.ListPartitionsRequest, version apiversion.Version) (*internal.ListPartitionsResponse, error) {
+	defer tracing.StartHostAPISpan(context, "disk", "ListPartitions")()
+	klog.V(4).Infof("Request: ListPartitions: diskNumber=%d", request.DiskNumber)
+	partitions, err := s.hostAPI.ListPartitions(request.DiskNumber)
+	if err != nil {
+		klog.Errorf("ListPartitions failed: %v", err)
+		return nil, err
+	}
+
+	response := &internal.ListPartitionsResponse{Partitions: make([]*internal.PartitionInfo, 0, len(partitions))}
+	for _, partition := range partitions {
+		response.Partitions = append(response.Partitions, &internal.PartitionInfo{
+			DiskNumber:      partition.DiskNumber,
+			PartitionNumber: partition.PartitionNumber,
+			Offset:          partition.Offset,
+			Size:            partition.Size,
+			MbrType:         uint32(partition.MbrType),
+			GptType:         partition.GptType,
+			Guid:            partition.GUID,
+			DriveLetter:     partition.DriveLetter,
+			IsBoot:          partition.IsBoot,
+			IsSystem:        partition.IsSystem,
+			IsHidden:        partition.IsHidden,
+			IsReadOnly:      partition.IsReadOnly,
+			IsOffline:       partition.IsOffline,
+		})
+	}
+	sort.Slice(response.Partitions, func(i, j int) bool {
+		return response.Partitions[i].PartitionNumber < response.Partitions[j].PartitionNumber
+	})
 	return response, nil
 }
 
+func (s *Server) DeletePartition(context context.Context, request *internal.DeletePartitionRequest, version apiversion.Version) (*internal.DeletePartitionResponse, error) {
+	defer tracing.StartHostAPISpan(context, "disk", "DeletePartition")()
+	klog.V(2).Infof("Request: DeletePartition: diskNumber=%d, partitionNumber=%d", request.DiskNumber, request.PartitionNumber)
+	response := &internal.DeletePartitionResponse{}
+	diskNumber := request.DiskNumber
+
+	release, err := s.locks.TryAcquire(locks.DiskResource(diskNumber), "DeletePartition")
+	if err != nil {
+		klog.Errorf("DeletePartition failed: %v", err)
+		return response, err
+	}
+	defer release()
+
+	if err := s.guard.CheckDisk(diskNumber, "DeletePartition"); err != nil {
+		klog.Errorf("DeletePartition failed: %v", err)
+		return response, err
+	}
+
+	err = s.hostAPI.DeletePartition(diskNumber, request.PartitionNumber)
+	if err != nil {
+		klog.Errorf("DeletePartition failed: %v", err)
+		return response, err
+	}
+	return response, nil
+}
+
+var (
+	// clearDiskZeroMegabytes is the size ClearDisk zeroes at the beginning and the end of a disk
+	// with ZeroModeEdges, when the request doesn't set one.
+	clearDiskZeroMegabytes uint32 = 32
+	// clearDiskProgressInterval is the minimum interval between the progress responses of ClearDisk.
+	clearDiskProgressInterval = time.Second
+)
+
+func (s *Server) ClearDisk(ctx context.Context, request *internal.ClearDiskRequest, send func(*internal.ClearDiskResponse) error, version apiversion.Version) error {
+	defer tracing.StartHostAPISpan(ctx, "disk", "ClearDisk")()
+	klog.V(2).Infof("Request: ClearDisk: %+v", request)
+	diskNumber := request.DiskNumber
+	if request.ZeroMode > internal.ZeroModeEntire {
+		return status.Errorf(codes.InvalidArgument, "unknown zero mode %d", request.ZeroMode)
+	}
+
+	release, err := s.locks.TryAcquire(locks.DiskResource(diskNumber), "ClearDisk")
+	if err != nil {
+		klog.Errorf("ClearDisk failed: %v", err)
+		return err
+	}
+	defer release()
+
+	if err := s.guard.CheckDisk(diskNumber, "ClearDisk"); err != nil {
+		klog.Errorf("ClearDisk failed: %v", err)
+		return err
+	}
+
+	info, err := s.hostAPI.GetDiskInfo(diskNumber)
+	if err != nil {
+		klog.Errorf("ClearDisk failed: %v", err)
+		return err
+	}
+	if info.IsOffline || info.IsReadOnly {
+		err := status.Errorf(codes.FailedPrecondition, "disk %d must be online and writable to be cleared (offline: %v, read only: %v)", diskNumber, info.IsOffline, info.IsReadOnly)
+		klog.Errorf("ClearDisk failed: %v", err)
+		return err
+	}
+
+	ranges := zeroRanges(info.Size, request)
+	var bytesToZero uint64
+	for _, r := range ranges {
+		bytesToZero += r.length
+	}
+
+	if err := send(&internal.ClearDiskResponse{Stage: internal.ClearDiskStageClearing, BytesToZero: bytesToZero}); err != nil {
+		return err
+	}
+	if err := s.hostAPI.ClearDisk(diskNumber, request.RemoveOem); err != nil {
+		klog.Errorf("ClearDisk failed: %v", err)
+		return err
+	}
+
+	var bytesZeroed uint64
+	lastProgress := time.Now()
+	for _, r := range ranges {
+		err := s.hostAPI.ZeroDisk(diskNumber, r.offset, r.length, func(zeroed uint64) error {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if time.Since(lastProgress) < clearDiskProgressInterval {
+				return nil
+			}
+			lastProgress = time.Now()
+			return send(&internal.ClearDiskResponse{Stage: internal.ClearDiskStageZeroing, BytesZeroed: bytesZeroed + zeroed, BytesToZero: bytesToZero})
+		})
+		if err != nil {
+			klog.Errorf("ClearDisk failed: %v", err)
+			return err
+		}
+		bytesZeroed += r.length
+	}
+
+	klog.V(2).Infof("ClearDisk cleared disk %d, zeroed %d bytes", diskNumber, bytesZeroed)
+	return send(&internal.ClearDiskResponse{Stage: internal.ClearDiskStageDone, BytesZeroed: bytesZeroed, BytesToZero: bytesToZero})
+}
+
+// diskRange is a range of bytes of a disk.
+type diskRange struct {
+	offset, length uint64
+}
+
+// zeroRanges returns the ranges of a disk of the given size ClearDisk zeroes for request.
+func zeroRanges(size uint64, request *internal.ClearDiskRequest) []diskRange {
+	switch request.ZeroMode {
+	case internal.ZeroModeEdges:
+		megabytes := request.ZeroMegabytes
+		if megabytes == 0 {
+			megabytes = clearDiskZeroMegabytes
+		}
+		edge := uint64(megabytes) * 1024 * 1024
+		if 2*edge >= size {
+			return []diskRange{{0, size}}
+		}
+		return []diskRange{{0, edge}, {size - edge, edge}}
+	case internal.ZeroModeEntire:
+		return []diskRange{{0, size}}
+	default:
+		return nil
+	}
+}
+
 func (s *Server) Rescan(context context.Context, request *internal.RescanRequest, version apiversion.Version) (*internal.RescanResponse, error) {
+	defer tracing.StartHostAPISpan(context, "disk", "Rescan")()
 	klog.V(2).Infof("Request: Rescan")
 	response := &internal.RescanResponse{}
 	err := s.hostAPI.Rescan()
@@ -97,6 +370,7 @@ func (s *Server) Rescan(context context.Context, request *internal.RescanRequest
 }
 
 func (s *Server) GetDiskNumberByName(context context.Context, request *internal.GetDiskNumberByNameRequest, version apiversion.Version) (*internal.GetDiskNumberByNameResponse, error) {
+	defer tracing.StartHostAPISpan(context, "disk", "GetDiskNumberByName")()
 	klog.V(4).Infof("Request: GetDiskNumberByName with diskName %q", request.DiskName)
 	response := &internal.GetDiskNumberByNameResponse{}
 	diskName := request.DiskName
@@ -110,6 +384,7 @@ func (s *Server) GetDiskNumberByName(context context.Context, request *internal.
 }
 
 func (s *Server) ListDiskIDs(context context.Context, request *internal.ListDiskIDsRequest, version apiversion.Version) (*internal.ListDiskIDsResponse, error) {
+	defer tracing.StartHostAPISpan(context, "disk", "ListDiskIDs")()
 	klog.V(4).Infof("Request: ListDiskIDs")
 	minimumVersion := apiversion.NewVersionOrPanic("v1beta1")
 	if version.Compare(minimumVersion) < 0 {
@@ -125,16 +400,269 @@ func (s *Server) ListDiskIDs(context context.Context, request *internal.ListDisk
 	// Convert from shared to internal type
 	responseDiskIDs := make(map[uint32]*internal.DiskIDs)
 	for k, v := range diskIDs {
-		responseDiskIDs[k] = &internal.DiskIDs{
-			Page83:       v.Page83,
-			SerialNumber: v.SerialNumber,
-		}
+		responseDiskIDs[k] = toInternalDiskIDs(v)
 	}
 	response := &internal.ListDiskIDsResponse{DiskIDs: responseDiskIDs}
 	klog.V(5).Infof("Response=%v", response)
 	return response, nil
 }
 
+func toInternalDiskIDs(ids shared.DiskIDs) *internal.DiskIDs {
+	identifiers := make([]*internal.DiskIdentifier, 0, len(ids.Identifiers))
+	for _, identifier := range ids.Identifiers {
+		identifiers = append(identifiers, &internal.DiskIdentifier{
+			Type:        internal.IdentifierType(identifier.Type),
+			Association: internal.IdentifierAssociation(identifier.Association),
+			Value:       identifier.Value,
+		})
+	}
+	return &internal.DiskIDs{
+		Page83:             ids.Page83,
+		SerialNumber:       ids.SerialNumber,
+		DeviceSerialNumber: ids.DeviceSerialNumber,
+		Identifiers:        identifiers,
+	}
+}
+
+func (s *Server) FindDisk(context context.Context, request *internal.FindDiskRequest, version apiversion.Version) (*internal.FindDiskResponse, error) {
+	defer tracing.StartHostAPISpan(context, "disk", "FindDisk")()
+	klog.V(4).Infof("Request: FindDisk: %+v", request)
+	identifier := strings.TrimSpace(request.Identifier)
+	if err := validateDiskSelector(identifier, request.IdentifierKind, request.Location); err != nil {
+		return nil, err
+	}
+
+	diskNumber, err := s.findDisk(identifier, request.IdentifierKind, request.Location)
+	if err != nil {
+		klog.Errorf("FindDisk failed: %v", err)
+		return nil, err
+	}
+	return &internal.FindDiskResponse{DiskNumber: diskNumber}, nil
+}
+
+// validateDiskSelector returns an InvalidArgument status error if the disk selector of a FindDisk or
+// WaitForDisk request selects nothing.
+func validateDiskSelector(identifier string, kind internal.IdentifierKind, location *internal.DiskLocation) error {
+	if identifier == "" && location == nil {
+		return status.Error(codes.InvalidArgument, "an identifier or a location is required")
+	}
+	if kind > internal.IdentifierKindSCSINameString {
+		return status.Errorf(codes.InvalidArgument, "invalid identifier kind %d", kind)
+	}
+	return nil
+}
+
+// findDisk returns the number of the only disk matching identifier of kind, if not empty, and location,
+// if not nil, a NotFound status error if there is none and a FailedPrecondition one if there are several.
+func (s *Server) findDisk(identifier string, kind internal.IdentifierKind, location *internal.DiskLocation) (uint32, error) {
+	var diskIDs map[uint32]shared.DiskIDs
+	var diskLocations map[uint32]shared.DiskLocation
+	var err error
+	if identifier != "" {
+		diskIDs, err = s.hostAPI.ListDiskIDs()
+		if err != nil {
+			return 0, err
+		}
+	}
+	if location != nil {
+		diskLocations, err = s.hostAPI.ListDiskLocations()
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	var matches []uint32
+	for _, diskNumber := range diskNumbers(diskIDs, diskLocations) {
+		if identifier != "" && !matchesIdentifier(diskIDs[diskNumber], kind, identifier) {
+			continue
+		}
+		if location != nil {
+			diskLocation, ok := diskLocations[diskNumber]
+			if !ok || !matchesLocation(diskLocation, location) {
+				continue
+			}
+		}
+		matches = append(matches, diskNumber)
+	}
+
+	switch len(matches) {
+	case 0:
+		return 0, status.Errorf(codes.NotFound, "no disk matches identifier %q and location %+v", identifier, location)
+	case 1:
+		return matches[0], nil
+	default:
+		return 0, status.Errorf(codes.FailedPrecondition, "disks %v match identifier %q and location %+v", matches, identifier, location)
+	}
+}
+
+var (
+	// waitForDiskTimeout bounds WaitForDisk when its call has no deadline.
+	waitForDiskTimeout = 2 * time.Minute
+	// waitForDiskPollInterval is the interval between the lookups of WaitForDisk.
+	waitForDiskPollInterval = time.Second
+	// waitForDiskRescanInterval is the interval between the rescans of WaitForDisk, which are more
+	// expensive than the lookups.
+	waitForDiskRescanInterval = 10 * time.Second
+)
+
+func (s *Server) WaitForDisk(ctx context.Context, request *internal.WaitForDiskRequest, version apiversion.Version) (*internal.WaitForDiskResponse, error) {
+	defer tracing.StartHostAPISpan(ctx, "disk", "WaitForDisk")()
+	klog.V(2).Infof("Request: WaitForDisk: %+v", request)
+	identifier := strings.TrimSpace(request.Identifier)
+	if err := validateDiskSelector(identifier, request.IdentifierKind, request.Location); err != nil {
+		return nil, err
+	}
+
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, waitForDiskTimeout)
+		defer cancel()
+	}
+
+	var lastRescan time.Time
+	var lastErr error
+	for {
+		if time.Since(lastRescan) >= waitForDiskRescanInterval {
+			if err := s.hostAPI.Rescan(); err != nil {
+				klog.Warningf("WaitForDisk failed to rescan, waiting anyway: %v", err)
+			}
+			lastRescan = time.Now()
+		}
+
+		info, err := s.waitForDiskOnce(identifier, request)
+		if err == nil {
+			klog.V(2).Infof("WaitForDisk found disk %d", info.Number)
+			return &internal.WaitForDiskResponse{DiskNumber: info.Number, Disk: toInternalDiskInfo(info)}, nil
+		}
+		if status.Code(err) == codes.FailedPrecondition {
+			// several disks match, waiting doesn't make the request unambiguous
+			klog.Errorf("WaitForDisk failed: %v", err)
+			return nil, err
+		}
+		lastErr = err
+		klog.V(4).Infof("WaitForDisk still waiting: %v", err)
+
+		select {
+		case <-ctx.Done():
+			code := codes.DeadlineExceeded
+			if ctx.Err() == context.Canceled {
+				code = codes.Canceled
+			}
+			err = status.Errorf(code, "gave up waiting for the disk: %v", lastErr)
+			klog.Errorf("WaitForDisk failed: %v", err)
+			return nil, err
+		case <-time.After(waitForDiskPollInterval):
+		}
+	}
+}
+
+// waitForDiskOnce returns the information of the disk selected by request if it is online, after bringing
+// it online if request.SetOnline is set, or the error to keep waiting on.
+func (s *Server) waitForDiskOnce(identifier string, request *internal.WaitForDiskRequest) (*shared.DiskInfo, error) {
+	diskNumber, err := s.findDisk(identifier, request.IdentifierKind, request.Location)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := s.hostAPI.GetDiskInfo(diskNumber)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsOffline {
+		return info, nil
+	}
+	if !request.SetOnline {
+		return nil, fmt.Errorf("disk %d is offline, offline reason %d", diskNumber, info.OfflineReason)
+	}
+
+	release, err := s.locks.TryAcquire(locks.DiskResource(diskNumber), "WaitForDisk")
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	klog.V(4).Infof("WaitForDisk bringing disk %d online", diskNumber)
+	if err := s.hostAPI.SetDiskState(diskNumber, true); err != nil {
+		return nil, err
+	}
+	info, err = s.hostAPI.GetDiskInfo(diskNumber)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsOffline {
+		return nil, fmt.Errorf("disk %d is still offline, offline reason %d", diskNumber, info.OfflineReason)
+	}
+	return info, nil
+}
+
+// diskNumbers returns the sorted numbers of the disks in diskIDs, or in diskLocations if diskIDs is nil.
+func diskNumbers(diskIDs map[uint32]shared.DiskIDs, diskLocations map[uint32]shared.DiskLocation) []uint32 {
+	var numbers []uint32
+	if diskIDs != nil {
+		for diskNumber := range diskIDs {
+			numbers = append(numbers, diskNumber)
+		}
+	} else {
+		for diskNumber := range diskLocations {
+			numbers = append(numbers, diskNumber)
+		}
+	}
+	sort.Slice(numbers, func(i, j int) bool { return numbers[i] < numbers[j] })
+	return numbers
+}
+
+// identifierTypes maps the identifier kinds of the device identification descriptors to their
+// STORAGE_IDENTIFIER_TYPE.
+var identifierTypes = map[internal.IdentifierKind]uint32{
+	internal.IdentifierKindVendorSpecific: 0,
+	internal.IdentifierKindT10VendorID:    1,
+	internal.IdentifierKindEUI64:          2,
+	internal.IdentifierKindNAA:            3,
+	internal.IdentifierKindSCSINameString: 8,
+}
+
+// storageIDAssocDevice is the STORAGE_ASSOCIATION_TYPE of the descriptors identifying the disk itself,
+// the other ones being shared with the disks behind the same port or target.
+const storageIDAssocDevice = 0
+
+// matchesIdentifier returns true if one of the identifiers of kind in ids is identifier.
+func matchesIdentifier(ids shared.DiskIDs, kind internal.IdentifierKind, identifier string) bool {
+	matches := func(value string) bool {
+		return strings.EqualFold(strings.TrimSpace(value), identifier)
+	}
+
+	if (kind == internal.IdentifierKindAny || kind == internal.IdentifierKindPage83) && matches(ids.Page83) {
+		return true
+	}
+	if (kind == internal.IdentifierKindAny || kind == internal.IdentifierKindSerialNumber) &&
+		(matches(ids.SerialNumber) || matches(ids.DeviceSerialNumber)) {
+		return true
+	}
+	identifierType, ok := identifierTypes[kind]
+	if kind != internal.IdentifierKindAny && !ok {
+		return false
+	}
+	for _, id := range ids.Identifiers {
+		if id.Association != storageIDAssocDevice {
+			continue
+		}
+		if (kind == internal.IdentifierKindAny || id.Type == identifierType) && matches(id.Value) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesLocation returns true if location has the non empty fields of expected.
+func matchesLocation(location shared.DiskLocation, expected *internal.DiskLocation) bool {
+	matches := func(value, expected string) bool {
+		expected = strings.TrimSpace(expected)
+		return expected == "" || value == expected
+	}
+	return matches(location.Adapter, expected.Adapter) && matches(location.Bus, expected.Bus) &&
+		matches(location.Target, expected.Target) && matches(location.LUNID, expected.LUNID)
+}
+
 func (s *Server) DiskStats(context context.Context, request *internal.DiskStatsRequest, version apiversion.Version) (*internal.DiskStatsResponse, error) {
 	klog.V(2).Infof("Request: DiskStats: diskID=%s", request.DiskID)
 	minimumVersion := apiversion.NewVersionOrPanic("v1beta1")
@@ -160,6 +688,7 @@ func (s *Server) DiskStats(context context.Context, request *internal.DiskStatsR
 }
 
 func (s *Server) GetDiskStats(context context.Context, request *internal.GetDiskStatsRequest, version apiversion.Version) (*internal.GetDiskStatsResponse, error) {
+	defer tracing.StartHostAPISpan(context, "disk", "GetDiskStats")()
 	klog.V(2).Infof("Request: GetDiskStats: diskNumber=%d", request.DiskNumber)
 	diskNumber := request.DiskNumber
 	totalBytes, err := s.hostAPI.GetDiskStats(diskNumber)
@@ -198,8 +727,23 @@ func (s *Server) SetAttachState(context context.Context, request *internal.SetAt
 }
 
 func (s *Server) SetDiskState(context context.Context, request *internal.SetDiskStateRequest, version apiversion.Version) (*internal.SetDiskStateResponse, error) {
+	defer tracing.StartHostAPISpan(context, "disk", "SetDiskState")()
 	klog.V(2).Infof("Request: SetDiskState with diskNumber=%d and isOnline=%v", request.DiskNumber, request.IsOnline)
-	err := s.hostAPI.SetDiskState(request.DiskNumber, request.IsOnline)
+	release, err := s.locks.TryAcquire(locks.DiskResource(request.DiskNumber), "SetDiskState")
+	if err != nil {
+		klog.Errorf("SetDiskState failed: %v", err)
+		return nil, err
+	}
+	defer release()
+
+	if !request.IsOnline {
+		if err := s.guard.CheckDisk(request.DiskNumber, "SetDiskState"); err != nil {
+			klog.Errorf("SetDiskState failed: %v", err)
+			return nil, err
+		}
+	}
+
+	err = s.hostAPI.SetDiskState(request.DiskNumber, request.IsOnline)
 	if err != nil {
 		klog.Errorf("SetDiskState failed: %v", err)
 		return nil, err
@@ -234,6 +778,7 @@ func (s *Server) GetAttachState(context context.Context, request *internal.GetAt
 }
 
 func (s *Server) GetDiskState(context context.Context, request *internal.GetDiskStateRequest, version apiversion.Version) (*internal.GetDiskStateResponse, error) {
+	defer tracing.StartHostAPISpan(context, "disk", "GetDiskState")()
 	klog.V(4).Infof("Request: GetDiskState with diskNumber=%d", request.DiskNumber)
 	isOnline, err := s.hostAPI.GetDiskState(request.DiskNumber)
 	if err != nil {
@@ -242,3 +787,247 @@ func (s *Server) GetDiskState(context context.Context, request *internal.GetDisk
 	}
 	return &internal.GetDiskStateResponse{IsOnline: isOnline}, nil
 }
+
+func (s *Server) SetDiskAttributes(context context.Context, request *internal.SetDiskAttributesRequest, version apiversion.Version) (*internal.SetDiskAttributesResponse, error) {
+	defer tracing.StartHostAPISpan(context, "disk", "SetDiskAttributes")()
+	klog.V(2).Infof("Request: SetDiskAttributes: %+v", request)
+	if request.SanPolicy > internal.SanPolicyOfflineInternal {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown SAN policy %d", request.SanPolicy)
+	}
+
+	release, err := s.locks.TryAcquire(locks.DiskResource(request.DiskNumber), "SetDiskAttributes")
+	if err != nil {
+		klog.Errorf("SetDiskAttributes failed: %v", err)
+		return nil, err
+	}
+	defer release()
+	// The SAN policy applies to the whole host, not only to the disk locked above. It's acquired
+	// before changing anything, so that an Aborted request has changed nothing.
+	if request.SanPolicy != internal.SanPolicyUnknown {
+		releaseHost, err := s.locks.TryAcquire(locks.HostResource("sanpolicy"), "SetDiskAttributes")
+		if err != nil {
+			klog.Errorf("SetDiskAttributes failed: %v", err)
+			return nil, err
+		}
+		defer releaseHost()
+	}
+
+	if request.SetReadOnly {
+		if request.IsReadOnly {
+			if err := s.guard.CheckDisk(request.DiskNumber, "SetDiskAttributes"); err != nil {
+				klog.Errorf("SetDiskAttributes failed: %v", err)
+				return nil, err
+			}
+		}
+		if err := s.hostAPI.SetDiskReadOnly(request.DiskNumber, request.IsReadOnly); err != nil {
+			klog.Errorf("SetDiskAttributes failed: %v", err)
+			return nil, err
+		}
+	}
+	if request.SanPolicy != internal.SanPolicyUnknown {
+		if err := s.hostAPI.SetSANPolicy(uint16(request.SanPolicy)); err != nil {
+			klog.Errorf("SetDiskAttributes failed: %v", err)
+			return nil, err
+		}
+	}
+
+	attributes, err := s.getDiskAttributes(request.DiskNumber)
+	if err != nil {
+		klog.Errorf("SetDiskAttributes failed: %v", err)
+		return nil, err
+	}
+	return &internal.SetDiskAttributesResponse{Attributes: attributes}, nil
+}
+
+func (s *Server) GetDiskAttributes(context context.Context, request *internal.GetDiskAttributesRequest, version apiversion.Version) (*internal.GetDiskAttributesResponse, error) {
+	defer tracing.StartHostAPISpan(context, "disk", "GetDiskAttributes")()
+	klog.V(4).Infof("Request: GetDiskAttributes with diskNumber=%d", request.DiskNumber)
+	attributes, err := s.getDiskAttributes(request.DiskNumber)
+	if err != nil {
+		klog.Errorf("GetDiskAttributes failed: %v", err)
+		return nil, err
+	}
+	return &internal.GetDiskAttributesResponse{Attributes: attributes}, nil
+}
+
+// getDiskAttributes returns the attributes of the disk diskNumber and the SAN policy of the host.
+func (s *Server) getDiskAttributes(diskNumber uint32) (*internal.DiskAttributes, error) {
+	info, err := s.hostAPI.GetDiskInfo(diskNumber)
+	if err != nil {
+		return nil, err
+	}
+	policy, err := s.hostAPI.GetSANPolicy()
+	if err != nil {
+		return nil, err
+	}
+	return &internal.DiskAttributes{
+		IsReadOnly:    info.IsReadOnly,
+		IsOffline:     info.IsOffline,
+		OfflineReason: internal.OfflineReason(info.OfflineReason),
+		SanPolicy:     internal.SanPolicy(policy),
+	}, nil
+}
+
+func (s *Server) ListDisks(context context.Context, request *internal.ListDisksRequest, version apiversion.Version) (*internal.ListDisksResponse, error) {
+	defer tracing.StartHostAPISpan(context, "disk", "ListDisks")()
+	klog.V(4).Infof("Request: ListDisks")
+	disks, err := s.hostAPI.ListDisks()
+	if err != nil {
+		klog.Errorf("ListDisks failed: %v", err)
+		return nil, err
+	}
+
+	response := &internal.ListDisksResponse{Disks: make(map[uint32]*internal.DiskInfo)}
+	for k, v := range disks {
+		response.Disks[k] = toInternalDiskInfo(&v)
+	}
+	klog.V(5).Infof("Response=%v", response)
+	return response, nil
+}
+
+func (s *Server) GetDiskInfo(context context.Context, request *internal.GetDiskInfoRequest, version apiversion.Version) (*internal.GetDiskInfoResponse, error) {
+	defer tracing.StartHostAPISpan(context, "disk", "GetDiskInfo")()
+	klog.V(4).Infof("Request: GetDiskInfo with diskNumber=%d", request.DiskNumber)
+	info, err := s.hostAPI.GetDiskInfo(request.DiskNumber)
+	if err != nil {
+		klog.Errorf("GetDiskInfo failed: %v", err)
+		return nil, err
+	}
+	return &internal.GetDiskInfoResponse{Disk: toInternalDiskInfo(info)}, nil
+}
+
+// watchDisksPollInterval is the interval at which WatchDisks checks the disks for changes,
+// when the request doesn't set one.
+var watchDisksPollInterval = 5 * time.Second
+
+func (s *Server) WatchDisks(ctx context.Context, request *internal.WatchDisksRequest, send func(*internal.WatchDisksResponse) error, version apiversion.Version) error {
+	defer tracing.StartHostAPISpan(ctx, "disk", "WatchDisks")()
+	klog.V(2).Infof("Request: WatchDisks: %+v", request)
+	pollInterval := watchDisksPollInterval
+	if request.PollIntervalSeconds > 0 {
+		pollInterval = time.Duration(request.PollIntervalSeconds) * time.Second
+	}
+
+	disks, err := s.hostAPI.ListDisks()
+	if err != nil {
+		klog.Errorf("WatchDisks failed: %v", err)
+		return err
+	}
+	// the current disks come first, so that a client (re)connecting doesn't need
+	// the events it missed
+	for _, diskNumber := range sortedDiskNumbers(disks) {
+		info := disks[diskNumber]
+		event := &internal.WatchDisksResponse{Type: internal.DiskEventTypeExisting, DiskNumber: diskNumber, Disk: toInternalDiskInfo(&info)}
+		if err := send(event); err != nil {
+			return err
+		}
+	}
+	if err := send(&internal.WatchDisksResponse{Type: internal.DiskEventTypeSynced}); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			klog.V(2).Infof("WatchDisks done: %v", ctx.Err())
+			return nil
+		case <-s.shutdown:
+			klog.V(2).Infof("WatchDisks done: server shutting down")
+			return nil
+		case <-ticker.C:
+		}
+
+		current, err := s.hostAPI.ListDisks()
+		if err != nil {
+			klog.Warningf("WatchDisks failed to list the disks, retrying: %v", err)
+			continue
+		}
+		for _, event := range diffDisks(disks, current) {
+			klog.V(4).Infof("WatchDisks sending event %d for disk %d", event.Type, event.DiskNumber)
+			if err := send(event); err != nil {
+				return err
+			}
+		}
+		disks = current
+	}
+}
+
+// diffDisks returns the events turning the previous disks into the current ones, by disk number.
+// A disk number whose page83 id or serial number changed is a disk removed and another one added.
+func diffDisks(previous, current map[uint32]shared.DiskInfo) []*internal.WatchDisksResponse {
+	all := make(map[uint32]shared.DiskInfo, len(current))
+	for diskNumber, info := range previous {
+		all[diskNumber] = info
+	}
+	for diskNumber, info := range current {
+		all[diskNumber] = info
+	}
+
+	var events []*internal.WatchDisksResponse
+	newEvent := func(eventType internal.DiskEventType, info shared.DiskInfo) {
+		events = append(events, &internal.WatchDisksResponse{Type: eventType, DiskNumber: info.Number, Disk: toInternalDiskInfo(&info)})
+	}
+	for _, diskNumber := range sortedDiskNumbers(all) {
+		before, existed := previous[diskNumber]
+		after, exists := current[diskNumber]
+		switch {
+		case !exists:
+			newEvent(internal.DiskEventTypeRemoved, before)
+		case !existed:
+			newEvent(internal.DiskEventTypeAdded, after)
+		case before.Page83 != after.Page83 || before.SerialNumber != after.SerialNumber:
+			newEvent(internal.DiskEventTypeRemoved, before)
+			newEvent(internal.DiskEventTypeAdded, after)
+		default:
+			if before.IsOffline && !after.IsOffline {
+				newEvent(internal.DiskEventTypeOnline, after)
+			} else if !before.IsOffline && after.IsOffline {
+				newEvent(internal.DiskEventTypeOffline, after)
+			}
+			if before.Size != after.Size {
+				newEvent(internal.DiskEventTypeResized, after)
+			}
+		}
+	}
+	return events
+}
+
+// sortedDiskNumbers returns the sorted numbers of the disks.
+func sortedDiskNumbers(disks map[uint32]shared.DiskInfo) []uint32 {
+	numbers := make([]uint32, 0, len(disks))
+	for diskNumber := range disks {
+		numbers = append(numbers, diskNumber)
+	}
+	sort.Slice(numbers, func(i, j int) bool { return numbers[i] < numbers[j] })
+	return numbers
+}
+
+// toInternalDiskInfo converts from the shared to the internal type.
+func toInternalDiskInfo(info *shared.DiskInfo) *internal.DiskInfo {
+	return &internal.DiskInfo{
+		DiskNumber:         info.Number,
+		Size:               info.Size,
+		LogicalSectorSize:  info.LogicalSectorSize,
+		PhysicalSectorSize: info.PhysicalSectorSize,
+		BusType:            internal.BusType(info.BusType),
+		FriendlyName:       info.FriendlyName,
+		Model:              info.Model,
+		SerialNumber:       info.SerialNumber,
+		Page83:             info.Page83,
+		PartitionStyle:     internal.PartitionStyle(info.PartitionStyle),
+		IsBoot:             info.IsBoot,
+		IsSystem:           info.IsSystem,
+		IsClustered:        info.IsClustered,
+		IsReadOnly:         info.IsReadOnly,
+		IsOffline:          info.IsOffline,
+		OfflineReason:      internal.OfflineReason(info.OfflineReason),
+		Location: &internal.DiskLocation{
+			Adapter: info.Location.Adapter,
+			Bus:     info.Location.Bus,
+			Target:  info.Location.Target,
+			LUNID:   info.Location.LUNID,
+		},
+	}
+}
@@ -0,0 +1,927 @@
+package disk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+	"github.com/kubernetes-csi/csi-proxy/pkg/os/disk"
+	internal "github.com/kubernetes-csi/csi-proxy/pkg/server/disk/impl"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/guard"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/locks"
+	srvtypes "github.com/kubernetes-csi/csi-proxy/pkg/server/types"
+	shared "github.com/kubernetes-csi/csi-proxy/pkg/shared/disk"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeDiskAPI has the boot disk 0 and the data disk 1, and records the mutating calls.
+type fakeDiskAPI struct {
+	disks      map[uint32]shared.DiskInfo
+	partitions map[uint32][]shared.PartitionInfo
+	sanPolicy  uint16
+	calls      []string
+	healthErr  error
+}
+
+var _ disk.API = &fakeDiskAPI{}
+
+func newFakeDiskAPI() *fakeDiskAPI {
+	return &fakeDiskAPI{
+		disks: map[uint32]shared.DiskInfo{
+			0: {Number: 0, IsBoot: true, IsSystem: true, PartitionStyle: 2},
+			1: {Number: 1, IsOffline: true, OfflineReason: 1, Location: shared.DiskLocation{Adapter: "0", LUNID: "1"}},
+		},
+		sanPolicy: 2,
+	}
+}
+
+func (f *fakeDiskAPI) ListDiskLocations() (map[uint32]shared.DiskLocation, error) {
+	locations := map[uint32]shared.DiskLocation{}
+	for diskNumber, info := range f.disks {
+		locations[diskNumber] = info.Location
+	}
+	return locations, nil
+}
+
+func (f *fakeDiskAPI) IsDiskInitialized(diskNumber uint32) (bool, error) {
+	return f.disks[diskNumber].PartitionStyle != 0, nil
+}
+
+func (f *fakeDiskAPI) InitializeDisk(diskNumber uint32, partitionStyle uint16) error {
+	f.calls = append(f.calls, "InitializeDisk")
+	info := f.disks[diskNumber]
+	info.PartitionStyle = partitionStyle
+	f.disks[diskNumber] = info
+	return nil
+}
+
+func (f *fakeDiskAPI) ListPartitions(diskNumber uint32) ([]shared.PartitionInfo, error) {
+	return f.partitions[diskNumber], nil
+}
+
+func (f *fakeDiskAPI) CreatePartition(diskNumber uint32, options shared.PartitionOptions) (uint32, error) {
+	f.calls = append(f.calls, "CreatePartition")
+	partitionNumber := uint32(len(f.partitions[diskNumber]) + 1)
+	f.partitions[diskNumber] = append(f.partitions[diskNumber], shared.PartitionInfo{
+		DiskNumber:      diskNumber,
+		PartitionNumber: partitionNumber,
+		Offset:          options.Offset,
+		Size:            options.Size,
+	})
+	return partitionNumber, nil
+}
+
+func (f *fakeDiskAPI) DeletePartition(diskNumber, partitionNumber uint32) error {
+	f.calls = append(f.calls, "DeletePartition")
+	return nil
+}
+
+func (f *fakeDiskAPI) Rescan() error {
+	return nil
+}
+
+func (f *fakeDiskAPI) GetDiskNumberByName(page83ID string) (uint32, error) {
+	return 0, nil
+}
+
+func (f *fakeDiskAPI) ListDiskIDs() (map[uint32]shared.DiskIDs, error) {
+	return map[uint32]shared.DiskIDs{
+		0: {
+			Page83:       "6002248000000000000000000000000a",
+			SerialNumber: "OS-DISK",
+			Identifiers: []shared.DiskIdentifier{
+				{Type: 3, Value: "6002248000000000000000000000000a"},
+				{Type: 3, Association: 1, Value: "500a098000000001"},
+			},
+		},
+		1: {
+			Page83:             "6002248000000000000000000000000b",
+			SerialNumber:       "0000_0000_0000_0001.",
+			DeviceSerialNumber: "vol0123456789abcdef0",
+			Identifiers: []shared.DiskIdentifier{
+				{Type: 2, Value: "00a0750123456789"},
+				{Type: 3, Association: 1, Value: "500a098000000001"},
+			},
+		},
+	}, nil
+}
+
+func (f *fakeDiskAPI) GetDiskStats(diskNumber uint32) (int64, error) {
+	return 0, nil
+}
+
+func (f *fakeDiskAPI) SetDiskState(diskNumber uint32, isOnline bool) error {
+	f.calls = append(f.calls, "SetDiskState")
+	info := f.disks[diskNumber]
+	info.IsOffline = !isOnline
+	info.OfflineReason = 0
+	f.disks[diskNumber] = info
+	return nil
+}
+
+func (f *fakeDiskAPI) GetDiskState(diskNumber uint32) (bool, error) {
+	return !f.disks[diskNumber].IsOffline, nil
+}
+
+func (f *fakeDiskAPI) ClearDisk(diskNumber uint32, removeOEM bool) error {
+	f.calls = append(f.calls, "ClearDisk")
+	delete(f.partitions, diskNumber)
+	return nil
+}
+
+func (f *fakeDiskAPI) ZeroDisk(diskNumber uint32, offset, length uint64, progress func(zeroed uint64) error) error {
+	f.calls = append(f.calls, fmt.Sprintf("ZeroDisk %d+%d", offset, length))
+	for _, zeroed := range []uint64{length / 2, length} {
+		if err := progress(zeroed); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *fakeDiskAPI) SetDiskReadOnly(diskNumber uint32, isReadOnly bool) error {
+	info := f.disks[diskNumber]
+	if info.IsReadOnly != isReadOnly {
+		f.calls = append(f.calls, "SetDiskReadOnly")
+		info.IsReadOnly = isReadOnly
+		f.disks[diskNumber] = info
+	}
+	return nil
+}
+
+func (f *fakeDiskAPI) GetSANPolicy() (uint16, error) {
+	return f.sanPolicy, nil
+}
+
+func (f *fakeDiskAPI) SetSANPolicy(policy uint16) error {
+	if f.sanPolicy != policy {
+		f.calls = append(f.calls, "SetSANPolicy")
+		f.sanPolicy = policy
+	}
+	return nil
+}
+
+func (f *fakeDiskAPI) ListDisks() (map[uint32]shared.DiskInfo, error) {
+	disks := make(map[uint32]shared.DiskInfo, len(f.disks))
+	for diskNumber, info := range f.disks {
+		disks[diskNumber] = info
+	}
+	return disks, nil
+}
+
+func (f *fakeDiskAPI) GetDiskInfo(diskNumber uint32) (*shared.DiskInfo, error) {
+	info := f.disks[diskNumber]
+	return &info, nil
+}
+
+func (f *fakeDiskAPI) ListPageFileDisks() ([]uint32, error) {
+	return []uint32{0}, nil
+}
+
+func (f *fakeDiskAPI) CheckHealth() error {
+	return f.healthErr
+}
+
+func newTestServer(t *testing.T, hostAPI *fakeDiskAPI, policy guard.Policy) *Server {
+	srv, err := NewServer(hostAPI, locks.NewManager(), guard.NewGuard(hostAPI, policy))
+	if err != nil {
+		t.Fatalf("Disk server could not be initialized: %v", err)
+	}
+	return srv
+}
+
+func TestPartitionDiskProtection(t *testing.T) {
+	v1 := apiversion.NewVersionOrPanic("v1")
+
+	hostAPI := newFakeDiskAPI()
+	srv := newTestServer(t, hostAPI, guard.DefaultPolicy())
+	_, err := srv.PartitionDisk(context.TODO(), &internal.PartitionDiskRequest{DiskNumber: 0}, v1)
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("Expected FailedPrecondition partitioning the boot disk, got: %v", err)
+	}
+	if len(hostAPI.calls) != 0 {
+		t.Fatalf("Expected no mutating call on the boot disk, got: %v", hostAPI.calls)
+	}
+
+	_, err = srv.PartitionDisk(context.TODO(), &internal.PartitionDiskRequest{DiskNumber: 1}, v1)
+	if err != nil {
+		t.Fatalf("PartitionDisk of the data disk failed: %v", err)
+	}
+	if len(hostAPI.calls) != 2 {
+		t.Fatalf("Expected the data disk to be initialized and partitioned, got: %v", hostAPI.calls)
+	}
+}
+
+func TestPartitionDisk(t *testing.T) {
+	v2alpha1 := apiversion.NewVersionOrPanic("v2alpha1")
+
+	testCases := []struct {
+		name                    string
+		partitions              []shared.PartitionInfo
+		request                 *internal.PartitionDiskRequest
+		expectedCode            codes.Code
+		expectedPartitionNumber uint32
+		expectedCalls           int
+	}{
+		{
+			name:                    "initialize and partition",
+			request:                 &internal.PartitionDiskRequest{DiskNumber: 1, PartitionStyle: internal.PartitionStyleMBR, Size: 1 << 30},
+			expectedPartitionNumber: 1,
+			expectedCalls:           2,
+		},
+		{
+			name:                    "already partitioned",
+			partitions:              []shared.PartitionInfo{{PartitionNumber: 1, IsReserved: true}, {PartitionNumber: 2}},
+			request:                 &internal.PartitionDiskRequest{DiskNumber: 1},
+			expectedPartitionNumber: 2,
+		},
+		{
+			name:                    "append",
+			partitions:              []shared.PartitionInfo{{PartitionNumber: 1, Offset: 1 << 20}},
+			request:                 &internal.PartitionDiskRequest{DiskNumber: 1, Offset: 1 << 30, Append: true},
+			expectedPartitionNumber: 2,
+			expectedCalls:           1,
+		},
+		{
+			name:                    "append retried",
+			partitions:              []shared.PartitionInfo{{PartitionNumber: 1, Offset: 1 << 20}, {PartitionNumber: 2, Offset: 1 << 30}},
+			request:                 &internal.PartitionDiskRequest{DiskNumber: 1, Offset: 1 << 30, Append: true},
+			expectedPartitionNumber: 2,
+		},
+		{
+			name:         "GPT name on MBR",
+			request:      &internal.PartitionDiskRequest{DiskNumber: 1, PartitionStyle: internal.PartitionStyleMBR, Name: "data"},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "MBR type on GPT",
+			request:      &internal.PartitionDiskRequest{DiskNumber: 1, MbrType: 7},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "name too long",
+			request:      &internal.PartitionDiskRequest{DiskNumber: 1, Name: "a name longer than thirty six characters"},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			hostAPI := newFakeDiskAPI()
+			if tc.partitions != nil {
+				// the disk was initialized with the GPT partition style when it was partitioned
+				hostAPI.InitializeDisk(1, uint16(internal.PartitionStyleGPT))
+				hostAPI.partitions[1] = tc.partitions
+				hostAPI.calls = nil
+			}
+			srv := newTestServer(t, hostAPI, guard.DefaultPolicy())
+			response, err := srv.PartitionDisk(context.TODO(), tc.request, v2alpha1)
+			if status.Code(err) != tc.expectedCode {
+				t.Fatalf("Expected code %v, got error: %v", tc.expectedCode, err)
+			}
+			if err != nil {
+				return
+			}
+			if response.PartitionNumber != tc.expectedPartitionNumber {
+				t.Fatalf("Expected partition %d, got %d", tc.expectedPartitionNumber, response.PartitionNumber)
+			}
+			if len(hostAPI.calls) != tc.expectedCalls {
+				t.Fatalf("Expected %d mutating calls, got: %v", tc.expectedCalls, hostAPI.calls)
+			}
+		})
+	}
+}
+
+func TestPartitionDiskStyleMismatch(t *testing.T) {
+	v2alpha1 := apiversion.NewVersionOrPanic("v2alpha1")
+
+	srv := newTestServer(t, newFakeDiskAPI(), guard.DefaultPolicy())
+	_, err := srv.PartitionDisk(context.TODO(), &internal.PartitionDiskRequest{DiskNumber: 1}, v2alpha1)
+	if err != nil {
+		t.Fatalf("PartitionDisk failed: %v", err)
+	}
+	_, err = srv.PartitionDisk(context.TODO(), &internal.PartitionDiskRequest{DiskNumber: 1, PartitionStyle: internal.PartitionStyleMBR}, v2alpha1)
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("Expected FailedPrecondition partitioning a GPT disk as MBR, got: %v", err)
+	}
+}
+
+func TestSetDiskStateProtection(t *testing.T) {
+	v1 := apiversion.NewVersionOrPanic("v1")
+
+	testCases := []struct {
+		name         string
+		diskNumber   uint32
+		isOnline     bool
+		policy       guard.Policy
+		expectedCode codes.Code
+	}{
+		{
+			name:         "boot disk offline",
+			diskNumber:   0,
+			policy:       guard.DefaultPolicy(),
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "boot disk online",
+			diskNumber:   0,
+			isOnline:     true,
+			policy:       guard.DefaultPolicy(),
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "boot disk offline not protected",
+			diskNumber:   0,
+			policy:       guard.Policy{},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "data disk offline",
+			diskNumber:   1,
+			policy:       guard.DefaultPolicy(),
+			expectedCode: codes.OK,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			hostAPI := newFakeDiskAPI()
+			srv := newTestServer(t, hostAPI, tc.policy)
+			_, err := srv.SetDiskState(context.TODO(), &internal.SetDiskStateRequest{DiskNumber: tc.diskNumber, IsOnline: tc.isOnline}, v1)
+			if status.Code(err) != tc.expectedCode {
+				t.Fatalf("Expected code %v, got error: %v", tc.expectedCode, err)
+			}
+		})
+	}
+}
+
+func TestFindDisk(t *testing.T) {
+	v2alpha1 := apiversion.NewVersionOrPanic("v2alpha1")
+
+	testCases := []struct {
+		name               string
+		request            *internal.FindDiskRequest
+		expectedCode       codes.Code
+		expectedDiskNumber uint32
+	}{
+		{
+			name:               "page83",
+			request:            &internal.FindDiskRequest{Identifier: "6002248000000000000000000000000B", IdentifierKind: internal.IdentifierKindPage83},
+			expectedDiskNumber: 1,
+		},
+		{
+			name:               "serial number",
+			request:            &internal.FindDiskRequest{Identifier: " os-disk "},
+			expectedDiskNumber: 0,
+		},
+		{
+			name:               "NVMe controller serial number",
+			request:            &internal.FindDiskRequest{Identifier: "vol0123456789abcdef0", IdentifierKind: internal.IdentifierKindSerialNumber},
+			expectedDiskNumber: 1,
+		},
+		{
+			name:               "EUI-64",
+			request:            &internal.FindDiskRequest{Identifier: "00a0750123456789", IdentifierKind: internal.IdentifierKindEUI64},
+			expectedDiskNumber: 1,
+		},
+		{
+			name:         "EUI-64 as NAA",
+			request:      &internal.FindDiskRequest{Identifier: "00a0750123456789", IdentifierKind: internal.IdentifierKindNAA},
+			expectedCode: codes.NotFound,
+		},
+		{
+			name:         "port identifier",
+			request:      &internal.FindDiskRequest{Identifier: "500a098000000001"},
+			expectedCode: codes.NotFound,
+		},
+		{
+			name:               "location",
+			request:            &internal.FindDiskRequest{Location: &internal.DiskLocation{LUNID: "1"}},
+			expectedDiskNumber: 1,
+		},
+		{
+			name:         "ambiguous location",
+			request:      &internal.FindDiskRequest{Location: &internal.DiskLocation{}},
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "identifier and other location",
+			request:      &internal.FindDiskRequest{Identifier: "OS-DISK", Location: &internal.DiskLocation{LUNID: "1"}},
+			expectedCode: codes.NotFound,
+		},
+		{
+			name:         "nothing to match",
+			request:      &internal.FindDiskRequest{Identifier: " "},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := newTestServer(t, newFakeDiskAPI(), guard.DefaultPolicy())
+			response, err := srv.FindDisk(context.TODO(), tc.request, v2alpha1)
+			if status.Code(err) != tc.expectedCode {
+				t.Fatalf("Expected code %v, got error: %v", tc.expectedCode, err)
+			}
+			if err == nil && response.DiskNumber != tc.expectedDiskNumber {
+				t.Fatalf("Expected disk %d, got %d", tc.expectedDiskNumber, response.DiskNumber)
+			}
+		})
+	}
+}
+
+func TestWaitForDisk(t *testing.T) {
+	v2alpha1 := apiversion.NewVersionOrPanic("v2alpha1")
+	waitForDiskPollInterval = time.Millisecond
+
+	testCases := []struct {
+		name               string
+		request            *internal.WaitForDiskRequest
+		expectedCode       codes.Code
+		expectedDiskNumber uint32
+		expectedCalls      []string
+	}{
+		{
+			name:               "online disk",
+			request:            &internal.WaitForDiskRequest{Identifier: "OS-DISK"},
+			expectedDiskNumber: 0,
+		},
+		{
+			name:               "offline disk brought online",
+			request:            &internal.WaitForDiskRequest{Identifier: "vol0123456789abcdef0", SetOnline: true},
+			expectedDiskNumber: 1,
+			expectedCalls:      []string{"SetDiskState"},
+		},
+		{
+			name:         "offline disk",
+			request:      &internal.WaitForDiskRequest{Identifier: "vol0123456789abcdef0"},
+			expectedCode: codes.DeadlineExceeded,
+		},
+		{
+			name:         "missing disk",
+			request:      &internal.WaitForDiskRequest{Identifier: "vol0fedcba9876543210"},
+			expectedCode: codes.DeadlineExceeded,
+		},
+		{
+			name:         "ambiguous location",
+			request:      &internal.WaitForDiskRequest{Location: &internal.DiskLocation{}},
+			expectedCode: codes.FailedPrecondition,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			hostAPI := newFakeDiskAPI()
+			srv := newTestServer(t, hostAPI, guard.DefaultPolicy())
+			ctx, cancel := context.WithTimeout(context.TODO(), 50*time.Millisecond)
+			defer cancel()
+			response, err := srv.WaitForDisk(ctx, tc.request, v2alpha1)
+			if status.Code(err) != tc.expectedCode {
+				t.Fatalf("Expected code %v, got error: %v", tc.expectedCode, err)
+			}
+			if err != nil {
+				return
+			}
+			if response.DiskNumber != tc.expectedDiskNumber || response.Disk.DiskNumber != tc.expectedDiskNumber || response.Disk.IsOffline {
+				t.Fatalf("Expected online disk %d, got: %+v", tc.expectedDiskNumber, response)
+			}
+			if len(hostAPI.calls) != len(tc.expectedCalls) {
+				t.Fatalf("Expected calls %v, got: %v", tc.expectedCalls, hostAPI.calls)
+			}
+		})
+	}
+}
+
+func TestCheckHealth(t *testing.T) {
+	v1 := apiversion.NewVersionOrPanic("v1")
+
+	hostAPI := newFakeDiskAPI()
+	var reporter srvtypes.HealthReporter = newTestServer(t, hostAPI, guard.DefaultPolicy())
+	if err := reporter.CheckHealth(v1); err != nil {
+		t.Fatalf("Expected the disk API group to be healthy, got: %v", err)
+	}
+
+	hostAPI.healthErr = errors.New("WMI unavailable")
+	if err := reporter.CheckHealth(v1); err == nil {
+		t.Fatalf("Expected the disk API group to be unhealthy")
+	}
+}
+
+func TestGetDiskInfo(t *testing.T) {
+	v2alpha1 := apiversion.NewVersionOrPanic("v2alpha1")
+
+	srv := newTestServer(t, newFakeDiskAPI(), guard.DefaultPolicy())
+	response, err := srv.GetDiskInfo(context.TODO(), &internal.GetDiskInfoRequest{DiskNumber: 1}, v2alpha1)
+	if err != nil {
+		t.Fatalf("GetDiskInfo failed: %v", err)
+	}
+	if !response.Disk.IsOffline || response.Disk.OfflineReason != internal.OfflineReason(1) {
+		t.Fatalf("Expected disk 1 to be offline by policy, got: %+v", response.Disk)
+	}
+	if response.Disk.Location.Adapter != "0" || response.Disk.Location.LUNID != "1" {
+		t.Fatalf("Unexpected location: %+v", response.Disk.Location)
+	}
+
+	listResponse, err := srv.ListDisks(context.TODO(), &internal.ListDisksRequest{}, v2alpha1)
+	if err != nil {
+		t.Fatalf("ListDisks failed: %v", err)
+	}
+	if len(listResponse.Disks) != 2 || !listResponse.Disks[0].IsBoot {
+		t.Fatalf("Unexpected disks: %+v", listResponse.Disks)
+	}
+}
+
+func TestClearDisk(t *testing.T) {
+	v2alpha1 := apiversion.NewVersionOrPanic("v2alpha1")
+	clearDiskProgressInterval = 0
+	const mb = 1024 * 1024
+
+	testCases := []struct {
+		name           string
+		request        *internal.ClearDiskRequest
+		diskSize       uint64
+		isOffline      bool
+		expectedCode   codes.Code
+		expectedCalls  []string
+		expectedStages []internal.ClearDiskStage
+	}{
+		{
+			name:           "no zeroing",
+			request:        &internal.ClearDiskRequest{DiskNumber: 1},
+			diskSize:       1024 * mb,
+			expectedCalls:  []string{"ClearDisk"},
+			expectedStages: []internal.ClearDiskStage{internal.ClearDiskStageClearing, internal.ClearDiskStageDone},
+		},
+		{
+			name:          "edges",
+			request:       &internal.ClearDiskRequest{DiskNumber: 1, ZeroMode: internal.ZeroModeEdges},
+			diskSize:      1024 * mb,
+			expectedCalls: []string{"ClearDisk", fmt.Sprintf("ZeroDisk 0+%d", 32*mb), fmt.Sprintf("ZeroDisk %d+%d", 992*mb, 32*mb)},
+			expectedStages: []internal.ClearDiskStage{
+				internal.ClearDiskStageClearing,
+				internal.ClearDiskStageZeroing, internal.ClearDiskStageZeroing,
+				internal.ClearDiskStageZeroing, internal.ClearDiskStageZeroing,
+				internal.ClearDiskStageDone,
+			},
+		},
+		{
+			name:          "edges of a small disk",
+			request:       &internal.ClearDiskRequest{DiskNumber: 1, ZeroMode: internal.ZeroModeEdges, ZeroMegabytes: 8},
+			diskSize:      10 * mb,
+			expectedCalls: []string{"ClearDisk", fmt.Sprintf("ZeroDisk 0+%d", 10*mb)},
+			expectedStages: []internal.ClearDiskStage{
+				internal.ClearDiskStageClearing,
+				internal.ClearDiskStageZeroing, internal.ClearDiskStageZeroing,
+				internal.ClearDiskStageDone,
+			},
+		},
+		{
+			name:          "entire disk",
+			request:       &internal.ClearDiskRequest{DiskNumber: 1, ZeroMode: internal.ZeroModeEntire},
+			diskSize:      1024 * mb,
+			expectedCalls: []string{"ClearDisk", fmt.Sprintf("ZeroDisk 0+%d", 1024*mb)},
+			expectedStages: []internal.ClearDiskStage{
+				internal.ClearDiskStageClearing,
+				internal.ClearDiskStageZeroing, internal.ClearDiskStageZeroing,
+				internal.ClearDiskStageDone,
+			},
+		},
+		{
+			name:         "offline disk",
+			request:      &internal.ClearDiskRequest{DiskNumber: 1},
+			diskSize:     1024 * mb,
+			isOffline:    true,
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "boot disk",
+			request:      &internal.ClearDiskRequest{DiskNumber: 0},
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "unknown zero mode",
+			request:      &internal.ClearDiskRequest{DiskNumber: 1, ZeroMode: 3},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			hostAPI := newFakeDiskAPI()
+			hostAPI.disks[1] = shared.DiskInfo{Number: 1, Size: tc.diskSize, IsOffline: tc.isOffline}
+			srv := newTestServer(t, hostAPI, guard.DefaultPolicy())
+
+			var responses []*internal.ClearDiskResponse
+			send := func(response *internal.ClearDiskResponse) error {
+				responses = append(responses, response)
+				return nil
+			}
+			err := srv.ClearDisk(context.TODO(), tc.request, send, v2alpha1)
+			if status.Code(err) != tc.expectedCode {
+				t.Fatalf("Expected code %v, got error: %v", tc.expectedCode, err)
+			}
+			if !reflect.DeepEqual(hostAPI.calls, tc.expectedCalls) {
+				t.Fatalf("Expected calls %v, got: %v", tc.expectedCalls, hostAPI.calls)
+			}
+			if err != nil {
+				return
+			}
+			var stages []internal.ClearDiskStage
+			for _, response := range responses {
+				stages = append(stages, response.Stage)
+			}
+			if !reflect.DeepEqual(stages, tc.expectedStages) {
+				t.Fatalf("Expected stages %v, got: %v", tc.expectedStages, stages)
+			}
+			last := responses[len(responses)-1]
+			if last.BytesZeroed != last.BytesToZero {
+				t.Fatalf("Expected all the bytes to be zeroed, got: %+v", last)
+			}
+		})
+	}
+}
+
+func TestClearDiskCanceled(t *testing.T) {
+	v2alpha1 := apiversion.NewVersionOrPanic("v2alpha1")
+
+	hostAPI := newFakeDiskAPI()
+	hostAPI.disks[1] = shared.DiskInfo{Number: 1, Size: 1024 * 1024 * 1024}
+	srv := newTestServer(t, hostAPI, guard.DefaultPolicy())
+	ctx, cancel := context.WithCancel(context.TODO())
+	send := func(response *internal.ClearDiskResponse) error {
+		// the client goes away once the disk is cleared
+		cancel()
+		return nil
+	}
+	err := srv.ClearDisk(ctx, &internal.ClearDiskRequest{DiskNumber: 1, ZeroMode: internal.ZeroModeEntire}, send, v2alpha1)
+	if err != context.Canceled {
+		t.Fatalf("Expected the zeroing to be canceled, got error: %v", err)
+	}
+}
+
+func TestSetDiskAttributes(t *testing.T) {
+	v2alpha1 := apiversion.NewVersionOrPanic("v2alpha1")
+
+	testCases := []struct {
+		name               string
+		request            *internal.SetDiskAttributesRequest
+		expectedCode       codes.Code
+		expectedAttributes internal.DiskAttributes
+		expectedCalls      []string
+	}{
+		{
+			name:               "nothing to set",
+			request:            &internal.SetDiskAttributesRequest{DiskNumber: 1},
+			expectedAttributes: internal.DiskAttributes{IsOffline: true, OfflineReason: 1, SanPolicy: internal.SanPolicyOfflineShared},
+		},
+		{
+			name:               "read only",
+			request:            &internal.SetDiskAttributesRequest{DiskNumber: 1, SetReadOnly: true, IsReadOnly: true},
+			expectedAttributes: internal.DiskAttributes{IsReadOnly: true, IsOffline: true, OfflineReason: 1, SanPolicy: internal.SanPolicyOfflineShared},
+			expectedCalls:      []string{"SetDiskReadOnly"},
+		},
+		{
+			name:               "already read write",
+			request:            &internal.SetDiskAttributesRequest{DiskNumber: 1, SetReadOnly: true},
+			expectedAttributes: internal.DiskAttributes{IsOffline: true, OfflineReason: 1, SanPolicy: internal.SanPolicyOfflineShared},
+		},
+		{
+			name:               "SAN policy",
+			request:            &internal.SetDiskAttributesRequest{DiskNumber: 1, SanPolicy: internal.SanPolicyOnlineAll},
+			expectedAttributes: internal.DiskAttributes{IsOffline: true, OfflineReason: 1, SanPolicy: internal.SanPolicyOnlineAll},
+			expectedCalls:      []string{"SetSANPolicy"},
+		},
+		{
+			name:               "boot disk read write",
+			request:            &internal.SetDiskAttributesRequest{DiskNumber: 0, SetReadOnly: true},
+			expectedAttributes: internal.DiskAttributes{SanPolicy: internal.SanPolicyOfflineShared},
+		},
+		{
+			name:         "boot disk read only",
+			request:      &internal.SetDiskAttributesRequest{DiskNumber: 0, SetReadOnly: true, IsReadOnly: true},
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "unknown SAN policy",
+			request:      &internal.SetDiskAttributesRequest{DiskNumber: 1, SanPolicy: 5},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			hostAPI := newFakeDiskAPI()
+			srv := newTestServer(t, hostAPI, guard.DefaultPolicy())
+			response, err := srv.SetDiskAttributes(context.TODO(), tc.request, v2alpha1)
+			if status.Code(err) != tc.expectedCode {
+				t.Fatalf("Expected code %v, got error: %v", tc.expectedCode, err)
+			}
+			if len(hostAPI.calls) != len(tc.expectedCalls) {
+				t.Fatalf("Expected calls %v, got: %v", tc.expectedCalls, hostAPI.calls)
+			}
+			if err != nil {
+				return
+			}
+			if *response.Attributes != tc.expectedAttributes {
+				t.Fatalf("Expected attributes %+v, got: %+v", tc.expectedAttributes, response.Attributes)
+			}
+		})
+	}
+}
+
+func TestSetDiskAttributesSANPolicyLock(t *testing.T) {
+	v2alpha1 := apiversion.NewVersionOrPanic("v2alpha1")
+
+	hostAPI := newFakeDiskAPI()
+	srv := newTestServer(t, hostAPI, guard.DefaultPolicy())
+	release, err := srv.locks.TryAcquire(locks.HostResource("sanpolicy"), "SetDiskAttributes")
+	if err != nil {
+		t.Fatalf("Could not acquire the SAN policy: %v", err)
+	}
+
+	// Changing the SAN policy through another disk conflicts with the operation in progress
+	request := &internal.SetDiskAttributesRequest{DiskNumber: 1, SanPolicy: internal.SanPolicyOnlineAll}
+	_, err = srv.SetDiskAttributes(context.TODO(), request, v2alpha1)
+	if status.Code(err) != codes.Aborted {
+		t.Fatalf("Expected code %v, got error: %v", codes.Aborted, err)
+	}
+	if len(hostAPI.calls) != 0 {
+		t.Fatalf("Expected no calls, got: %v", hostAPI.calls)
+	}
+
+	// nor does it change the other attributes of the request
+	request = &internal.SetDiskAttributesRequest{DiskNumber: 1, SetReadOnly: true, IsReadOnly: true, SanPolicy: internal.SanPolicyOnlineAll}
+	_, err = srv.SetDiskAttributes(context.TODO(), request, v2alpha1)
+	if status.Code(err) != codes.Aborted {
+		t.Fatalf("Expected code %v, got error: %v", codes.Aborted, err)
+	}
+	if hostAPI.disks[1].IsReadOnly {
+		t.Fatalf("Disk 1 made read only by an aborted request")
+	}
+	if len(hostAPI.calls) != 0 {
+		t.Fatalf("Expected no calls, got: %v", hostAPI.calls)
+	}
+
+	// Attributes other than the SAN policy don't need it
+	request = &internal.SetDiskAttributesRequest{DiskNumber: 1, SetReadOnly: true, IsReadOnly: true}
+	if _, err := srv.SetDiskAttributes(context.TODO(), request, v2alpha1); err != nil {
+		t.Fatalf("SetDiskAttributes failed: %v", err)
+	}
+
+	release()
+	request = &internal.SetDiskAttributesRequest{DiskNumber: 1, SanPolicy: internal.SanPolicyOnlineAll}
+	if _, err := srv.SetDiskAttributes(context.TODO(), request, v2alpha1); err != nil {
+		t.Fatalf("SetDiskAttributes failed: %v", err)
+	}
+}
+
+type diskEvent struct {
+	eventType  internal.DiskEventType
+	diskNumber uint32
+}
+
+func TestWatchDisks(t *testing.T) {
+	v2alpha1 := apiversion.NewVersionOrPanic("v2alpha1")
+	watchDisksPollInterval = time.Millisecond
+
+	hostAPI := newFakeDiskAPI()
+	srv := newTestServer(t, hostAPI, guard.DefaultPolicy())
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+	defer cancel()
+
+	expectedEvents := []diskEvent{
+		{internal.DiskEventTypeExisting, 0},
+		{internal.DiskEventTypeExisting, 1},
+		{internal.DiskEventTypeSynced, 0},
+		{internal.DiskEventTypeOnline, 1},
+		{internal.DiskEventTypeAdded, 2},
+	}
+	var events []diskEvent
+	send := func(response *internal.WatchDisksResponse) error {
+		events = append(events, diskEvent{response.Type, response.DiskNumber})
+		if response.Type == internal.DiskEventTypeSynced {
+			// the disks change once the client is synced
+			info := hostAPI.disks[1]
+			info.IsOffline = false
+			hostAPI.disks[1] = info
+			hostAPI.disks[2] = shared.DiskInfo{Number: 2, Page83: "vol0fedcba9876543210"}
+		}
+		if len(events) == len(expectedEvents) {
+			cancel()
+		}
+		return nil
+	}
+	if err := srv.WatchDisks(ctx, &internal.WatchDisksRequest{}, send, v2alpha1); err != nil {
+		t.Fatalf("WatchDisks failed: %v", err)
+	}
+	if !reflect.DeepEqual(events, expectedEvents) {
+		t.Fatalf("Expected events %v, got: %v", expectedEvents, events)
+	}
+}
+
+func TestWatchDisksShutdown(t *testing.T) {
+	v2alpha1 := apiversion.NewVersionOrPanic("v2alpha1")
+	watchDisksPollInterval = time.Hour
+
+	srv := newTestServer(t, newFakeDiskAPI(), guard.DefaultPolicy())
+	send := func(response *internal.WatchDisksResponse) error {
+		if response.Type == internal.DiskEventTypeSynced {
+			// the server stops while the client is watching
+			srv.Shutdown()
+		}
+		return nil
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- srv.WatchDisks(context.TODO(), &internal.WatchDisksRequest{}, send, v2alpha1)
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("WatchDisks failed: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("WatchDisks didn't end on shutdown")
+	}
+
+	// shutting down again is a no-op
+	srv.Shutdown()
+}
+
+func TestDiffDisks(t *testing.T) {
+	previous := map[uint32]shared.DiskInfo{
+		0: {Number: 0, Size: 100},
+		1: {Number: 1, Size: 100, Page83: "a"},
+		2: {Number: 2, Size: 100, IsOffline: true},
+		3: {Number: 3, Size: 100},
+	}
+
+	testCases := []struct {
+		name           string
+		current        map[uint32]shared.DiskInfo
+		expectedEvents []diskEvent
+	}{
+		{
+			name:    "no changes",
+			current: previous,
+		},
+		{
+			name: "added and removed",
+			current: map[uint32]shared.DiskInfo{
+				0: previous[0],
+				1: previous[1],
+				2: previous[2],
+				4: {Number: 4},
+			},
+			expectedEvents: []diskEvent{
+				{internal.DiskEventTypeRemoved, 3},
+				{internal.DiskEventTypeAdded, 4},
+			},
+		},
+		{
+			name: "replaced",
+			current: map[uint32]shared.DiskInfo{
+				0: previous[0],
+				1: {Number: 1, Size: 100, Page83: "b"},
+				2: previous[2],
+				3: previous[3],
+			},
+			expectedEvents: []diskEvent{
+				{internal.DiskEventTypeRemoved, 1},
+				{internal.DiskEventTypeAdded, 1},
+			},
+		},
+		{
+			name: "state and size changes",
+			current: map[uint32]shared.DiskInfo{
+				0: {Number: 0, Size: 200, IsOffline: true},
+				1: previous[1],
+				2: {Number: 2, Size: 100},
+				3: previous[3],
+			},
+			expectedEvents: []diskEvent{
+				{internal.DiskEventTypeOffline, 0},
+				{internal.DiskEventTypeResized, 0},
+				{internal.DiskEventTypeOnline, 2},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var events []diskEvent
+			for _, event := range diffDisks(previous, tc.current) {
+				events = append(events, diskEvent{event.Type, event.DiskNumber})
+			}
+			if !reflect.DeepEqual(events, tc.expectedEvents) {
+				t.Fatalf("Expected events %v, got: %v", tc.expectedEvents, events)
+			}
+		})
+	}
+}
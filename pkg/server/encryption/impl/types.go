@@ -0,0 +1,98 @@
+package impl
+
+type KeyProtectorType uint32
+
+const (
+	KeyProtectorTypeRecoveryPassword KeyProtectorType = iota
+	KeyProtectorTypePassword
+)
+
+type EncryptionMethod uint32
+
+const (
+	EncryptionMethodUnspecified EncryptionMethod = iota
+	EncryptionMethodNone
+	EncryptionMethodAes128WithDiffuser
+	EncryptionMethodAes256WithDiffuser
+	EncryptionMethodAes128
+	EncryptionMethodAes256
+	EncryptionMethodHardware
+	EncryptionMethodXtsAes128
+	EncryptionMethodXtsAes256
+)
+
+type ConversionStatus uint32
+
+const (
+	ConversionStatusUnknown ConversionStatus = iota
+	ConversionStatusFullyDecrypted
+	ConversionStatusFullyEncrypted
+	ConversionStatusEncryptionInProgress
+	ConversionStatusDecryptionInProgress
+	ConversionStatusEncryptionPaused
+	ConversionStatusDecryptionPaused
+)
+
+type ProtectionStatus uint32
+
+const (
+	ProtectionStatusUnknown ProtectionStatus = iota
+	ProtectionStatusOff
+	ProtectionStatusOn
+)
+
+type EnableEncryptionRequest struct {
+	// Volume device ID of the volume to encrypt, e.g. \\?\Volume{...}\
+	VolumeId         string
+	KeyProtectorType KeyProtectorType
+	// Recovery password or password of the key protector, never logged
+	Secret           string
+	EncryptionMethod EncryptionMethod
+	UsedSpaceOnly    bool
+}
+
+type EnableEncryptionResponse struct {
+	// ID of the added key protector, empty if the volume wasn't fully decrypted
+	KeyProtectorId string
+}
+
+type UnlockRequest struct {
+	VolumeId         string
+	KeyProtectorType KeyProtectorType
+	// Recovery password or password of one of the volume's key protectors, never logged
+	Secret string
+}
+
+type UnlockResponse struct {
+	// Intentionally empty
+}
+
+type LockRequest struct {
+	VolumeId      string
+	ForceDismount bool
+}
+
+type LockResponse struct {
+	// Intentionally empty
+}
+
+type GetEncryptionStatusRequest struct {
+	VolumeId string
+}
+
+type GetEncryptionStatusResponse struct {
+	ConversionStatus ConversionStatus
+	// Percentage of the volume which is encrypted, from 0 to 100
+	EncryptionPercentage uint32
+	ProtectionStatus     ProtectionStatus
+	Locked               bool
+	EncryptionMethod     EncryptionMethod
+}
+
+type DisableEncryptionRequest struct {
+	VolumeId string
+}
+
+type DisableEncryptionResponse struct {
+	// Intentionally empty
+}
@@ -0,0 +1,23 @@
+// Code generated by csi-proxy-api-gen. DO NOT EDIT.
+
+package impl
+
+import (
+	"context"
+
+	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+	"google.golang.org/grpc"
+)
+
+type VersionedAPI interface {
+	Register(grpcServer *grpc.Server)
+}
+
+// All the functions this group's server needs to define.
+type ServerInterface interface {
+	DisableEncryption(context.Context, *DisableEncryptionRequest, apiversion.Version) (*DisableEncryptionResponse, error)
+	EnableEncryption(context.Context, *EnableEncryptionRequest, apiversion.Version) (*EnableEncryptionResponse, error)
+	GetEncryptionStatus(context.Context, *GetEncryptionStatusRequest, apiversion.Version) (*GetEncryptionStatusResponse, error)
+	Lock(context.Context, *LockRequest, apiversion.Version) (*LockResponse, error)
+	Unlock(context.Context, *UnlockRequest, apiversion.Version) (*UnlockResponse, error)
+}
@@ -0,0 +1,224 @@
+// Code generated by csi-proxy-api-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/kubernetes-csi/csi-proxy/client/api/encryption/v1alpha1"
+	impl "github.com/kubernetes-csi/csi-proxy/pkg/server/encryption/impl"
+)
+
+func autoConvert_v1alpha1_DisableEncryptionRequest_To_impl_DisableEncryptionRequest(in *v1alpha1.DisableEncryptionRequest, out *impl.DisableEncryptionRequest) error {
+	out.VolumeId = in.VolumeId
+	return nil
+}
+
+// Convert_v1alpha1_DisableEncryptionRequest_To_impl_DisableEncryptionRequest is an autogenerated conversion function.
+func Convert_v1alpha1_DisableEncryptionRequest_To_impl_DisableEncryptionRequest(in *v1alpha1.DisableEncryptionRequest, out *impl.DisableEncryptionRequest) error {
+	return autoConvert_v1alpha1_DisableEncryptionRequest_To_impl_DisableEncryptionRequest(in, out)
+}
+
+func autoConvert_impl_DisableEncryptionRequest_To_v1alpha1_DisableEncryptionRequest(in *impl.DisableEncryptionRequest, out *v1alpha1.DisableEncryptionRequest) error {
+	out.VolumeId = in.VolumeId
+	return nil
+}
+
+// Convert_impl_DisableEncryptionRequest_To_v1alpha1_DisableEncryptionRequest is an autogenerated conversion function.
+func Convert_impl_DisableEncryptionRequest_To_v1alpha1_DisableEncryptionRequest(in *impl.DisableEncryptionRequest, out *v1alpha1.DisableEncryptionRequest) error {
+	return autoConvert_impl_DisableEncryptionRequest_To_v1alpha1_DisableEncryptionRequest(in, out)
+}
+
+func autoConvert_v1alpha1_DisableEncryptionResponse_To_impl_DisableEncryptionResponse(in *v1alpha1.DisableEncryptionResponse, out *impl.DisableEncryptionResponse) error {
+	return nil
+}
+
+// Convert_v1alpha1_DisableEncryptionResponse_To_impl_DisableEncryptionResponse is an autogenerated conversion function.
+func Convert_v1alpha1_DisableEncryptionResponse_To_impl_DisableEncryptionResponse(in *v1alpha1.DisableEncryptionResponse, out *impl.DisableEncryptionResponse) error {
+	return autoConvert_v1alpha1_DisableEncryptionResponse_To_impl_DisableEncryptionResponse(in, out)
+}
+
+func autoConvert_impl_DisableEncryptionResponse_To_v1alpha1_DisableEncryptionResponse(in *impl.DisableEncryptionResponse, out *v1alpha1.DisableEncryptionResponse) error {
+	return nil
+}
+
+// Convert_impl_DisableEncryptionResponse_To_v1alpha1_DisableEncryptionResponse is an autogenerated conversion function.
+func Convert_impl_DisableEncryptionResponse_To_v1alpha1_DisableEncryptionResponse(in *impl.DisableEncryptionResponse, out *v1alpha1.DisableEncryptionResponse) error {
+	return autoConvert_impl_DisableEncryptionResponse_To_v1alpha1_DisableEncryptionResponse(in, out)
+}
+
+func autoConvert_v1alpha1_EnableEncryptionRequest_To_impl_EnableEncryptionRequest(in *v1alpha1.EnableEncryptionRequest, out *impl.EnableEncryptionRequest) error {
+	out.VolumeId = in.VolumeId
+	out.KeyProtectorType = impl.KeyProtectorType(in.KeyProtectorType)
+	out.Secret = in.Secret
+	out.EncryptionMethod = impl.EncryptionMethod(in.EncryptionMethod)
+	out.UsedSpaceOnly = in.UsedSpaceOnly
+	return nil
+}
+
+// Convert_v1alpha1_EnableEncryptionRequest_To_impl_EnableEncryptionRequest is an autogenerated conversion function.
+func Convert_v1alpha1_EnableEncryptionRequest_To_impl_EnableEncryptionRequest(in *v1alpha1.EnableEncryptionRequest, out *impl.EnableEncryptionRequest) error {
+	return autoConvert_v1alpha1_EnableEncryptionRequest_To_impl_EnableEncryptionRequest(in, out)
+}
+
+func autoConvert_impl_EnableEncryptionRequest_To_v1alpha1_EnableEncryptionRequest(in *impl.EnableEncryptionRequest, out *v1alpha1.EnableEncryptionRequest) error {
+	out.VolumeId = in.VolumeId
+	out.KeyProtectorType = v1alpha1.KeyProtectorType(in.KeyProtectorType)
+	out.Secret = in.Secret
+	out.EncryptionMethod = v1alpha1.EncryptionMethod(in.EncryptionMethod)
+	out.UsedSpaceOnly = in.UsedSpaceOnly
+	return nil
+}
+
+// Convert_impl_EnableEncryptionRequest_To_v1alpha1_EnableEncryptionRequest is an autogenerated conversion function.
+func Convert_impl_EnableEncryptionRequest_To_v1alpha1_EnableEncryptionRequest(in *impl.EnableEncryptionRequest, out *v1alpha1.EnableEncryptionRequest) error {
+	return autoConvert_impl_EnableEncryptionRequest_To_v1alpha1_EnableEncryptionRequest(in, out)
+}
+
+func autoConvert_v1alpha1_EnableEncryptionResponse_To_impl_EnableEncryptionResponse(in *v1alpha1.EnableEncryptionResponse, out *impl.EnableEncryptionResponse) error {
+	out.KeyProtectorId = in.KeyProtectorId
+	return nil
+}
+
+// Convert_v1alpha1_EnableEncryptionResponse_To_impl_EnableEncryptionResponse is an autogenerated conversion function.
+func Convert_v1alpha1_EnableEncryptionResponse_To_impl_EnableEncryptionResponse(in *v1alpha1.EnableEncryptionResponse, out *impl.EnableEncryptionResponse) error {
+	return autoConvert_v1alpha1_EnableEncryptionResponse_To_impl_EnableEncryptionResponse(in, out)
+}
+
+func autoConvert_impl_EnableEncryptionResponse_To_v1alpha1_EnableEncryptionResponse(in *impl.EnableEncryptionResponse, out *v1alpha1.EnableEncryptionResponse) error {
+	out.KeyProtectorId = in.KeyProtectorId
+	return nil
+}
+
+// Convert_impl_EnableEncryptionResponse_To_v1alpha1_EnableEncryptionResponse is an autogenerated conversion function.
+func Convert_impl_EnableEncryptionResponse_To_v1alpha1_EnableEncryptionResponse(in *impl.EnableEncryptionResponse, out *v1alpha1.EnableEncryptionResponse) error {
+	return autoConvert_impl_EnableEncryptionResponse_To_v1alpha1_EnableEncryptionResponse(in, out)
+}
+
+func autoConvert_v1alpha1_GetEncryptionStatusRequest_To_impl_GetEncryptionStatusRequest(in *v1alpha1.GetEncryptionStatusRequest, out *impl.GetEncryptionStatusRequest) error {
+	out.VolumeId = in.VolumeId
+	return nil
+}
+
+// Convert_v1alpha1_GetEncryptionStatusRequest_To_impl_GetEncryptionStatusRequest is an autogenerated conversion function.
+func Convert_v1alpha1_GetEncryptionStatusRequest_To_impl_GetEncryptionStatusRequest(in *v1alpha1.GetEncryptionStatusRequest, out *impl.GetEncryptionStatusRequest) error {
+	return autoConvert_v1alpha1_GetEncryptionStatusRequest_To_impl_GetEncryptionStatusRequest(in, out)
+}
+
+func autoConvert_impl_GetEncryptionStatusRequest_To_v1alpha1_GetEncryptionStatusRequest(in *impl.GetEncryptionStatusRequest, out *v1alpha1.GetEncryptionStatusRequest) error {
+	out.VolumeId = in.VolumeId
+	return nil
+}
+
+// Convert_impl_GetEncryptionStatusRequest_To_v1alpha1_GetEncryptionStatusRequest is an autogenerated conversion function.
+func Convert_impl_GetEncryptionStatusRequest_To_v1alpha1_GetEncryptionStatusRequest(in *impl.GetEncryptionStatusRequest, out *v1alpha1.GetEncryptionStatusRequest) error {
+	return autoConvert_impl_GetEncryptionStatusRequest_To_v1alpha1_GetEncryptionStatusRequest(in, out)
+}
+
+func autoConvert_v1alpha1_GetEncryptionStatusResponse_To_impl_GetEncryptionStatusResponse(in *v1alpha1.GetEncryptionStatusResponse, out *impl.GetEncryptionStatusResponse) error {
+	out.ConversionStatus = impl.ConversionStatus(in.ConversionStatus)
+	out.EncryptionPercentage = in.EncryptionPercentage
+	out.ProtectionStatus = impl.ProtectionStatus(in.ProtectionStatus)
+	out.Locked = in.Locked
+	out.EncryptionMethod = impl.EncryptionMethod(in.EncryptionMethod)
+	return nil
+}
+
+// Convert_v1alpha1_GetEncryptionStatusResponse_To_impl_GetEncryptionStatusResponse is an autogenerated conversion function.
+func Convert_v1alpha1_GetEncryptionStatusResponse_To_impl_GetEncryptionStatusResponse(in *v1alpha1.GetEncryptionStatusResponse, out *impl.GetEncryptionStatusResponse) error {
+	return autoConvert_v1alpha1_GetEncryptionStatusResponse_To_impl_GetEncryptionStatusResponse(in, out)
+}
+
+func autoConvert_impl_GetEncryptionStatusResponse_To_v1alpha1_GetEncryptionStatusResponse(in *impl.GetEncryptionStatusResponse, out *v1alpha1.GetEncryptionStatusResponse) error {
+	out.ConversionStatus = v1alpha1.ConversionStatus(in.ConversionStatus)
+	out.EncryptionPercentage = in.EncryptionPercentage
+	out.ProtectionStatus = v1alpha1.ProtectionStatus(in.ProtectionStatus)
+	out.Locked = in.Locked
+	out.EncryptionMethod = v1alpha1.EncryptionMethod(in.EncryptionMethod)
+	return nil
+}
+
+// Convert_impl_GetEncryptionStatusResponse_To_v1alpha1_GetEncryptionStatusResponse is an autogenerated conversion function.
+func Convert_impl_GetEncryptionStatusResponse_To_v1alpha1_GetEncryptionStatusResponse(in *impl.GetEncryptionStatusResponse, out *v1alpha1.GetEncryptionStatusResponse) error {
+	return autoConvert_impl_GetEncryptionStatusResponse_To_v1alpha1_GetEncryptionStatusResponse(in, out)
+}
+
+func autoConvert_v1alpha1_LockRequest_To_impl_LockRequest(in *v1alpha1.LockRequest, out *impl.LockRequest) error {
+	out.VolumeId = in.VolumeId
+	out.ForceDismount = in.ForceDismount
+	return nil
+}
+
+// Convert_v1alpha1_LockRequest_To_impl_LockRequest is an autogenerated conversion function.
+func Convert_v1alpha1_LockRequest_To_impl_LockRequest(in *v1alpha1.LockRequest, out *impl.LockRequest) error {
+	return autoConvert_v1alpha1_LockRequest_To_impl_LockRequest(in, out)
+}
+
+func autoConvert_impl_LockRequest_To_v1alpha1_LockRequest(in *impl.LockRequest, out *v1alpha1.LockRequest) error {
+	out.VolumeId = in.VolumeId
+	out.ForceDismount = in.ForceDismount
+	return nil
+}
+
+// Convert_impl_LockRequest_To_v1alpha1_LockRequest is an autogenerated conversion function.
+func Convert_impl_LockRequest_To_v1alpha1_LockRequest(in *impl.LockRequest, out *v1alpha1.LockRequest) error {
+	return autoConvert_impl_LockRequest_To_v1alpha1_LockRequest(in, out)
+}
+
+func autoConvert_v1alpha1_LockResponse_To_impl_LockResponse(in *v1alpha1.LockResponse, out *impl.LockResponse) error {
+	return nil
+}
+
+// Convert_v1alpha1_LockResponse_To_impl_LockResponse is an autogenerated conversion function.
+func Convert_v1alpha1_LockResponse_To_impl_LockResponse(in *v1alpha1.LockResponse, out *impl.LockResponse) error {
+	return autoConvert_v1alpha1_LockResponse_To_impl_LockResponse(in, out)
+}
+
+func autoConvert_impl_LockResponse_To_v1alpha1_LockResponse(in *impl.LockResponse, out *v1alpha1.LockResponse) error {
+	return nil
+}
+
+// Convert_impl_LockResponse_To_v1alpha1_LockResponse is an autogenerated conversion function.
+func Convert_impl_LockResponse_To_v1alpha1_LockResponse(in *impl.LockResponse, out *v1alpha1.LockResponse) error {
+	return autoConvert_impl_LockResponse_To_v1alpha1_LockResponse(in, out)
+}
+
+func autoConvert_v1alpha1_UnlockRequest_To_impl_UnlockRequest(in *v1alpha1.UnlockRequest, out *impl.UnlockRequest) error {
+	out.VolumeId = in.VolumeId
+	out.KeyProtectorType = impl.KeyProtectorType(in.KeyProtectorType)
+	out.Secret = in.Secret
+	return nil
+}
+
+// Convert_v1alpha1_UnlockRequest_To_impl_UnlockRequest is an autogenerated conversion function.
+func Convert_v1alpha1_UnlockRequest_To_impl_UnlockRequest(in *v1alpha1.UnlockRequest, out *impl.UnlockRequest) error {
+	return autoConvert_v1alpha1_UnlockRequest_To_impl_UnlockRequest(in, out)
+}
+
+func autoConvert_impl_UnlockRequest_To_v1alpha1_UnlockRequest(in *impl.UnlockRequest, out *v1alpha1.UnlockRequest) error {
+	out.VolumeId = in.VolumeId
+	out.KeyProtectorType = v1alpha1.KeyProtectorType(in.KeyProtectorType)
+	out.Secret = in.Secret
+	return nil
+}
+
+// Convert_impl_UnlockRequest_To_v1alpha1_UnlockRequest is an autogenerated conversion function.
+func Convert_impl_UnlockRequest_To_v1alpha1_UnlockRequest(in *impl.UnlockRequest, out *v1alpha1.UnlockRequest) error {
+	return autoConvert_impl_UnlockRequest_To_v1alpha1_UnlockRequest(in, out)
+}
+
+func autoConvert_v1alpha1_UnlockResponse_To_impl_UnlockResponse(in *v1alpha1.UnlockResponse, out *impl.UnlockResponse) error {
+	return nil
+}
+
+// Convert_v1alpha1_UnlockResponse_To_impl_UnlockResponse is an autogenerated conversion function.
+func Convert_v1alpha1_UnlockResponse_To_impl_UnlockResponse(in *v1alpha1.UnlockResponse, out *impl.UnlockResponse) error {
+	return autoConvert_v1alpha1_UnlockResponse_To_impl_UnlockResponse(in, out)
+}
+
+func autoConvert_impl_UnlockResponse_To_v1alpha1_UnlockResponse(in *impl.UnlockResponse, out *v1alpha1.UnlockResponse) error {
+	return nil
+}
+
+// Convert_impl_UnlockResponse_To_v1alpha1_UnlockResponse is an autogenerated conversion function.
+func Convert_impl_UnlockResponse_To_v1alpha1_UnlockResponse(in *impl.UnlockResponse, out *v1alpha1.UnlockResponse) error {
+	return autoConvert_impl_UnlockResponse_To_v1alpha1_UnlockResponse(in, out)
+}
@@ -0,0 +1,123 @@
+// Code generated by csi-proxy-api-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/kubernetes-csi/csi-proxy/client/api/encryption/v1alpha1"
+	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/encryption/impl"
+	"google.golang.org/grpc"
+)
+
+var version = apiversion.NewVersionOrPanic("v1alpha1")
+
+type versionedAPI struct {
+	apiGroupServer impl.ServerInterface
+}
+
+func NewVersionedServer(apiGroupServer impl.ServerInterface) impl.VersionedAPI {
+	return &versionedAPI{
+		apiGroupServer: apiGroupServer,
+	}
+}
+
+func (s *versionedAPI) Register(grpcServer *grpc.Server) {
+	v1alpha1.RegisterEncryptionServer(grpcServer, s)
+}
+
+func (s *versionedAPI) DisableEncryption(context context.Context, versionedRequest *v1alpha1.DisableEncryptionRequest) (*v1alpha1.DisableEncryptionResponse, error) {
+	request := &impl.DisableEncryptionRequest{}
+	if err := Convert_v1alpha1_DisableEncryptionRequest_To_impl_DisableEncryptionRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.DisableEncryption(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v1alpha1.DisableEncryptionResponse{}
+	if err := Convert_impl_DisableEncryptionResponse_To_v1alpha1_DisableEncryptionResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) EnableEncryption(context context.Context, versionedRequest *v1alpha1.EnableEncryptionRequest) (*v1alpha1.EnableEncryptionResponse, error) {
+	request := &impl.EnableEncryptionRequest{}
+	if err := Convert_v1alpha1_EnableEncryptionRequest_To_impl_EnableEncryptionRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.EnableEncryption(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v1alpha1.EnableEncryptionResponse{}
+	if err := Convert_impl_EnableEncryptionResponse_To_v1alpha1_EnableEncryptionResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) GetEncryptionStatus(context context.Context, versionedRequest *v1alpha1.GetEncryptionStatusRequest) (*v1alpha1.GetEncryptionStatusResponse, error) {
+	request := &impl.GetEncryptionStatusRequest{}
+	if err := Convert_v1alpha1_GetEncryptionStatusRequest_To_impl_GetEncryptionStatusRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.GetEncryptionStatus(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v1alpha1.GetEncryptionStatusResponse{}
+	if err := Convert_impl_GetEncryptionStatusResponse_To_v1alpha1_GetEncryptionStatusResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) Lock(context context.Context, versionedRequest *v1alpha1.LockRequest) (*v1alpha1.LockResponse, error) {
+	request := &impl.LockRequest{}
+	if err := Convert_v1alpha1_LockRequest_To_impl_LockRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.Lock(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v1alpha1.LockResponse{}
+	if err := Convert_impl_LockResponse_To_v1alpha1_LockResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) Unlock(context context.Context, versionedRequest *v1alpha1.UnlockRequest) (*v1alpha1.UnlockResponse, error) {
+	request := &impl.UnlockRequest{}
+	if err := Convert_v1alpha1_UnlockRequest_To_impl_UnlockRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.Unlock(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v1alpha1.UnlockResponse{}
+	if err := Convert_impl_UnlockResponse_To_v1alpha1_UnlockResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
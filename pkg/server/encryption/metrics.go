@@ -0,0 +1,49 @@
+package encryption
+
+import (
+	"time"
+
+	"github.com/kubernetes-csi/csi-proxy/pkg/os/encryption"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/metrics"
+)
+
+// instrumentedAPI records the duration and the errors of the calls to the encryption host API.
+type instrumentedAPI struct {
+	hostAPI encryption.API
+}
+
+// NewInstrumentedAPI returns an encryption.API recording the duration and the errors of the calls to hostAPI
+// in the host API metrics.
+func NewInstrumentedAPI(hostAPI encryption.API) encryption.API {
+	return &instrumentedAPI{hostAPI: hostAPI}
+}
+
+func (i *instrumentedAPI) GetEncryptionStatus(volumeID string) (_ *encryption.EncryptionStatus, err error) {
+	defer metrics.ObserveHostAPICall("encryption", "GetEncryptionStatus", time.Now(), &err)
+	return i.hostAPI.GetEncryptionStatus(volumeID)
+}
+
+func (i *instrumentedAPI) AddKeyProtector(volumeID string, protectorType encryption.KeyProtectorType, secret string) (_ string, err error) {
+	defer metrics.ObserveHostAPICall("encryption", "AddKeyProtector", time.Now(), &err)
+	return i.hostAPI.AddKeyProtector(volumeID, protectorType, secret)
+}
+
+func (i *instrumentedAPI) Encrypt(volumeID string, encryptionMethod uint32, usedSpaceOnly bool) (err error) {
+	defer metrics.ObserveHostAPICall("encryption", "Encrypt", time.Now(), &err)
+	return i.hostAPI.Encrypt(volumeID, encryptionMethod, usedSpaceOnly)
+}
+
+func (i *instrumentedAPI) Unlock(volumeID string, protectorType encryption.KeyProtectorType, secret string) (err error) {
+	defer metrics.ObserveHostAPICall("encryption", "Unlock", time.Now(), &err)
+	return i.hostAPI.Unlock(volumeID, protectorType, secret)
+}
+
+func (i *instrumentedAPI) Lock(volumeID string, forceDismount bool) (err error) {
+	defer metrics.ObserveHostAPICall("encryption", "Lock", time.Now(), &err)
+	return i.hostAPI.Lock(volumeID, forceDismount)
+}
+
+func (i *instrumentedAPI) Decrypt(volumeID string) (err error) {
+	defer metrics.ObserveHostAPICall("encryption", "Decrypt", time.Now(), &err)
+	return i.hostAPI.Decrypt(volumeID)
+}
@@ -0,0 +1,309 @@
+package encryption
+
+import (
+	"context"
+
+	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+	"github.com/kubernetes-csi/csi-proxy/pkg/os/encryption"
+	internal "github.com/kubernetes-csi/csi-proxy/pkg/server/encryption/impl"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/guard"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/locks"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/tracing"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+// The requests' secrets are never logged: the requests are logged field by field.
+
+// VolumeAPI is the part of the volume host API the Server queries for the disk of a volume.
+type VolumeAPI interface {
+	// GetDiskNumberFromVolumeID returns the disk number for a given volumeID.
+	GetDiskNumberFromVolumeID(volumeID string) (uint32, error)
+}
+
+type Server struct {
+	hostAPI   encryption.API
+	volumeAPI VolumeAPI
+	locks     *locks.Manager
+	guard     *guard.Guard
+}
+
+// check that Server implements internal.ServerInterface
+var _ internal.ServerInterface = &Server{}
+
+// NewServer returns a Server serializing its mutating operations on the same volume with lockManager,
+// and refusing to encrypt, decrypt or lock the volumes of the disks diskGuard protects.
+func NewServer(hostAPI encryption.API, volumeAPI VolumeAPI, lockManager *locks.Manager, diskGuard *guard.Guard) (*Server, error) {
+	return &Server{
+		hostAPI:   hostAPI,
+		volumeAPI: volumeAPI,
+		locks:     lockManager,
+		guard:     diskGuard,
+	}, nil
+}
+
+// The Win32_EncryptableVolume GetConversionStatus statuses the server acts on.
+const (
+	wmiConversionStatusFullyDecrypted       = 0
+	wmiConversionStatusDecryptionInProgress = 3
+)
+
+var (
+	// keyProtectorTypes maps the key protector types of the requests to the host API's.
+	keyProtectorTypes = map[internal.KeyProtectorType]encryption.KeyProtectorType{
+		internal.KeyProtectorTypeRecoveryPassword: encryption.KeyProtectorTypeRecoveryPassword,
+		internal.KeyProtectorTypePassword:         encryption.KeyProtectorTypePassword,
+	}
+
+	// encryptionMethods maps the encryption methods EnableEncryption accepts to the Win32_EncryptableVolume
+	// Encrypt ones, the methods with a diffuser are deprecated.
+	encryptionMethods = map[internal.EncryptionMethod]uint32{
+		internal.EncryptionMethodUnspecified: 6,
+		internal.EncryptionMethodAes128:      3,
+		internal.EncryptionMethodAes256:      4,
+		internal.EncryptionMethodXtsAes128:   6,
+		internal.EncryptionMethodXtsAes256:   7,
+	}
+
+	// wmiEncryptionMethods maps the Win32_EncryptableVolume GetEncryptionMethod methods.
+	wmiEncryptionMethods = map[uint32]internal.EncryptionMethod{
+		0: internal.EncryptionMethodNone,
+		1: internal.EncryptionMethodAes128WithDiffuser,
+		2: internal.EncryptionMethodAes256WithDiffuser,
+		3: internal.EncryptionMethodAes128,
+		4: internal.EncryptionMethodAes256,
+		5: internal.EncryptionMethodHardware,
+		6: internal.EncryptionMethodXtsAes128,
+		7: internal.EncryptionMethodXtsAes256,
+	}
+
+	// wmiConversionStatuses maps the Win32_EncryptableVolume GetConversionStatus statuses.
+	wmiConversionStatuses = map[uint32]internal.ConversionStatus{
+		wmiConversionStatusFullyDecrypted:       internal.ConversionStatusFullyDecrypted,
+		1:                                       internal.ConversionStatusFullyEncrypted,
+		2:                                       internal.ConversionStatusEncryptionInProgress,
+		wmiConversionStatusDecryptionInProgress: internal.ConversionStatusDecryptionInProgress,
+		4:                                       internal.ConversionStatusEncryptionPaused,
+		5:                                       internal.ConversionStatusDecryptionPaused,
+	}
+
+	// wmiProtectionStatuses maps the Win32_EncryptableVolume GetProtectionStatus statuses, 2 is unknown.
+	wmiProtectionStatuses = map[uint32]internal.ProtectionStatus{
+		0: internal.ProtectionStatusOff,
+		1: internal.ProtectionStatusOn,
+	}
+)
+
+func (s *Server) EnableEncryption(context context.Context, request *internal.EnableEncryptionRequest, version apiversion.Version) (*internal.EnableEncryptionResponse, error) {
+	defer tracing.StartHostAPISpan(context, "encryption", "EnableEncryption")()
+	klog.V(2).Infof("Request: EnableEncryption: volumeID=%s, keyProtectorType=%d, encryptionMethod=%d, usedSpaceOnly=%t",
+		request.VolumeId, request.KeyProtectorType, request.EncryptionMethod, request.UsedSpaceOnly)
+	response := &internal.EnableEncryptionResponse{}
+	volumeID := request.VolumeId
+	if volumeID == "" {
+		return response, status.Error(codes.InvalidArgument, "volume id empty")
+	}
+	protectorType, ok := keyProtectorTypes[request.KeyProtectorType]
+	if !ok {
+		return response, status.Errorf(codes.InvalidArgument, "unknown key protector type %d", request.KeyProtectorType)
+	}
+	if request.Secret == "" {
+		return response, status.Error(codes.InvalidArgument, "secret empty")
+	}
+	encryptionMethod, ok := encryptionMethods[request.EncryptionMethod]
+	if !ok {
+		return response, status.Errorf(codes.InvalidArgument, "unsupported encryption method %d", request.EncryptionMethod)
+	}
+
+	release, err := s.locks.TryAcquire(locks.VolumeResource(volumeID), "EnableEncryption")
+	if err != nil {
+		klog.Errorf("EnableEncryption failed: %v", err)
+		return response, err
+	}
+	defer release()
+
+	if err := s.checkVolume(volumeID, "EnableEncryption"); err != nil {
+		klog.Errorf("EnableEncryption failed: %v", err)
+		return response, err
+	}
+
+	encryptionStatus, err := s.hostAPI.GetEncryptionStatus(volumeID)
+	if err != nil {
+		klog.Errorf("EnableEncryption failed: %v", err)
+		return response, err
+	}
+	if encryptionStatus.Locked || encryptionStatus.ConversionStatus != wmiConversionStatusFullyDecrypted {
+		klog.V(2).Infof("Encryption of volume %s already enabled, conversion status %d", volumeID, encryptionStatus.ConversionStatus)
+		return response, nil
+	}
+
+	keyProtectorID, err := s.hostAPI.AddKeyProtector(volumeID, protectorType, request.Secret)
+	if err != nil {
+		klog.Errorf("EnableEncryption failed: %v", err)
+		return response, err
+	}
+	klog.V(2).Infof("Added key protector %s to volume %s", keyProtectorID, volumeID)
+
+	if err := s.hostAPI.Encrypt(volumeID, encryptionMethod, request.UsedSpaceOnly); err != nil {
+		klog.Errorf("EnableEncryption failed: %v", err)
+		return response, err
+	}
+
+	response.KeyProtectorId = keyProtectorID
+	return response, nil
+}
+
+func (s *Server) Unlock(context context.Context, request *internal.UnlockRequest, version apiversion.Version) (*internal.UnlockResponse, error) {
+	defer tracing.StartHostAPISpan(context, "encryption", "Unlock")()
+	klog.V(2).Infof("Request: Unlock: volumeID=%s, keyProtectorType=%d", request.VolumeId, request.KeyProtectorType)
+	response := &internal.UnlockResponse{}
+	volumeID := request.VolumeId
+	if volumeID == "" {
+		return response, status.Error(codes.InvalidArgument, "volume id empty")
+	}
+	protectorType, ok := keyProtectorTypes[request.KeyProtectorType]
+	if !ok {
+		return response, status.Errorf(codes.InvalidArgument, "unknown key protector type %d", request.KeyProtectorType)
+	}
+	if request.Secret == "" {
+		return response, status.Error(codes.InvalidArgument, "secret empty")
+	}
+
+	release, err := s.locks.TryAcquire(locks.VolumeResource(volumeID), "Unlock")
+	if err != nil {
+		klog.Errorf("Unlock failed: %v", err)
+		return response, err
+	}
+	defer release()
+
+	encryptionStatus, err := s.hostAPI.GetEncryptionStatus(volumeID)
+	if err != nil {
+		klog.Errorf("Unlock failed: %v", err)
+		return response, err
+	}
+	if !encryptionStatus.Locked {
+		klog.V(2).Infof("Volume %s is not locked", volumeID)
+		return response, nil
+	}
+
+	if err := s.hostAPI.Unlock(volumeID, protectorType, request.Secret); err != nil {
+		klog.Errorf("Unlock failed: %v", err)
+		return response, err
+	}
+	return response, nil
+}
+
+func (s *Server) Lock(context context.Context, request *internal.LockRequest, version apiversion.Version) (*internal.LockResponse, error) {
+	defer tracing.StartHostAPISpan(context, "encryption", "Lock")()
+	klog.V(2).Infof("Request: Lock: volumeID=%s, forceDismount=%t", request.VolumeId, request.ForceDismount)
+	response := &internal.LockResponse{}
+	volumeID := request.VolumeId
+	if volumeID == "" {
+		return response, status.Error(codes.InvalidArgument, "volume id empty")
+	}
+
+	release, err := s.locks.TryAcquire(locks.VolumeResource(volumeID), "Lock")
+	if err != nil {
+		klog.Errorf("Lock failed: %v", err)
+		return response, err
+	}
+	defer release()
+
+	if err := s.checkVolume(volumeID, "Lock"); err != nil {
+		klog.Errorf("Lock failed: %v", err)
+		return response, err
+	}
+
+	encryptionStatus, err := s.hostAPI.GetEncryptionStatus(volumeID)
+	if err != nil {
+		klog.Errorf("Lock failed: %v", err)
+		return response, err
+	}
+	if encryptionStatus.Locked {
+		klog.V(2).Infof("Volume %s is already locked", volumeID)
+		return response, nil
+	}
+
+	if err := s.hostAPI.Lock(volumeID, request.ForceDismount); err != nil {
+		klog.Errorf("Lock failed: %v", err)
+		return response, err
+	}
+	return response, nil
+}
+
+func (s *Server) GetEncryptionStatus(context context.Context, request *internal.GetEncryptionStatusRequest, version apiversion.Version) (*internal.GetEncryptionStatusResponse, error) {
+	defer tracing.StartHostAPISpan(context, "encryption", "GetEncryptionStatus")()
+	klog.V(4).Infof("Request: GetEncryptionStatus: volumeID=%s", request.VolumeId)
+	response := &internal.GetEncryptionStatusResponse{}
+	if request.VolumeId == "" {
+		return response, status.Error(codes.InvalidArgument, "volume id empty")
+	}
+
+	encryptionStatus, err := s.hostAPI.GetEncryptionStatus(request.VolumeId)
+	if err != nil {
+		klog.Errorf("GetEncryptionStatus failed: %v", err)
+		return response, err
+	}
+
+	response.Locked = encryptionStatus.Locked
+	response.ProtectionStatus = wmiProtectionStatuses[encryptionStatus.ProtectionStatus]
+	// the conversion status and the encryption method of a locked volume are unknown
+	if !encryptionStatus.Locked {
+		response.ConversionStatus = wmiConversionStatuses[encryptionStatus.ConversionStatus]
+		response.EncryptionPercentage = encryptionStatus.EncryptionPercentage
+		response.EncryptionMethod = wmiEncryptionMethods[encryptionStatus.EncryptionMethod]
+	}
+	return response, nil
+}
+
+func (s *Server) DisableEncryption(context context.Context, request *internal.DisableEncryptionRequest, version apiversion.Version) (*internal.DisableEncryptionResponse, error) {
+	defer tracing.StartHostAPISpan(context, "encryption", "DisableEncryption")()
+	klog.V(2).Infof("Request: DisableEncryption: volumeID=%s", request.VolumeId)
+	response := &internal.DisableEncryptionResponse{}
+	volumeID := request.VolumeId
+	if volumeID == "" {
+		return response, status.Error(codes.InvalidArgument, "volume id empty")
+	}
+
+	release, err := s.locks.TryAcquire(locks.VolumeResource(volumeID), "DisableEncryption")
+	if err != nil {
+		klog.Errorf("DisableEncryption failed: %v", err)
+		return response, err
+	}
+	defer release()
+
+	if err := s.checkVolume(volumeID, "DisableEncryption"); err != nil {
+		klog.Errorf("DisableEncryption failed: %v", err)
+		return response, err
+	}
+
+	encryptionStatus, err := s.hostAPI.GetEncryptionStatus(volumeID)
+	if err != nil {
+		klog.Errorf("DisableEncryption failed: %v", err)
+		return response, err
+	}
+	if encryptionStatus.Locked {
+		return response, status.Errorf(codes.FailedPrecondition, "volume %s is locked", volumeID)
+	}
+	if encryptionStatus.ConversionStatus == wmiConversionStatusFullyDecrypted || encryptionStatus.ConversionStatus == wmiConversionStatusDecryptionInProgress {
+		klog.V(2).Infof("Encryption of volume %s already disabled, conversion status %d", volumeID, encryptionStatus.ConversionStatus)
+		return response, nil
+	}
+
+	if err := s.hostAPI.Decrypt(volumeID); err != nil {
+		klog.Errorf("DisableEncryption failed: %v", err)
+		return response, err
+	}
+	return response, nil
+}
+
+// checkVolume returns a FailedPrecondition status error if the guard protects the disk of the volume
+// volumeID from operation.
+func (s *Server) checkVolume(volumeID, operation string) error {
+	diskNumber, err := s.volumeAPI.GetDiskNumberFromVolumeID(volumeID)
+	if err != nil {
+		return err
+	}
+	return s.guard.CheckDisk(diskNumber, operation)
+}
@@ -0,0 +1,262 @@
+package encryption
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+	"github.com/kubernetes-csi/csi-proxy/pkg/os/encryption"
+	internal "github.com/kubernetes-csi/csi-proxy/pkg/server/encryption/impl"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/guard"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/locks"
+	shared "github.com/kubernetes-csi/csi-proxy/pkg/shared/disk"
+	"github.com/kubernetes-csi/csi-proxy/pkg/wmi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	dataVolumeID = `\\?\Volume{data}\`
+	bootVolumeID = `\\?\Volume{boot}\`
+)
+
+// fakeEncryptionAPI keeps the BitLocker state of the volumes in memory, the encryption and the decryption
+// stay in progress at 40% until the test changes the state.
+type fakeEncryptionAPI struct {
+	statuses map[string]*encryption.EncryptionStatus
+	secrets  map[string][]string
+	calls    []string
+}
+
+var _ encryption.API = &fakeEncryptionAPI{}
+
+func newFakeEncryptionAPI() *fakeEncryptionAPI {
+	return &fakeEncryptionAPI{
+		statuses: map[string]*encryption.EncryptionStatus{
+			dataVolumeID: {},
+			bootVolumeID: {},
+		},
+		secrets: map[string][]string{},
+	}
+}
+
+func (f *fakeEncryptionAPI) GetEncryptionStatus(volumeID string) (*encryption.EncryptionStatus, error) {
+	encryptionStatus, ok := f.statuses[volumeID]
+	if !ok {
+		return nil, fmt.Errorf("failed to query encryptable volume %s: %w", volumeID, wmi.ErrNotFound)
+	}
+	statusCopy := *encryptionStatus
+	return &statusCopy, nil
+}
+
+func (f *fakeEncryptionAPI) AddKeyProtector(volumeID string, protectorType encryption.KeyProtectorType, secret string) (string, error) {
+	f.calls = append(f.calls, "AddKeyProtector")
+	f.secrets[volumeID] = append(f.secrets[volumeID], secret)
+	return fmt.Sprintf("{%08d-0000-0000-0000-000000000000}", len(f.secrets[volumeID])), nil
+}
+
+func (f *fakeEncryptionAPI) Encrypt(volumeID string, encryptionMethod uint32, usedSpaceOnly bool) error {
+	f.calls = append(f.calls, "Encrypt")
+	f.statuses[volumeID] = &encryption.EncryptionStatus{
+		ConversionStatus:     2,
+		EncryptionPercentage: 40,
+		ProtectionStatus:     1,
+		EncryptionMethod:     encryptionMethod,
+	}
+	return nil
+}
+
+func (f *fakeEncryptionAPI) Unlock(volumeID string, protectorType encryption.KeyProtectorType, secret string) error {
+	f.calls = append(f.calls, "Unlock")
+	for _, s := range f.secrets[volumeID] {
+		if s == secret {
+			f.statuses[volumeID].Locked = false
+			return nil
+		}
+	}
+	return &wmi.WMIError{Class: wmi.Win32EncryptableVolumeClass, Method: "UnlockWithPassphrase", Code: 0x80310027}
+}
+
+func (f *fakeEncryptionAPI) Lock(volumeID string, forceDismount bool) error {
+	f.calls = append(f.calls, "Lock")
+	f.statuses[volumeID].Locked = true
+	return nil
+}
+
+func (f *fakeEncryptionAPI) Decrypt(volumeID string) error {
+	f.calls = append(f.calls, "Decrypt")
+	f.statuses[volumeID].ConversionStatus = 3
+	return nil
+}
+
+// fakeVolumeAPI puts the boot volume on disk 0, the other volumes on disk 1.
+type fakeVolumeAPI struct{}
+
+func (fakeVolumeAPI) GetDiskNumberFromVolumeID(volumeID string) (uint32, error) {
+	if volumeID == bootVolumeID {
+		return 0, nil
+	}
+	return 1, nil
+}
+
+// fakeDiskAPI reports disk 0 as the boot disk.
+type fakeDiskAPI struct{}
+
+func (fakeDiskAPI) GetDiskInfo(diskNumber uint32) (*shared.DiskInfo, error) {
+	return &shared.DiskInfo{Number: diskNumber, IsBoot: diskNumber == 0, IsSystem: diskNumber == 0}, nil
+}
+
+func (fakeDiskAPI) ListPageFileDisks() ([]uint32, error) {
+	return []uint32{0}, nil
+}
+
+func newTestServer(t *testing.T, hostAPI encryption.API) *Server {
+	srv, err := NewServer(hostAPI, fakeVolumeAPI{}, locks.NewManager(), guard.NewGuard(fakeDiskAPI{}, guard.DefaultPolicy()))
+	require.NoError(t, err)
+	return srv
+}
+
+func TestEnableAndDisableEncryption(t *testing.T) {
+	v1alpha1, err := apiversion.NewVersion("v1alpha1")
+	require.NoError(t, err)
+	hostAPI := newFakeEncryptionAPI()
+	srv := newTestServer(t, hostAPI)
+
+	request := &internal.EnableEncryptionRequest{
+		VolumeId:         dataVolumeID,
+		KeyProtectorType: internal.KeyProtectorTypePassword,
+		Secret:           "hunter2hunter2",
+	}
+	response, err := srv.EnableEncryption(context.TODO(), request, v1alpha1)
+	require.NoError(t, err)
+	assert.NotEmpty(t, response.KeyProtectorId)
+	assert.Equal(t, []string{"AddKeyProtector", "Encrypt"}, hostAPI.calls)
+	assert.Equal(t, uint32(6), hostAPI.statuses[dataVolumeID].EncryptionMethod, "XTS-AES 128 is the default encryption method")
+
+	// enabling the encryption of a volume being encrypted doesn't add a key protector
+	response, err = srv.EnableEncryption(context.TODO(), request, v1alpha1)
+	require.NoError(t, err)
+	assert.Empty(t, response.KeyProtectorId)
+	assert.Len(t, hostAPI.calls, 2)
+
+	statusResponse, err := srv.GetEncryptionStatus(context.TODO(), &internal.GetEncryptionStatusRequest{VolumeId: dataVolumeID}, v1alpha1)
+	require.NoError(t, err)
+	assert.Equal(t, &internal.GetEncryptionStatusResponse{
+		ConversionStatus:     internal.ConversionStatusEncryptionInProgress,
+		EncryptionPercentage: 40,
+		ProtectionStatus:     internal.ProtectionStatusOn,
+		EncryptionMethod:     internal.EncryptionMethodXtsAes128,
+	}, statusResponse)
+
+	_, err = srv.DisableEncryption(context.TODO(), &internal.DisableEncryptionRequest{VolumeId: dataVolumeID}, v1alpha1)
+	require.NoError(t, err)
+	assert.Equal(t, "Decrypt", hostAPI.calls[len(hostAPI.calls)-1])
+
+	// disabling the encryption of a volume being decrypted succeeds
+	_, err = srv.DisableEncryption(context.TODO(), &internal.DisableEncryptionRequest{VolumeId: dataVolumeID}, v1alpha1)
+	require.NoError(t, err)
+	assert.Len(t, hostAPI.calls, 3)
+
+	statusResponse, err = srv.GetEncryptionStatus(context.TODO(), &internal.GetEncryptionStatusRequest{VolumeId: dataVolumeID}, v1alpha1)
+	require.NoError(t, err)
+	assert.Equal(t, internal.ConversionStatusDecryptionInProgress, statusResponse.ConversionStatus)
+}
+
+func TestEnableEncryptionErrors(t *testing.T) {
+	v1alpha1, err := apiversion.NewVersion("v1alpha1")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name         string
+		request      *internal.EnableEncryptionRequest
+		expectedCode codes.Code
+	}{
+		{
+			name:         "empty volume id",
+			request:      &internal.EnableEncryptionRequest{Secret: "hunter2hunter2"},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "empty secret",
+			request:      &internal.EnableEncryptionRequest{VolumeId: dataVolumeID},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "unknown key protector type",
+			request:      &internal.EnableEncryptionRequest{VolumeId: dataVolumeID, KeyProtectorType: 7, Secret: "hunter2hunter2"},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "unsupported encryption method",
+			request:      &internal.EnableEncryptionRequest{VolumeId: dataVolumeID, Secret: "hunter2hunter2", EncryptionMethod: internal.EncryptionMethodHardware},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "protected disk",
+			request:      &internal.EnableEncryptionRequest{VolumeId: bootVolumeID, Secret: "hunter2hunter2"},
+			expectedCode: codes.FailedPrecondition,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			hostAPI := newFakeEncryptionAPI()
+			srv := newTestServer(t, hostAPI)
+
+			_, err := srv.EnableEncryption(context.TODO(), tc.request, v1alpha1)
+			assert.Equal(t, tc.expectedCode, status.Code(err), "err=%v", err)
+			assert.Empty(t, hostAPI.calls)
+		})
+	}
+}
+
+func TestLockAndUnlock(t *testing.T) {
+	v1alpha1, err := apiversion.NewVersion("v1alpha1")
+	require.NoError(t, err)
+	hostAPI := newFakeEncryptionAPI()
+	srv := newTestServer(t, hostAPI)
+
+	_, err = srv.EnableEncryption(context.TODO(), &internal.EnableEncryptionRequest{
+		VolumeId:         dataVolumeID,
+		KeyProtectorType: internal.KeyProtectorTypePassword,
+		Secret:           "hunter2hunter2",
+	}, v1alpha1)
+	require.NoError(t, err)
+
+	_, err = srv.Lock(context.TODO(), &internal.LockRequest{VolumeId: dataVolumeID}, v1alpha1)
+	require.NoError(t, err)
+	// locking a locked volume succeeds
+	_, err = srv.Lock(context.TODO(), &internal.LockRequest{VolumeId: dataVolumeID}, v1alpha1)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"AddKeyProtector", "Encrypt", "Lock"}, hostAPI.calls)
+
+	statusResponse, err := srv.GetEncryptionStatus(context.TODO(), &internal.GetEncryptionStatusRequest{VolumeId: dataVolumeID}, v1alpha1)
+	require.NoError(t, err)
+	assert.True(t, statusResponse.Locked)
+	assert.Equal(t, internal.ConversionStatusUnknown, statusResponse.ConversionStatus, "the conversion status of a locked volume is unknown")
+
+	_, err = srv.DisableEncryption(context.TODO(), &internal.DisableEncryptionRequest{VolumeId: dataVolumeID}, v1alpha1)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "err=%v", err)
+
+	_, err = srv.Unlock(context.TODO(), &internal.UnlockRequest{VolumeId: dataVolumeID, KeyProtectorType: internal.KeyProtectorTypePassword, Secret: "wrong"}, v1alpha1)
+	var wmiErr *wmi.WMIError
+	assert.ErrorAs(t, err, &wmiErr)
+	assert.True(t, hostAPI.statuses[dataVolumeID].Locked)
+
+	_, err = srv.Unlock(context.TODO(), &internal.UnlockRequest{VolumeId: dataVolumeID, KeyProtectorType: internal.KeyProtectorTypePassword, Secret: "hunter2hunter2"}, v1alpha1)
+	require.NoError(t, err)
+	assert.False(t, hostAPI.statuses[dataVolumeID].Locked)
+
+	// unlocking an unlocked volume succeeds
+	calls := len(hostAPI.calls)
+	_, err = srv.Unlock(context.TODO(), &internal.UnlockRequest{VolumeId: dataVolumeID, KeyProtectorType: internal.KeyProtectorTypePassword, Secret: "hunter2hunter2"}, v1alpha1)
+	require.NoError(t, err)
+	assert.Len(t, hostAPI.calls, calls)
+
+	_, err = srv.Unlock(context.TODO(), &internal.UnlockRequest{VolumeId: dataVolumeID}, v1alpha1)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "err=%v", err)
+	_, err = srv.Lock(context.TODO(), &internal.LockRequest{VolumeId: bootVolumeID}, v1alpha1)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "err=%v", err)
+}
@@ -2,19 +2,22 @@ package filesystem
 
 import (
 	"context"
-	"fmt"
 	"regexp"
 	"strings"
+	"sync/atomic"
 
 	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
 	"github.com/kubernetes-csi/csi-proxy/pkg/os/filesystem"
 	internal "github.com/kubernetes-csi/csi-proxy/pkg/server/filesystem/impl"
 	"github.com/kubernetes-csi/csi-proxy/pkg/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"k8s.io/klog/v2"
 )
 
 type Server struct {
-	workingDirs []string
+	// workingDirs holds the []string of working directories, swapped atomically by SetWorkingDirs.
+	workingDirs atomic.Pointer[[]string]
 	hostAPI     filesystem.API
 }
 
@@ -25,14 +28,22 @@ var invalidPathCharsRegexWindows = regexp.MustCompile(`["/\:\?\*|]`)
 var absPathRegexWindows = regexp.MustCompile(`^[a-zA-Z]:\\`)
 
 func NewServer(workingDirs []string, hostAPI filesystem.API) (*Server, error) {
-	return &Server{
-		workingDirs: workingDirs,
-		hostAPI:     hostAPI,
-	}, nil
+	s := &Server{
+		hostAPI: hostAPI,
+	}
+	s.SetWorkingDirs(workingDirs)
+	return s, nil
 }
 
 func (s *Server) GetWorkingDirs() []string {
-	return s.workingDirs
+	return *s.workingDirs.Load()
+}
+
+// SetWorkingDirs replaces the working directories; requests being served
+// keep validating their paths against the previous ones.
+func (s *Server) SetWorkingDirs(workingDirs []string) {
+	workingDirs = append([]string(nil), workingDirs...)
+	s.workingDirs.Store(&workingDirs)
 }
 
 func containsInvalidCharactersWindows(path string) bool {
@@ -72,38 +83,41 @@ func (s *Server) ValidatePluginPath(path string) error {
 	return s.validatePathWindows(path)
 }
 
+// validatePathWindows returns an InvalidArgument status error if path isn't a valid absolute Windows path,
+// and a PermissionDenied one if it isn't within the working directories.
 func (s *Server) validatePathWindows(path string) error {
 	pathlen := len(path)
 
 	if pathlen > utils.MaxPathLengthWindows {
-		return fmt.Errorf("path length %d exceeds maximum characters: %d", pathlen, utils.MaxPathLengthWindows)
+		return status.Errorf(codes.InvalidArgument, "path length %d exceeds maximum characters: %d", pathlen, utils.MaxPathLengthWindows)
 	}
 
 	if pathlen > 0 && (path[0] == '\\') {
-		return fmt.Errorf("invalid character \\ at beginning of path: %s", path)
+		return status.Errorf(codes.InvalidArgument, "invalid character \\ at beginning of path: %s", path)
 	}
 
 	if isUNCPathWindows(path) {
-		return fmt.Errorf("unsupported UNC path prefix: %s", path)
+		return status.Errorf(codes.InvalidArgument, "unsupported UNC path prefix: %s", path)
 	}
 
 	if containsInvalidCharactersWindows(path) {
-		return fmt.Errorf("path contains invalid characters: %s", path)
+		return status.Errorf(codes.InvalidArgument, "path contains invalid characters: %s", path)
 	}
 
 	if !isAbsWindows(path) {
-		return fmt.Errorf("not an absolute Windows path: %s", path)
+		return status.Errorf(codes.InvalidArgument, "not an absolute Windows path: %s", path)
 	}
 
+	workingDirs := s.GetWorkingDirs()
 	valid := false
-	for _, workingDir := range s.workingDirs {
+	for _, workingDir := range workingDirs {
 		if strings.HasPrefix(strings.ToLower(path), strings.ToLower(workingDir)) {
 			valid = true
 		}
 	}
 
 	if !valid {
-		return fmt.Errorf("path: %s is not within the working directories: %v", path, s.workingDirs)
+		return status.Errorf(codes.PermissionDenied, "path: %s is not within the working directories: %v", path, workingDirs)
 	}
 
 	return nil
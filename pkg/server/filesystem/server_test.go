@@ -7,6 +7,8 @@ import (
 	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
 	"github.com/kubernetes-csi/csi-proxy/pkg/os/filesystem"
 	internal "github.com/kubernetes-csi/csi-proxy/pkg/server/filesystem/impl"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type fakeFileSystemAPI struct{}
@@ -243,3 +245,48 @@ func TestRmdirWindows(t *testing.T) {
 		}
 	}
 }
+
+func TestSetWorkingDirs(t *testing.T) {
+	srv, err := NewServer([]string{`C:\var\lib\kubelet`}, &fakeFileSystemAPI{})
+	if err != nil {
+		t.Fatalf("FileSystem Server could not be initialized for testing: %v", err)
+	}
+
+	if err := srv.ValidatePluginPath(`C:\var\lib\csi\pv1`); err == nil {
+		t.Errorf("Expected an error for a path outside of the working directories")
+	}
+
+	srv.SetWorkingDirs([]string{`C:\var\lib\kubelet`, `C:\var\lib\csi`})
+	if err := srv.ValidatePluginPath(`C:\var\lib\csi\pv1`); err != nil {
+		t.Errorf("Expected no errors for a path in the new working directories, got: %v", err)
+	}
+
+	srv.SetWorkingDirs([]string{`C:\var\lib\csi`})
+	if err := srv.ValidatePluginPath(`C:\var\lib\kubelet\pods`); err == nil {
+		t.Errorf("Expected an error for a path in a removed working directory")
+	}
+}
+
+func TestValidatePluginPathStatusCodes(t *testing.T) {
+	srv, err := NewServer([]string{`C:\var\lib\kubelet`}, &fakeFileSystemAPI{})
+	if err != nil {
+		t.Fatalf("FileSystem Server could not be initialized for testing: %v", err)
+	}
+
+	testCases := []struct {
+		name         string
+		path         string
+		expectedCode codes.Code
+	}{
+		{name: "relative path", path: `var\lib\kubelet\pods`, expectedCode: codes.InvalidArgument},
+		{name: "UNC path", path: `\\.\pipe\csi-proxy`, expectedCode: codes.InvalidArgument},
+		{name: "invalid characters", path: `C:\var\lib\kubelet\pods\pv1?`, expectedCode: codes.InvalidArgument},
+		{name: "outside of the working directories", path: `C:\Windows\System32`, expectedCode: codes.PermissionDenied},
+		{name: "valid path", path: `C:\var\lib\kubelet\pods\pv1`, expectedCode: codes.OK},
+	}
+	for _, tc := range testCases {
+		if code := status.Code(srv.ValidatePluginPath(tc.path)); code != tc.expectedCode {
+			t.Errorf("%s: expected code %v, got %v", tc.name, tc.expectedCode, code)
+		}
+	}
+}
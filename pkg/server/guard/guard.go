@@ -0,0 +1,136 @@
+package guard
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	shared "github.com/kubernetes-csi/csi-proxy/pkg/shared/disk"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+// Policy defines the disks and volumes the destructive disk and volume operations refuse to act on.
+type Policy struct {
+	// BootDisks protects the disk the host booted from.
+	BootDisks bool
+	// SystemDisks protects the disk holding the system partition.
+	SystemDisks bool
+	// ClusteredDisks protects the disks used by a failover cluster.
+	ClusteredDisks bool
+	// PageFileDisks protects the disks hosting a page file.
+	PageFileDisks bool
+	// FormattedVolumes refuses to format a volume that already has a file system, unless forced.
+	// It only applies to the volume API versions with a Force field, v2alpha2 and later.
+	FormattedVolumes bool
+}
+
+// DefaultPolicy returns the Policy protecting all of the above.
+func DefaultPolicy() Policy {
+	return Policy{
+		BootDisks:        true,
+		SystemDisks:      true,
+		ClusteredDisks:   true,
+		PageFileDisks:    true,
+		FormattedVolumes: true,
+	}
+}
+
+// protectsDisks returns true if the policy protects any disk.
+func (p Policy) protectsDisks() bool {
+	return p.BootDisks || p.SystemDisks || p.ClusteredDisks || p.PageFileDisks
+}
+
+// DiskAPI is the part of the disk host API the Guard queries.
+type DiskAPI interface {
+	// GetDiskInfo gets the information of the disk `diskNumber`.
+	GetDiskInfo(diskNumber uint32) (*shared.DiskInfo, error)
+	// ListPageFileDisks gets the numbers of the disks hosting a page file.
+	ListPageFileDisks() ([]uint32, error)
+}
+
+// Guard refuses the destructive operations on the disks and volumes its Policy protects, so that
+// a driver resolving the wrong disk number or volume ID can't wipe the OS disk or a volume in use.
+type Guard struct {
+	hostAPI DiskAPI
+
+	mutex  sync.RWMutex
+	policy Policy
+}
+
+// NewGuard returns a Guard querying hostAPI for the disks policy protects.
+func NewGuard(hostAPI DiskAPI, policy Policy) *Guard {
+	return &Guard{
+		hostAPI: hostAPI,
+		policy:  policy,
+	}
+}
+
+// Policy returns the current policy.
+func (g *Guard) Policy() Policy {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+	return g.policy
+}
+
+// SetPolicy replaces the policy, e.g. when the configuration file is reloaded.
+func (g *Guard) SetPolicy(policy Policy) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	g.policy = policy
+}
+
+// CheckDisk returns a FailedPrecondition status error if the policy protects the disk diskNumber
+// from operation, or the error preventing to check it.
+func (g *Guard) CheckDisk(diskNumber uint32, operation string) error {
+	policy := g.Policy()
+	if !policy.protectsDisks() {
+		return nil
+	}
+
+	info, err := g.hostAPI.GetDiskInfo(diskNumber)
+	if err != nil {
+		return fmt.Errorf("failed to check whether disk %d is protected: %w", diskNumber, err)
+	}
+
+	var reasons []string
+	if policy.BootDisks && info.IsBoot {
+		reasons = append(reasons, "the boot disk")
+	}
+	if policy.SystemDisks && info.IsSystem {
+		reasons = append(reasons, "the system disk")
+	}
+	if policy.ClusteredDisks && info.IsClustered {
+		reasons = append(reasons, "a clustered disk")
+	}
+	if policy.PageFileDisks {
+		pageFileDisks, err := g.hostAPI.ListPageFileDisks()
+		if err != nil {
+			return fmt.Errorf("failed to check whether disk %d is protected: %w", diskNumber, err)
+		}
+		for _, pageFileDisk := range pageFileDisks {
+			if pageFileDisk == diskNumber {
+				reasons = append(reasons, "a page file disk")
+				break
+			}
+		}
+	}
+
+	if len(reasons) > 0 {
+		klog.Warningf("Refused %s on protected disk %d: %s", operation, diskNumber, strings.Join(reasons, ", "))
+		return status.Errorf(codes.FailedPrecondition, "%s refused on disk %d: it is %s", operation, diskNumber, strings.Join(reasons, ", "))
+	}
+	return nil
+}
+
+// CheckFormat returns a FailedPrecondition status error if the policy refuses to format the volume
+// volumeID, depending on whether it is formatted already and the format is forced.
+func (g *Guard) CheckFormat(volumeID string, formatted, force bool) error {
+	if !formatted || force || !g.Policy().FormattedVolumes {
+		return nil
+	}
+
+	klog.Warningf("Refused to format volume %s already formatted", volumeID)
+	return status.Errorf(codes.FailedPrecondition, "volume %s already has a file system, set force to format it", volumeID)
+}
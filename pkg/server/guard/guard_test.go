@@ -0,0 +1,127 @@
+package guard
+
+import (
+	"errors"
+	"testing"
+
+	shared "github.com/kubernetes-csi/csi-proxy/pkg/shared/disk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeDiskAPI struct {
+	disks         map[uint32]shared.DiskInfo
+	pageFileDisks []uint32
+	calls         int
+}
+
+func (f *fakeDiskAPI) GetDiskInfo(diskNumber uint32) (*shared.DiskInfo, error) {
+	f.calls++
+	info, ok := f.disks[diskNumber]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return &info, nil
+}
+
+func (f *fakeDiskAPI) ListPageFileDisks() ([]uint32, error) {
+	f.calls++
+	return f.pageFileDisks, nil
+}
+
+func newFakeDiskAPI() *fakeDiskAPI {
+	return &fakeDiskAPI{
+		disks: map[uint32]shared.DiskInfo{
+			0: {Number: 0, IsBoot: true, IsSystem: true},
+			1: {Number: 1},
+			2: {Number: 2, IsClustered: true},
+			3: {Number: 3},
+		},
+		pageFileDisks: []uint32{3},
+	}
+}
+
+func TestCheckDisk(t *testing.T) {
+	testCases := []struct {
+		name        string
+		policy      Policy
+		diskNumber  uint32
+		expectedErr string
+	}{
+		{
+			name:        "boot and system disk",
+			policy:      DefaultPolicy(),
+			diskNumber:  0,
+			expectedErr: "PartitionDisk refused on disk 0: it is the boot disk, the system disk",
+		},
+		{
+			name:       "data disk",
+			policy:     DefaultPolicy(),
+			diskNumber: 1,
+		},
+		{
+			name:        "clustered disk",
+			policy:      DefaultPolicy(),
+			diskNumber:  2,
+			expectedErr: "it is a clustered disk",
+		},
+		{
+			name:        "page file disk",
+			policy:      DefaultPolicy(),
+			diskNumber:  3,
+			expectedErr: "it is a page file disk",
+		},
+		{
+			name:        "system disk only",
+			policy:      Policy{SystemDisks: true},
+			diskNumber:  0,
+			expectedErr: "it is the system disk",
+		},
+		{
+			name:       "clustered disks allowed",
+			policy:     Policy{BootDisks: true, SystemDisks: true, PageFileDisks: true},
+			diskNumber: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewGuard(newFakeDiskAPI(), tc.policy)
+			err := g.CheckDisk(tc.diskNumber, "PartitionDisk")
+			if tc.expectedErr == "" {
+				assert.Nil(t, err)
+				return
+			}
+			require.NotNil(t, err)
+			assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+			assert.Contains(t, err.Error(), tc.expectedErr)
+		})
+	}
+}
+
+func TestCheckDiskNoProtection(t *testing.T) {
+	hostAPI := newFakeDiskAPI()
+	g := NewGuard(hostAPI, Policy{FormattedVolumes: true})
+	assert.Nil(t, g.CheckDisk(0, "PartitionDisk"))
+	assert.Equal(t, 0, hostAPI.calls, "the host shouldn't be queried")
+}
+
+func TestCheckDiskUnknown(t *testing.T) {
+	g := NewGuard(newFakeDiskAPI(), DefaultPolicy())
+	err := g.CheckDisk(9, "PartitionDisk")
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "failed to check whether disk 9 is protected")
+}
+
+func TestCheckFormat(t *testing.T) {
+	g := NewGuard(newFakeDiskAPI(), DefaultPolicy())
+	assert.Nil(t, g.CheckFormat("volume", false, false))
+	assert.Nil(t, g.CheckFormat("volume", true, true))
+	err := g.CheckFormat("volume", true, false)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	g.SetPolicy(Policy{})
+	assert.Nil(t, g.CheckFormat("volume", true, false))
+}
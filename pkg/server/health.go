@@ -0,0 +1,82 @@
+package server
+
+import (
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"k8s.io/klog/v2"
+)
+
+// DefaultHealthCheckInterval is the default interval at which API groups' health is checked.
+const DefaultHealthCheckInterval = 10 * time.Second
+
+// registerVersionedAPIs registers the endpoint's API versions on grpcServer, along with
+// the grpc.health.v1 service reporting their status. It records the GRPC service names
+// each version registers, so their status can be updated.
+func (e *endpoint) registerVersionedAPIs(grpcServer *grpc.Server) {
+	e.serviceNames = make([][]string, len(e.versionedAPIs))
+	for i, versionedAPI := range e.versionedAPIs {
+		registered := grpcServer.GetServiceInfo()
+		versionedAPI.Registrant(grpcServer)
+		for serviceName := range grpcServer.GetServiceInfo() {
+			if _, present := registered[serviceName]; !present {
+				e.serviceNames[i] = append(e.serviceNames[i], serviceName)
+			}
+		}
+	}
+
+	e.health = health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, e.health)
+}
+
+// updateHealth sets the serving status of every registered GRPC service,
+// asking the API groups implementing srvtypes.HealthReporter for their status.
+func (s *Server) updateHealth() {
+	for _, endpoint := range s.endpoints {
+		if endpoint.health == nil {
+			continue
+		}
+
+		for i, versionedAPI := range endpoint.versionedAPIs {
+			status := healthpb.HealthCheckResponse_SERVING
+			if reporter, ok := s.healthReporters[versionedAPI]; ok {
+				if err := reporter.CheckHealth(versionedAPI.Version); err != nil {
+					klog.Warningf("API group %s version %s is not serving: %v", versionedAPI.Group, versionedAPI.Version, err)
+					status = healthpb.HealthCheckResponse_NOT_SERVING
+				}
+			}
+
+			for _, serviceName := range endpoint.serviceNames[i] {
+				endpoint.health.SetServingStatus(serviceName, status)
+			}
+		}
+	}
+}
+
+// checkHealthPeriodically updates the GRPC services' health every health check interval,
+// until stoppedChan is closed.
+func (s *Server) checkHealthPeriodically(stoppedChan <-chan struct{}) {
+	ticker := time.NewTicker(s.healthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.updateHealth()
+		case <-stoppedChan:
+			return
+		}
+	}
+}
+
+// shutdownHealth sets all the GRPC services as not serving, so that health probes
+// notice the proxy is stopping while in-flight requests complete.
+func shutdownHealth(endpoints []*endpoint) {
+	for _, endpoint := range endpoints {
+		if endpoint.health != nil {
+			endpoint.health.Shutdown()
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kubernetes-csi/csi-proxy/client"
+	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+	"github.com/kubernetes-csi/csi-proxy/integrationtests/apigroups/server/dummy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+)
+
+// unhealthyDummyServer is the dummy API group, reporting v1alpha1 as not serving while unhealthy is set.
+type unhealthyDummyServer struct {
+	dummy.Server
+	unhealthy atomic.Bool
+}
+
+func (s *unhealthyDummyServer) CheckHealth(version apiversion.Version) error {
+	if s.unhealthy.Load() && version.String() == "v1alpha1" {
+		return fmt.Errorf("v1alpha1 is unhealthy")
+	}
+	return nil
+}
+
+func TestServerHealth(t *testing.T) {
+	socketDir := t.TempDir()
+	apiGroup := &unhealthyDummyServer{}
+	s, err := NewServer(Config{
+		ListenerFactory:     NewUnixListenerFactory(socketDir),
+		Multiplexed:         true,
+		HealthCheckInterval: 10 * time.Millisecond,
+	}, apiGroup)
+	require.Nil(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	listeningChan := make(chan interface{})
+	go s.Start(ctx, listeningChan)
+	<-listeningChan
+
+	connection, err := client.NewMultiplexedConnectionWithDialer(client.MultiplexedSocketPath(socketDir), client.DialUnix)
+	require.Nil(t, err)
+	defer connection.Close()
+	healthClient := healthpb.NewHealthClient(connection)
+
+	checkStatus := func(service string) healthpb.HealthCheckResponse_ServingStatus {
+		response, err := healthClient.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+		require.Nil(t, err)
+		return response.Status
+	}
+
+	for _, service := range []string{"", "v1alpha1.Dummy", "v1alpha2.Dummy", "v1.Dummy"} {
+		assert.Equal(t, healthpb.HealthCheckResponse_SERVING, checkStatus(service), "service %q", service)
+	}
+
+	apiGroup.unhealthy.Store(true)
+	assert.Eventually(t, func() bool {
+		return checkStatus("v1alpha1.Dummy") == healthpb.HealthCheckResponse_NOT_SERVING
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, checkStatus("v1.Dummy"))
+
+	apiGroup.unhealthy.Store(false)
+	assert.Eventually(t, func() bool {
+		return checkStatus("v1alpha1.Dummy") == healthpb.HealthCheckResponse_SERVING
+	}, 5*time.Second, 10*time.Millisecond)
+
+	_, err = healthClient.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "v1.Unknown"})
+	assert.NotNil(t, err)
+}
+
+func TestServerReflection(t *testing.T) {
+	socketDir := t.TempDir()
+	_, stop := startTestServer(t, Config{ListenerFactory: NewUnixListenerFactory(socketDir), EnableReflection: true})
+	defer stop()
+
+	connection, err := grpc.Dial(client.SocketPath(socketDir, "dummy", apiversion.NewVersionOrPanic("v1")),
+		grpc.WithContextDialer(client.DialUnix), grpc.WithInsecure())
+	require.Nil(t, err)
+	defer connection.Close()
+
+	stream, err := reflectionpb.NewServerReflectionClient(connection).ServerReflectionInfo(context.Background())
+	require.Nil(t, err)
+	require.Nil(t, stream.Send(&reflectionpb.ServerReflectionRequest{
+		MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{},
+	}))
+	response, err := stream.Recv()
+	require.Nil(t, err)
+
+	var services []string
+	for _, service := range response.GetListServicesResponse().GetService() {
+		services = append(services, service.Name)
+	}
+	assert.ElementsMatch(t, []string{"v1.Dummy", "grpc.health.v1.Health", "grpc.reflection.v1.ServerReflection", "grpc.reflection.v1alpha.ServerReflection"}, services)
+}
@@ -0,0 +1,57 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"google.golang.org/grpc"
+)
+
+// inFlightRequests keeps track of the requests currently being served
+// across all the GRPC servers, so that a graceful shutdown can wait for them.
+type inFlightRequests struct {
+	count atomic.Int64
+	wg    sync.WaitGroup
+}
+
+func (r *inFlightRequests) begin() {
+	r.wg.Add(1)
+	r.count.Add(1)
+}
+
+func (r *inFlightRequests) end() {
+	r.count.Add(-1)
+	r.wg.Done()
+}
+
+// current returns the number of requests being served.
+func (r *inFlightRequests) current() int64 {
+	return r.count.Load()
+}
+
+// wait blocks until all the requests being served are done.
+// It must only be called once no new requests can be accepted.
+func (r *inFlightRequests) wait() {
+	r.wg.Wait()
+}
+
+// serverOptions returns the interceptors counting unary and stream requests.
+func (r *inFlightRequests) serverOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(r.unaryInterceptor),
+		grpc.ChainStreamInterceptor(r.streamInterceptor),
+	}
+}
+
+func (r *inFlightRequests) unaryInterceptor(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	r.begin()
+	defer r.end()
+	return handler(ctx, req)
+}
+
+func (r *inFlightRequests) streamInterceptor(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	r.begin()
+	defer r.end()
+	return handler(srv, ss)
+}
@@ -0,0 +1,50 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+func TestInFlightRequests(t *testing.T) {
+	r := &inFlightRequests{}
+
+	release := make(chan struct{})
+	started := make(chan struct{})
+	go func() {
+		_, _ = r.unaryInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			close(started)
+			<-release
+			return nil, nil
+		})
+	}()
+
+	<-started
+	if got := r.current(); got != 1 {
+		t.Fatalf("expected 1 request in flight, got %d", got)
+	}
+
+	waitDone := make(chan struct{})
+	go func() {
+		r.wait()
+		close(waitDone)
+	}()
+
+	select {
+	case <-waitDone:
+		t.Fatalf("wait returned while a request was in flight")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	select {
+	case <-waitDone:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for in-flight requests")
+	}
+	if got := r.current(); got != 0 {
+		t.Fatalf("expected 0 requests in flight, got %d", got)
+	}
+}
@@ -0,0 +1,64 @@
+package iscsi
+
+import (
+	"time"
+
+	"github.com/kubernetes-csi/csi-proxy/pkg/os/iscsi"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/metrics"
+)
+
+// instrumentedAPI records the duration and the errors of the calls to the iscsi host API.
+type instrumentedAPI struct {
+	hostAPI API
+}
+
+// NewInstrumentedAPI returns a API recording the duration and the errors of the calls to hostAPI
+// in the host API metrics.
+func NewInstrumentedAPI(hostAPI API) API {
+	return &instrumentedAPI{hostAPI: hostAPI}
+}
+
+func (i *instrumentedAPI) AddTargetPortal(portal *iscsi.TargetPortal) (err error) {
+	defer metrics.ObserveHostAPICall("iscsi", "AddTargetPortal", time.Now(), &err)
+	return i.hostAPI.AddTargetPortal(portal)
+}
+
+func (i *instrumentedAPI) DiscoverTargetPortal(portal *iscsi.TargetPortal) (_ []string, err error) {
+	defer metrics.ObserveHostAPICall("iscsi", "DiscoverTargetPortal", time.Now(), &err)
+	return i.hostAPI.DiscoverTargetPortal(portal)
+}
+
+func (i *instrumentedAPI) ListTargetPortals() (_ []iscsi.TargetPortal, err error) {
+	defer metrics.ObserveHostAPICall("iscsi", "ListTargetPortals", time.Now(), &err)
+	return i.hostAPI.ListTargetPortals()
+}
+
+func (i *instrumentedAPI) RemoveTargetPortal(portal *iscsi.TargetPortal) (err error) {
+	defer metrics.ObserveHostAPICall("iscsi", "RemoveTargetPortal", time.Now(), &err)
+	return i.hostAPI.RemoveTargetPortal(portal)
+}
+
+func (i *instrumentedAPI) ConnectTarget(portal *iscsi.TargetPortal, iqn string, authType string, chapUser string, chapSecret string) (err error) {
+	defer metrics.ObserveHostAPICall("iscsi", "ConnectTarget", time.Now(), &err)
+	return i.hostAPI.ConnectTarget(portal, iqn, authType, chapUser, chapSecret)
+}
+
+func (i *instrumentedAPI) DisconnectTarget(portal *iscsi.TargetPortal, iqn string) (err error) {
+	defer metrics.ObserveHostAPICall("iscsi", "DisconnectTarget", time.Now(), &err)
+	return i.hostAPI.DisconnectTarget(portal, iqn)
+}
+
+func (i *instrumentedAPI) GetTargetDisks(portal *iscsi.TargetPortal, iqn string) (_ []string, err error) {
+	defer metrics.ObserveHostAPICall("iscsi", "GetTargetDisks", time.Now(), &err)
+	return i.hostAPI.GetTargetDisks(portal, iqn)
+}
+
+func (i *instrumentedAPI) SetMutualChapSecret(mutualChapSecret string) (err error) {
+	defer metrics.ObserveHostAPICall("iscsi", "SetMutualChapSecret", time.Now(), &err)
+	return i.hostAPI.SetMutualChapSecret(mutualChapSecret)
+}
+
+func (i *instrumentedAPI) CheckHealth() (err error) {
+	defer metrics.ObserveHostAPICall("iscsi", "CheckHealth", time.Now(), &err)
+	return i.hostAPI.CheckHealth()
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
 	"github.com/kubernetes-csi/csi-proxy/pkg/os/iscsi"
 	internal "github.com/kubernetes-csi/csi-proxy/pkg/server/iscsi/impl"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/locks"
 	"k8s.io/klog/v2"
 )
 
@@ -14,6 +15,7 @@ const defaultIscsiPort = 3260
 
 type Server struct {
 	hostAPI API
+	locks   *locks.Manager
 }
 
 type API interface {
@@ -26,14 +28,25 @@ type API interface {
 	DisconnectTarget(portal *iscsi.TargetPortal, iqn string) error
 	GetTargetDisks(portal *iscsi.TargetPortal, iqn string) ([]string, error)
 	SetMutualChapSecret(mutualChapSecret string) error
+	// CheckHealth returns an error if the iSCSI targets can't be queried.
+	CheckHealth() error
 }
 
-func NewServer(hostAPI API) (*Server, error) {
+// NewServer returns a Server serializing its mutating operations on the same target portal
+// or target with lockManager.
+func NewServer(hostAPI API, lockManager *locks.Manager) (*Server, error) {
 	return &Server{
 		hostAPI: hostAPI,
+		locks:   lockManager,
 	}, nil
 }
 
+// CheckHealth returns an error if the iscsi host API is unavailable, e.g. because its WMI provider
+// is down. All the versions share the host API, their health is the same.
+func (s *Server) CheckHealth(version apiversion.Version) error {
+	return s.hostAPI.CheckHealth()
+}
+
 func (s *Server) requestTPtoAPITP(portal *internal.TargetPortal) *iscsi.TargetPortal {
 	port := portal.TargetPort
 	if port == 0 {
@@ -45,7 +58,15 @@ func (s *Server) requestTPtoAPITP(portal *internal.TargetPortal) *iscsi.TargetPo
 func (s *Server) AddTargetPortal(context context.Context, request *internal.AddTargetPortalRequest, version apiversion.Version) (*internal.AddTargetPortalResponse, error) {
 	klog.V(4).Infof("calling AddTargetPortal with portal %s:%d", request.TargetPortal.TargetAddress, request.TargetPortal.TargetPort)
 	response := &internal.AddTargetPortalResponse{}
-	err := s.hostAPI.AddTargetPortal(s.requestTPtoAPITP(request.TargetPortal))
+	portal := s.requestTPtoAPITP(request.TargetPortal)
+	release, err := s.locks.TryAcquire(locks.ISCSITargetPortalResource(portal.Address, portal.Port), "AddTargetPortal")
+	if err != nil {
+		klog.Errorf("failed AddTargetPortal %v", err)
+		return response, err
+	}
+	defer release()
+
+	err = s.hostAPI.AddTargetPortal(portal)
 	if err != nil {
 		klog.Errorf("failed AddTargetPortal %v", err)
 		return response, err
@@ -79,7 +100,15 @@ func (s *Server) ConnectTarget(context context.Context, req *internal.ConnectTar
 		return response, err
 	}
 
-	err = s.hostAPI.ConnectTarget(s.requestTPtoAPITP(req.TargetPortal), req.Iqn,
+	portal := s.requestTPtoAPITP(req.TargetPortal)
+	release, err := s.locks.TryAcquire(locks.ISCSITargetResource(portal.Address, portal.Port, req.Iqn), "ConnectTarget")
+	if err != nil {
+		klog.Errorf("failed ConnectTarget %v", err)
+		return response, err
+	}
+	defer release()
+
+	err = s.hostAPI.ConnectTarget(portal, req.Iqn,
 		authType, req.ChapUsername, req.ChapSecret)
 	if err != nil {
 		klog.Errorf("failed ConnectTarget %v", err)
@@ -94,7 +123,15 @@ func (s *Server) DisconnectTarget(context context.Context, request *internal.Dis
 		request.TargetPortal.TargetAddress, request.TargetPortal.TargetPort, request.Iqn)
 
 	response := &internal.DisconnectTargetResponse{}
-	err := s.hostAPI.DisconnectTarget(s.requestTPtoAPITP(request.TargetPortal), request.Iqn)
+	portal := s.requestTPtoAPITP(request.TargetPortal)
+	release, err := s.locks.TryAcquire(locks.ISCSITargetResource(portal.Address, portal.Port, request.Iqn), "DisconnectTarget")
+	if err != nil {
+		klog.Errorf("failed DisconnectTarget %v", err)
+		return response, err
+	}
+	defer release()
+
+	err = s.hostAPI.DisconnectTarget(portal, request.Iqn)
 	if err != nil {
 		klog.Errorf("failed DisconnectTarget %v", err)
 		return response, err
@@ -161,7 +198,15 @@ func (s *Server) ListTargetPortals(context context.Context, request *internal.Li
 func (s *Server) RemoveTargetPortal(context context.Context, request *internal.RemoveTargetPortalRequest, version apiversion.Version) (*internal.RemoveTargetPortalResponse, error) {
 	klog.V(4).Infof("calling RemoveTargetPortal with portal %s:%d", request.TargetPortal.TargetAddress, request.TargetPortal.TargetPort)
 	response := &internal.RemoveTargetPortalResponse{}
-	err := s.hostAPI.RemoveTargetPortal(s.requestTPtoAPITP(request.TargetPortal))
+	portal := s.requestTPtoAPITP(request.TargetPortal)
+	release, err := s.locks.TryAcquire(locks.ISCSITargetPortalResource(portal.Address, portal.Port), "RemoveTargetPortal")
+	if err != nil {
+		klog.Errorf("failed RemoveTargetPortal %v", err)
+		return response, err
+	}
+	defer release()
+
+	err = s.hostAPI.RemoveTargetPortal(portal)
 	if err != nil {
 		klog.Errorf("failed RemoveTargetPortal %v", err)
 		return response, err
@@ -0,0 +1,136 @@
+package server
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/kubernetes-csi/csi-proxy/client"
+	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+)
+
+const (
+	// TransportPipe serves each API version on a named pipe, this is the default.
+	TransportPipe = "pipe"
+	// TransportUnix serves each API version on a unix domain socket.
+	TransportUnix = "unix"
+	// TransportTCP serves each API version on a loopback TCP port.
+	TransportTCP = "tcp"
+)
+
+// ListenerFactory creates the listeners the GRPC servers are served on.
+type ListenerFactory interface {
+	// Listen creates the listener for the given API group and version.
+	Listen(apiGroupName string, apiVersion apiversion.Version) (net.Listener, error)
+	// ListenMultiplexed creates the listener for the single endpoint serving all the
+	// API groups and versions.
+	ListenMultiplexed() (net.Listener, error)
+}
+
+// NewListenerFactory returns the ListenerFactory for the given transport.
+// For TransportUnix, address is the directory the sockets are created in;
+// for TransportTCP, it's the loopback host and first port to listen on,
+// see NewTCPListenerFactory. It's ignored for TransportPipe, whose security
+// descriptors are pipeSecurityDescriptors, see NewPipeListenerFactory.
+func NewListenerFactory(transport, address string, pipeSecurityDescriptors map[string]string) (ListenerFactory, error) {
+	switch transport {
+	case TransportPipe, "":
+		return NewPipeListenerFactory(pipeSecurityDescriptors), nil
+	case TransportUnix:
+		if address == "" {
+			return nil, fmt.Errorf("a socket directory is required for transport %q", transport)
+		}
+		return NewUnixListenerFactory(address), nil
+	case TransportTCP:
+		return NewTCPListenerFactory(address)
+	default:
+		return nil, fmt.Errorf("unknown transport %q, expected one of %q, %q, %q", transport, TransportPipe, TransportUnix, TransportTCP)
+	}
+}
+
+type unixListenerFactory struct {
+	socketDir string
+}
+
+// NewUnixListenerFactory returns a ListenerFactory creating the unix domain sockets
+// in socketDir, see client.SocketPath.
+func NewUnixListenerFactory(socketDir string) ListenerFactory {
+	return &unixListenerFactory{socketDir: socketDir}
+}
+
+func (f *unixListenerFactory) Listen(apiGroupName string, apiVersion apiversion.Version) (net.Listener, error) {
+	return f.listen(client.SocketPath(f.socketDir, apiGroupName, apiVersion))
+}
+
+func (f *unixListenerFactory) ListenMultiplexed() (net.Listener, error) {
+	return f.listen(client.MultiplexedSocketPath(f.socketDir))
+}
+
+func (f *unixListenerFactory) listen(socketPath string) (net.Listener, error) {
+	if err := os.MkdirAll(f.socketDir, 0750); err != nil {
+		return nil, err
+	}
+
+	// remove the socket left behind by a previous run, if any
+	if err := os.Remove(socketPath); err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	return net.Listen("unix", socketPath)
+}
+
+type tcpListenerFactory struct {
+	host string
+
+	mutex    sync.Mutex
+	nextPort int
+}
+
+// NewTCPListenerFactory returns a ListenerFactory listening on the loopback address
+// "address", e.g. "127.0.0.1:9100". Each API version gets its own port, starting with
+// the given one and incremented for each version in registration order; port 0 lets
+// the system pick a free port for each of them. The multiplexed endpoint listens on
+// the given port.
+// Non-loopback hosts are refused, as the API isn't authenticated.
+func NewTCPListenerFactory(address string) (ListenerFactory, error) {
+	if address == "" {
+		address = "127.0.0.1:0"
+	}
+
+	host, portStr, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid TCP address %q: %w", address, err)
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil || port < 0 || port > 65535 {
+		return nil, fmt.Errorf("invalid port in TCP address %q", address)
+	}
+	if host != "localhost" {
+		if ip := net.ParseIP(host); ip == nil || !ip.IsLoopback() {
+			return nil, fmt.Errorf("TCP address %q is not a loopback address", address)
+		}
+	}
+
+	return &tcpListenerFactory{host: host, nextPort: port}, nil
+}
+
+func (f *tcpListenerFactory) Listen(apiGroupName string, apiVersion apiversion.Version) (net.Listener, error) {
+	return f.listen()
+}
+
+func (f *tcpListenerFactory) ListenMultiplexed() (net.Listener, error) {
+	return f.listen()
+}
+
+func (f *tcpListenerFactory) listen() (net.Listener, error) {
+	f.mutex.Lock()
+	port := f.nextPort
+	if port != 0 {
+		f.nextPort++
+	}
+	f.mutex.Unlock()
+
+	return net.Listen("tcp", net.JoinHostPort(f.host, strconv.Itoa(port)))
+}
@@ -0,0 +1,27 @@
+//go:build !windows
+// +build !windows
+
+package server
+
+import (
+	"net"
+
+	"github.com/kubernetes-csi/csi-proxy/client"
+	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+)
+
+type pipeListenerFactory struct{}
+
+// NewPipeListenerFactory returns a ListenerFactory creating the named pipes, see client.PipePath.
+// Named pipes are only supported on Windows.
+func NewPipeListenerFactory(map[string]string) ListenerFactory {
+	return pipeListenerFactory{}
+}
+
+func (pipeListenerFactory) Listen(string, apiversion.Version) (net.Listener, error) {
+	return nil, client.ErrPipeNotSupported
+}
+
+func (pipeListenerFactory) ListenMultiplexed() (net.Listener, error) {
+	return nil, client.ErrPipeNotSupported
+}
@@ -0,0 +1,44 @@
+//go:build windows
+// +build windows
+
+package server
+
+import (
+	"net"
+
+	"github.com/Microsoft/go-winio"
+	"github.com/kubernetes-csi/csi-proxy/client"
+	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/authz"
+)
+
+type pipeListenerFactory struct {
+	securityDescriptors map[string]string
+}
+
+// NewPipeListenerFactory returns a ListenerFactory creating the named pipes, see client.PipePath.
+// securityDescriptors are the pipes' SDDL security descriptors by API group, the authz.Wildcard entry
+// applying to the groups not listed and to the multiplexed endpoint; pipes without one get
+// the system's default security descriptor.
+func NewPipeListenerFactory(securityDescriptors map[string]string) ListenerFactory {
+	return &pipeListenerFactory{securityDescriptors: securityDescriptors}
+}
+
+func (f *pipeListenerFactory) Listen(apiGroupName string, apiVersion apiversion.Version) (net.Listener, error) {
+	return winio.ListenPipe(client.PipePath(apiGroupName, apiVersion), f.pipeConfig(apiGroupName))
+}
+
+func (f *pipeListenerFactory) ListenMultiplexed() (net.Listener, error) {
+	return winio.ListenPipe(client.MultiplexedPipePath(), f.pipeConfig(authz.Wildcard))
+}
+
+func (f *pipeListenerFactory) pipeConfig(apiGroupName string) *winio.PipeConfig {
+	securityDescriptor, ok := f.securityDescriptors[apiGroupName]
+	if !ok {
+		securityDescriptor = f.securityDescriptors[authz.Wildcard]
+	}
+	if securityDescriptor == "" {
+		return nil
+	}
+	return &winio.PipeConfig{SecurityDescriptor: securityDescriptor}
+}
@@ -0,0 +1,125 @@
+package locks
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+// Operation is a mutating operation in progress on a resource.
+type Operation struct {
+	// Resource identifies the resource, e.g. "disk/1", see the *Resource functions.
+	Resource string `json:"resource"`
+	// Name is the operation's method, e.g. "PartitionDisk".
+	Name string `json:"name"`
+	// Started is when the operation acquired the resource.
+	Started time.Time `json:"started"`
+}
+
+// Manager serializes the mutating operations on the same resource: an operation
+// trying to acquire a resource while another one holds it fails with Aborted,
+// as CSI drivers expect, rather than waiting for it.
+type Manager struct {
+	mutex      sync.Mutex
+	operations map[string]*Operation
+}
+
+// NewManager returns a Manager with no operation in progress.
+func NewManager() *Manager {
+	return &Manager{
+		operations: make(map[string]*Operation),
+	}
+}
+
+// TryAcquire acquires resource for the operation name, and returns the function releasing it.
+// It returns an Aborted status error if another operation holds resource.
+func (m *Manager) TryAcquire(resource, name string) (func(), error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if operation, ok := m.operations[resource]; ok {
+		return nil, status.Errorf(codes.Aborted, "an operation %s on %s is already in progress since %s",
+			operation.Name, resource, operation.Started.Format(time.RFC3339))
+	}
+
+	operation := &Operation{Resource: resource, Name: name, Started: time.Now()}
+	m.operations[resource] = operation
+	klog.V(4).Infof("Acquired %s for %s", resource, name)
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			m.mutex.Lock()
+			defer m.mutex.Unlock()
+			delete(m.operations, resource)
+			klog.V(4).Infof("Released %s after %s", resource, name)
+		})
+	}, nil
+}
+
+// InFlight returns the operations in progress, sorted by resource.
+func (m *Manager) InFlight() []Operation {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	operations := make([]Operation, 0, len(m.operations))
+	for _, operation := range m.operations {
+		operations = append(operations, *operation)
+	}
+	sort.Slice(operations, func(i, j int) bool {
+		return operations[i].Resource < operations[j].Resource
+	})
+	return operations
+}
+
+// ServeHTTP writes the operations in progress as a JSON array, for diagnostics.
+func (m *Manager) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(m.InFlight()); err != nil {
+		klog.Warningf("failed to write the operations in progress: %v", err)
+	}
+}
+
+// DiskResource identifies the disk diskNumber.
+func DiskResource(diskNumber uint32) string {
+	return fmt.Sprintf("disk/%d", diskNumber)
+}
+
+// HostResource identifies the host-wide setting name, e.g. "sanpolicy", which an operation on a
+// single disk or volume can change too.
+func HostResource(name string) string {
+	return "host/" + name
+}
+
+// VolumeResource identifies the volume volumeID, e.g. \\?\Volume{...}\, case-insensitively.
+func VolumeResource(volumeID string) string {
+	return "volume/" + strings.ToLower(volumeID)
+}
+
+// ShadowCopyResource identifies the shadow copy shadowCopyID, e.g. {1fb1a4b6-...}, case-insensitively.
+func ShadowCopyResource(shadowCopyID string) string {
+	return "shadowcopy/" + strings.ToLower(shadowCopyID)
+}
+
+// SMBShareResource identifies the SMB share remotePath, e.g. \\server\share, case-insensitively.
+func SMBShareResource(remotePath string) string {
+	return "smb/" + strings.ToLower(remotePath)
+}
+
+// ISCSITargetResource identifies the iSCSI target iqn on the target portal address:port.
+func ISCSITargetResource(address string, port uint32, iqn string) string {
+	return fmt.Sprintf("iscsi/%s:%d/%s", strings.ToLower(address), port, strings.ToLower(iqn))
+}
+
+// ISCSITargetPortalResource identifies the iSCSI target portal address:port.
+func ISCSITargetPortalResource(address string, port uint32) string {
+	return fmt.Sprintf("iscsi/%s:%d", strings.ToLower(address), port)
+}
@@ -0,0 +1,75 @@
+package locks
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTryAcquire(t *testing.T) {
+	m := NewManager()
+
+	release, err := m.TryAcquire(DiskResource(1), "PartitionDisk")
+	require.Nil(t, err)
+
+	_, err = m.TryAcquire(DiskResource(1), "SetDiskState")
+	require.NotNil(t, err)
+	assert.Equal(t, codes.Aborted, status.Code(err))
+	assert.Contains(t, err.Error(), "PartitionDisk on disk/1")
+
+	// other resources aren't held
+	releaseOther, err := m.TryAcquire(DiskResource(2), "SetDiskState")
+	require.Nil(t, err)
+	releaseOther()
+
+	release()
+	// releasing twice doesn't release a later acquisition
+	release2, err := m.TryAcquire(DiskResource(1), "SetDiskState")
+	require.Nil(t, err)
+	release()
+	_, err = m.TryAcquire(DiskResource(1), "PartitionDisk")
+	assert.Equal(t, codes.Aborted, status.Code(err))
+	release2()
+
+	assert.Empty(t, m.InFlight())
+}
+
+func TestResources(t *testing.T) {
+	assert.Equal(t, VolumeResource(`\\?\Volume{ABC}\`), VolumeResource(`\\?\volume{abc}\`))
+	assert.Equal(t, ShadowCopyResource("{1FB1A4B6-7B1A}"), ShadowCopyResource("{1fb1a4b6-7b1a}"))
+	assert.Equal(t, SMBShareResource(`\\Server\Share`), SMBShareResource(`\\server\share`))
+	assert.Equal(t, "iscsi/10.0.0.1:3260/iqn.2020-01.com.example:target",
+		ISCSITargetResource("10.0.0.1", 3260, "IQN.2020-01.com.example:target"))
+	assert.NotEqual(t, ISCSITargetPortalResource("10.0.0.1", 3260), ISCSITargetResource("10.0.0.1", 3260, "iqn"))
+	assert.Equal(t, "host/sanpolicy", HostResource("sanpolicy"))
+}
+
+func TestServeHTTP(t *testing.T) {
+	m := NewManager()
+	releaseVolume, err := m.TryAcquire(VolumeResource(`\\?\Volume{abc}\`), "FormatVolume")
+	require.Nil(t, err)
+	defer releaseVolume()
+	releaseDisk, err := m.TryAcquire(DiskResource(3), "PartitionDisk")
+	require.Nil(t, err)
+	defer releaseDisk()
+
+	recorder := httptest.NewRecorder()
+	m.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/debug/operations", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+
+	var operations []Operation
+	require.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &operations))
+	require.Len(t, operations, 2)
+	assert.Equal(t, "disk/3", operations[0].Resource)
+	assert.Equal(t, "PartitionDisk", operations[0].Name)
+	assert.Equal(t, `volume/\\?\volume{abc}\`, operations[1].Resource)
+	assert.Equal(t, "FormatVolume", operations[1].Name)
+	assert.False(t, operations[1].Started.IsZero())
+}
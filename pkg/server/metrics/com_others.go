@@ -0,0 +1,11 @@
+//go:build !windows
+// +build !windows
+
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+// comThreadMetrics returns no collectors, COM is only used on Windows.
+func comThreadMetrics() []prometheus.Collector {
+	return nil
+}
@@ -0,0 +1,29 @@
+//go:build windows
+// +build windows
+
+package metrics
+
+import (
+	"github.com/kubernetes-csi/csi-proxy/pkg/wmi"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// comThreadMetrics returns the collectors of the usage of the COM initialized threads.
+func comThreadMetrics() []prometheus.Collector {
+	return []prometheus.Collector{
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Name: "csi_proxy_com_threads_in_use",
+			Help: "Number of OS threads currently locked with COM initialized to call WMI.",
+		}, func() float64 {
+			inUse, _ := wmi.COMThreadStats()
+			return float64(inUse)
+		}),
+		prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Name: "csi_proxy_com_thread_initializations_total",
+			Help: "Number of times an OS thread was locked with COM initialized to call WMI.",
+		}, func() float64 {
+			_, total := wmi.COMThreadStats()
+			return float64(total)
+		}),
+	}
+}
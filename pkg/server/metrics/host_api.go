@@ -0,0 +1,55 @@
+package metrics
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-ole/go-ole"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	// hostAPICallDuration records the duration of the calls to the host APIs, see ObserveHostAPICall.
+	hostAPICallDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "csi_proxy_host_api_call_duration_seconds",
+		Help:    "Duration of the calls to the host APIs, by API group and operation.",
+		Buckets: []float64{0.001, 0.01, 0.1, 0.3, 0.6, 1, 3, 6, 9, 20, 30, 60, 90, 120},
+	}, []string{"api", "operation"})
+
+	// hostAPICallErrors counts the failed calls to the host APIs, by WMI error code.
+	hostAPICallErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "csi_proxy_host_api_call_errors_total",
+		Help: "Number of failed calls to the host APIs, by API group, operation and WMI method return value or COM HRESULT.",
+	}, []string{"api", "operation", "code"})
+)
+
+// codedError is implemented by the errors carrying the return value of a WMI method, i.e. wmi.WMIError.
+type codedError interface {
+	error
+	ErrorCode() uint32
+}
+
+// ObserveHostAPICall records the duration of the call to the host API operation which started at start,
+// and counts it as failed if *err isn't nil. It's meant to be deferred with a named error result:
+//
+//	defer metrics.ObserveHostAPICall("disk", "InitializeDisk", time.Now(), &err)
+func ObserveHostAPICall(api, operation string, start time.Time, err *error) {
+	hostAPICallDuration.WithLabelValues(api, operation).Observe(time.Since(start).Seconds())
+	if *err != nil {
+		hostAPICallErrors.WithLabelValues(api, operation, errorCode(*err)).Inc()
+	}
+}
+
+// errorCode returns the WMI method return value or the COM HRESULT of err, "unknown" if it has none.
+func errorCode(err error) string {
+	var wmiErr codedError
+	if errors.As(err, &wmiErr) {
+		return fmt.Sprintf("%d", wmiErr.ErrorCode())
+	}
+	var oleErr *ole.OleError
+	if errors.As(err, &oleErr) {
+		return fmt.Sprintf("0x%08X", uint32(oleErr.Code()))
+	}
+	return "unknown"
+}
@@ -0,0 +1,77 @@
+package metrics
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-ole/go-ole"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type wmiError struct {
+	code uint32
+}
+
+func (e *wmiError) Error() string {
+	return fmt.Sprintf("code=%d", e.code)
+}
+
+func (e *wmiError) ErrorCode() uint32 {
+	return e.code
+}
+
+func TestErrorCode(t *testing.T) {
+	testCases := map[string]struct {
+		err      error
+		expected string
+	}{
+		"WMI error":          {err: fmt.Errorf("failed to create partition: %w", &wmiError{code: 42002}), expected: "42002"},
+		"COM error":          {err: fmt.Errorf("failed to query disks: %w", ole.NewError(0x80041010)), expected: "0x80041010"},
+		"error without code": {err: errors.New("not found"), expected: "unknown"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, errorCode(tc.err))
+		})
+	}
+}
+
+func TestObserveHostAPICall(t *testing.T) {
+	registry := prometheus.NewPedanticRegistry()
+	registry.MustRegister(hostAPICallDuration, hostAPICallErrors)
+
+	succeed := func() (err error) {
+		defer ObserveHostAPICall("disk", "InitializeDisk", time.Now(), &err)
+		return nil
+	}
+	fail := func() (err error) {
+		defer ObserveHostAPICall("disk", "InitializeDisk", time.Now(), &err)
+		return &wmiError{code: 5}
+	}
+	require.Nil(t, succeed())
+	require.NotNil(t, fail())
+
+	families, err := registry.Gather()
+	require.Nil(t, err)
+	values := make(map[string]uint64)
+	for _, family := range families {
+		for _, metric := range family.GetMetric() {
+			switch family.GetName() {
+			case "csi_proxy_host_api_call_duration_seconds":
+				values[family.GetName()] += metric.GetHistogram().GetSampleCount()
+			case "csi_proxy_host_api_call_errors_total":
+				assert.Len(t, metric.GetLabel(), 3)
+				values[family.GetName()] += uint64(metric.GetCounter().GetValue())
+			}
+		}
+	}
+	assert.Equal(t, map[string]uint64{
+		"csi_proxy_host_api_call_duration_seconds": 2,
+		"csi_proxy_host_api_call_errors_total":     1,
+	}, values)
+}
@@ -16,6 +16,12 @@ var (
 	// metricsList is a list of all raw metrics that should be registered always, regardless of any feature gate's value.
 	metricsList       []prometheus.Collector
 	grpcServerMetrics *grpcprom.ServerMetrics
+
+	// apiVersionsExposed reports which API group versions are served (1) or disabled (0).
+	apiVersionsExposed = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "csi_proxy_api_version_exposed",
+		Help: "Whether an API group version is served (1) or disabled (0).",
+	}, []string{"group", "version"})
 )
 
 // Register registers a list of metrics.
@@ -28,7 +34,11 @@ func Register() {
 
 	metricsList = []prometheus.Collector{
 		grpcServerMetrics,
+		apiVersionsExposed,
+		hostAPICallDuration,
+		hostAPICallErrors,
 	}
+	metricsList = append(metricsList, comThreadMetrics()...)
 
 	for _, metric := range metricsList {
 		legacyregistry.RawMustRegister(metric)
@@ -36,8 +46,8 @@ func Register() {
 }
 
 // SetupMetricsServer creates an HTTP server to expose the gRPC server metrics
-// at the`endpoint at `metricsAddress`
-func SetupMetricsServer(metricsAddress string) error {
+// at the`endpoint at `metricsAddress`, along with the diagnostics handlers keyed by path
+func SetupMetricsServer(metricsAddress string, handlers map[string]http.Handler) error {
 	if metricsAddress == "" {
 		return nil
 	}
@@ -53,6 +63,9 @@ func SetupMetricsServer(metricsAddress string) error {
 
 		m := http.NewServeMux()
 		m.Handle("/metrics", legacyregistry.Handler())
+		for pattern, handler := range handlers {
+			m.Handle(pattern, handler)
+		}
 		if err := trapClosedConnErr(http.Serve(l, m)); err != nil {
 			klog.Fatalf("serve failure(%v), address(%v)", err, path)
 		}
@@ -70,6 +83,15 @@ func trapClosedConnErr(err error) error {
 	return err
 }
 
+// SetAPIVersionExposed records whether the given API group version is served.
+func SetAPIVersionExposed(group, version string, exposed bool) {
+	value := 0.0
+	if exposed {
+		value = 1
+	}
+	apiVersionsExposed.WithLabelValues(group, version).Set(value)
+}
+
 // GRPCServerMetricsOptions returns the ServerOption applying on gRPC server
 // to collect server metrics
 func GRPCServerMetricsOptions() []grpc.ServerOption {
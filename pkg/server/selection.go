@@ -0,0 +1,81 @@
+package server
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/metrics"
+	srvtypes "github.com/kubernetes-csi/csi-proxy/pkg/server/types"
+	"k8s.io/klog/v2"
+)
+
+// selectVersionedAPIs returns the versioned APIs belonging to enabledGroups, all of them if
+// it's empty, and not matching any of disabledVersions, see Config.DisabledVersions.
+// It returns an error if an enabled group or a disabled version doesn't match any of versionedAPIs,
+// so that typos don't leave unwanted APIs exposed, and if no versioned API is left to serve.
+func selectVersionedAPIs(versionedAPIs []*srvtypes.VersionedAPI, enabledGroups, disabledVersions []string) ([]*srvtypes.VersionedAPI, error) {
+	knownGroups := make(map[string]bool)
+	for _, versionedAPI := range versionedAPIs {
+		knownGroups[versionedAPI.Group] = true
+	}
+
+	enabled := make(map[string]bool)
+	for _, group := range enabledGroups {
+		if !knownGroups[group] {
+			return nil, fmt.Errorf("unknown API group %q", group)
+		}
+		enabled[group] = true
+	}
+
+	type disabledVersion struct {
+		group   string
+		version apiversion.Version
+		matched bool
+	}
+	disabled := make([]*disabledVersion, 0, len(disabledVersions))
+	for _, entry := range disabledVersions {
+		group, versionName, found := strings.Cut(entry, "/")
+		if !found {
+			group, versionName = "", entry
+		} else if !knownGroups[group] {
+			return nil, fmt.Errorf("unknown API group %q in disabled version %q", group, entry)
+		}
+		version, err := apiversion.NewVersion(versionName)
+		if err != nil {
+			return nil, fmt.Errorf("invalid disabled version %q: %w", entry, err)
+		}
+		disabled = append(disabled, &disabledVersion{group: group, version: version})
+	}
+
+	selected := make([]*srvtypes.VersionedAPI, 0, len(versionedAPIs))
+	for _, versionedAPI := range versionedAPIs {
+		exposed := len(enabled) == 0 || enabled[versionedAPI.Group]
+		for _, d := range disabled {
+			if (d.group == "" || d.group == versionedAPI.Group) && d.version.Compare(versionedAPI.Version) == apiversion.Equal {
+				d.matched = true
+				exposed = false
+			}
+		}
+
+		metrics.SetAPIVersionExposed(versionedAPI.Group, versionedAPI.Version.String(), exposed)
+		if exposed {
+			klog.Infof("Exposing API group %s version %s", versionedAPI.Group, versionedAPI.Version)
+			selected = append(selected, versionedAPI)
+		} else {
+			klog.Infof("Disabled API group %s version %s", versionedAPI.Group, versionedAPI.Version)
+		}
+	}
+
+	for i, d := range disabled {
+		if !d.matched {
+			return nil, fmt.Errorf("disabled version %q doesn't match any API group version", disabledVersions[i])
+		}
+	}
+
+	if len(selected) == 0 {
+		return nil, fmt.Errorf("all API group versions are disabled")
+	}
+
+	return selected, nil
+}
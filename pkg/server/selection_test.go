@@ -0,0 +1,98 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/kubernetes-csi/csi-proxy/integrationtests/apigroups/server/dummy"
+	srvtypes "github.com/kubernetes-csi/csi-proxy/pkg/server/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectVersionedAPIs(t *testing.T) {
+	testCases := []struct {
+		name             string
+		enabledGroups    []string
+		disabledVersions []string
+		expected         []string
+		expectedErr      string
+	}{
+		{
+			name:     "all by default",
+			expected: []string{"v1alpha1", "v1alpha2", "v1"},
+		},
+		{
+			name:          "enabled group",
+			enabledGroups: []string{"dummy"},
+			expected:      []string{"v1alpha1", "v1alpha2", "v1"},
+		},
+		{
+			name:             "disabled version in all groups",
+			disabledVersions: []string{"v1alpha1"},
+			expected:         []string{"v1alpha2", "v1"},
+		},
+		{
+			name:             "disabled version in a group",
+			disabledVersions: []string{"dummy/v1alpha2", "dummy/v1"},
+			expected:         []string{"v1alpha1"},
+		},
+		{
+			name:          "unknown enabled group",
+			enabledGroups: []string{"network"},
+			expectedErr:   `unknown API group "network"`,
+		},
+		{
+			name:             "unknown group in disabled version",
+			disabledVersions: []string{"network/v1"},
+			expectedErr:      `unknown API group "network" in disabled version "network/v1"`,
+		},
+		{
+			name:             "invalid disabled version",
+			disabledVersions: []string{"v1-alpha1"},
+			expectedErr:      `invalid disabled version "v1-alpha1"`,
+		},
+		{
+			name:             "unmatched disabled version",
+			disabledVersions: []string{"v2"},
+			expectedErr:      `disabled version "v2" doesn't match any API group version`,
+		},
+		{
+			name:             "all versions disabled",
+			disabledVersions: []string{"v1alpha1", "v1alpha2", "v1"},
+			expectedErr:      "all API group versions are disabled",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			selected, err := selectVersionedAPIs((&dummy.Server{}).VersionedAPIs(), tc.enabledGroups, tc.disabledVersions)
+			if tc.expectedErr != "" {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, tc.expected, versionNames(selected))
+		})
+	}
+}
+
+func TestNewServerDisabledVersions(t *testing.T) {
+	s, err := NewServer(Config{ListenerFactory: NewUnixListenerFactory(t.TempDir()), DisabledVersions: []string{"v1alpha1"}}, &dummy.Server{})
+	require.Nil(t, err)
+
+	names := make([]string, 0, len(s.endpoints))
+	for _, endpoint := range s.endpoints {
+		names = append(names, endpoint.String())
+	}
+	assert.NotContains(t, names, "API group dummy version v1alpha1")
+	assert.Len(t, names, 2)
+}
+
+func versionNames(versionedAPIs []*srvtypes.VersionedAPI) []string {
+	names := make([]string, 0, len(versionedAPIs))
+	for _, versionedAPI := range versionedAPIs {
+		names = append(names, versionedAPI.Version.String())
+	}
+	return names
+}
@@ -1,50 +1,206 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"net"
+	"strings"
 	"sync"
+	"time"
 
-	"github.com/Microsoft/go-winio"
-	"github.com/kubernetes-csi/csi-proxy/client"
+	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/apierrors"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/audit"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/authz"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/caller"
 	"github.com/kubernetes-csi/csi-proxy/pkg/server/metrics"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/tracing"
 	srvtypes "github.com/kubernetes-csi/csi-proxy/pkg/server/types"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/reflection"
+	"k8s.io/klog/v2"
 )
 
+// DefaultShutdownTimeout is the default time GracefulStop waits for in-flight requests.
+const DefaultShutdownTimeout = 30 * time.Second
+
+// Config holds the settings of a Server.
+type Config struct {
+	// EnableMetrics registers the GRPC server metrics.
+	EnableMetrics bool
+	// ShutdownTimeout is how long GracefulStop waits for in-flight requests to
+	// complete before stopping the GRPC servers forcefully.
+	// Defaults to DefaultShutdownTimeout.
+	ShutdownTimeout time.Duration
+	// ListenerFactory creates the listeners the API versions are served on.
+	// Defaults to named pipes with the system's default security descriptor, see NewPipeListenerFactory.
+	ListenerFactory ListenerFactory
+	// Multiplexed serves all the API groups and versions on a single GRPC server
+	// and endpoint, see client.MultiplexedPipePath, instead of one per API version.
+	Multiplexed bool
+	// EnableReflection registers the GRPC reflection service on every endpoint,
+	// so that tools like grpcurl can list and call the API versions.
+	EnableReflection bool
+	// HealthCheckInterval is how often the API groups implementing
+	// srvtypes.HealthReporter are asked for their status, reported by the
+	// grpc.health.v1 service. Defaults to DefaultHealthCheckInterval.
+	HealthCheckInterval time.Duration
+	// AuditLogger, if set, gets an audit record for every mutating request.
+	AuditLogger *audit.Logger
+	// AuthorizationPolicy, if set, denies the requests it doesn't allow.
+	AuthorizationPolicy *authz.Policy
+	// IdentityProvider identifies the clients the authorization policy is enforced on.
+	// Defaults to authz.CallerIdentityProvider.
+	IdentityProvider authz.IdentityProvider
+	// EnabledGroups are the API groups to serve, all of them if empty.
+	EnabledGroups []string
+	// DisabledVersions are the API versions not to serve, either "<version>" for all
+	// the API groups, e.g. "v1alpha1", or "<group>/<version>", e.g. "system/v1alpha1".
+	DisabledVersions []string
+	// EnableTracing starts a span for every GRPC call, continuing the trace propagated
+	// in the client's metadata, and exports it with the global tracer provider, see tracing.Setup.
+	EnableTracing bool
+}
+
 // Server aggregates a number of API groups and versions,
 // and serves requests for all of them.
 type Server struct {
+	versionedAPIs   []*srvtypes.VersionedAPI
+	endpoints       []*endpoint
+	started         bool
+	mutex           *sync.Mutex
+	listenerFactory ListenerFactory
+	exposeMetrics   bool
+	shutdownTimeout time.Duration
+	inFlight        *inFlightRequests
+	stopOnce        sync.Once
+
+	enableReflection    bool
+	healthReporters     map[*srvtypes.VersionedAPI]srvtypes.HealthReporter
+	healthCheckInterval time.Duration
+	shutdownListeners   []srvtypes.ShutdownListener
+	auditLogger         *audit.Logger
+	authorizer          *authz.Authorizer
+	enableTracing       bool
+}
+
+// endpoint is a listener, and the GRPC server serving one or more API versions on it.
+type endpoint struct {
 	versionedAPIs []*srvtypes.VersionedAPI
-	started       bool
-	mutex         *sync.Mutex
-	grpcServers   []*grpc.Server
-	exposeMetrics bool
+	// multiplexed is true for the single endpoint serving all the API versions, see Config.Multiplexed.
+	multiplexed bool
+	listener    net.Listener
+	grpcServer  *grpc.Server
+	// health serves the grpc.health.v1 service, serviceNames holds
+	// the GRPC service names registered by each of versionedAPIs.
+	health       *health.Server
+	serviceNames [][]string
 }
 
-// NewServer creates a new Server for the given API groups.
-func NewServer(enableMetrics bool, apiGroups ...srvtypes.APIGroup) *Server {
+func (e *endpoint) String() string {
+	if e.multiplexed {
+		return "multiplexed endpoint"
+	}
+	return fmt.Sprintf("API group %s version %s", e.versionedAPIs[0].Group, e.versionedAPIs[0].Version)
+}
+
+func (e *endpoint) listen(listenerFactory ListenerFactory) (net.Listener, error) {
+	if e.multiplexed {
+		return listenerFactory.ListenMultiplexed()
+	}
+	return listenerFactory.Listen(e.versionedAPIs[0].Group, e.versionedAPIs[0].Version)
+}
+
+// NewServer creates a new Server for the given API groups, serving the versions selected
+// by the config's EnabledGroups and DisabledVersions.
+func NewServer(config Config, apiGroups ...srvtypes.APIGroup) (*Server, error) {
 	versionedAPIs := make([]*srvtypes.VersionedAPI, 0, len(apiGroups))
+	healthReporters := make(map[*srvtypes.VersionedAPI]srvtypes.HealthReporter)
+	var shutdownListeners []srvtypes.ShutdownListener
 	for _, apiGroup := range apiGroups {
-		versionedAPIs = append(versionedAPIs, apiGroup.VersionedAPIs()...)
+		groupVersionedAPIs := apiGroup.VersionedAPIs()
+		versionedAPIs = append(versionedAPIs, groupVersionedAPIs...)
+
+		if reporter, ok := apiGroup.(srvtypes.HealthReporter); ok {
+			for _, versionedAPI := range groupVersionedAPIs {
+				healthReporters[versionedAPI] = reporter
+			}
+		}
+		if listener, ok := apiGroup.(srvtypes.ShutdownListener); ok {
+			shutdownListeners = append(shutdownListeners, listener)
+		}
 	}
 
-	if enableMetrics {
+	versionedAPIs, err := selectVersionedAPIs(versionedAPIs, config.EnabledGroups, config.DisabledVersions)
+	if err != nil {
+		return nil, err
+	}
+
+	var endpoints []*endpoint
+	if config.Multiplexed {
+		// GRPC service names include the API version's package, so they don't collide
+		endpoints = []*endpoint{{versionedAPIs: versionedAPIs, multiplexed: true}}
+	} else {
+		endpoints = make([]*endpoint, 0, len(versionedAPIs))
+		for _, versionedAPI := range versionedAPIs {
+			endpoints = append(endpoints, &endpoint{versionedAPIs: []*srvtypes.VersionedAPI{versionedAPI}})
+		}
+	}
+
+	if config.EnableMetrics {
 		metrics.Register()
 	}
 
-	return &Server{
-		versionedAPIs: versionedAPIs,
-		mutex:         &sync.Mutex{},
-		exposeMetrics: enableMetrics,
+	shutdownTimeout := config.ShutdownTimeout
+	if shutdownTimeout <= 0 {
+		shutdownTimeout = DefaultShutdownTimeout
+	}
+
+	healthCheckInterval := config.HealthCheckInterval
+	if healthCheckInterval <= 0 {
+		healthCheckInterval = DefaultHealthCheckInterval
+	}
+
+	listenerFactory := config.ListenerFactory
+	if listenerFactory == nil {
+		listenerFactory = NewPipeListenerFactory(nil)
+	}
+
+	s := &Server{
+		versionedAPIs:   versionedAPIs,
+		endpoints:       endpoints,
+		mutex:           &sync.Mutex{},
+		listenerFactory: listenerFactory,
+		exposeMetrics:   config.EnableMetrics,
+		shutdownTimeout: shutdownTimeout,
+		inFlight:        &inFlightRequests{},
+
+		enableReflection:    config.EnableReflection,
+		healthReporters:     healthReporters,
+		healthCheckInterval: healthCheckInterval,
+		shutdownListeners:   shutdownListeners,
+		auditLogger:         config.AuditLogger,
+		enableTracing:       config.EnableTracing,
+	}
+
+	if config.AuthorizationPolicy != nil {
+		identityProvider := config.IdentityProvider
+		if identityProvider == nil {
+			identityProvider = authz.CallerIdentityProvider{}
+		}
+		s.authorizer = authz.NewAuthorizer(config.AuthorizationPolicy, identityProvider, s.resolveMethod)
 	}
+
+	return s, nil
 }
 
-// Start starts one GRPC server per API version; it is a blocking call, that returns
-// as soon as any of those servers shuts down (at which point it also shuts down all the
-// others).
+// Start starts one GRPC server per API version, or a single one for all of them in
+// multiplexed mode; it is a blocking call, that returns as soon as any of those servers
+// shuts down (at which point it also gracefully shuts down all the others), or once ctx
+// is done and all the servers have been gracefully stopped.
 // If passed a listeningChan, it will close it when it's started listening.
-func (s *Server) Start(listeningChan chan interface{}) []error {
+func (s *Server) Start(ctx context.Context, listeningChan chan interface{}) []error {
 	doneChan, ListenErr := s.startListening()
 	if len(ListenErr) != 0 {
 		return ListenErr
@@ -55,11 +211,25 @@ func (s *Server) Start(listeningChan chan interface{}) []error {
 		close(listeningChan)
 	}
 
+	s.updateHealth()
+
+	stoppedChan := make(chan struct{})
+	defer close(stoppedChan)
+	go s.checkHealthPeriodically(stoppedChan)
+	go func() {
+		select {
+		case <-ctx.Done():
+			klog.Infof("Context done, stopping GRPC servers: %v", ctx.Err())
+			s.gracefulStop()
+		case <-stoppedChan:
+		}
+	}()
+
 	return s.waitForGRPCServersToStop(doneChan)
 }
 
-// startListening creates the named pipes, and starts GRPC servers listening on them.
-func (s *Server) startListening() (chan *versionedAPIDone, []error) {
+// startListening creates the listeners, and starts GRPC servers listening on them.
+func (s *Server) startListening() (chan *endpointDone, []error) {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
@@ -68,34 +238,31 @@ func (s *Server) startListening() (chan *versionedAPIDone, []error) {
 	}
 	s.started = true
 
-	listeners, ListenErr := s.createListeners()
-	if len(ListenErr) != 0 {
+	if ListenErr := s.createListeners(); len(ListenErr) != 0 {
 		return nil, ListenErr
 	}
 
-	return s.createAndStartGRPCServers(listeners), nil
+	return s.createAndStartGRPCServers(), nil
 }
 
-// createListeners creates the named pipes.
-func (s *Server) createListeners() (listeners []net.Listener, errors []error) {
-	listeners = make([]net.Listener, len(s.versionedAPIs))
-
-	for i, versionedAPI := range s.versionedAPIs {
-		pipePath := client.PipePath(versionedAPI.Group, versionedAPI.Version)
-
-		listener, err := winio.ListenPipe(pipePath, nil)
+// createListeners creates the endpoints' listeners using the server's ListenerFactory.
+func (s *Server) createListeners() (errors []error) {
+	for _, endpoint := range s.endpoints {
+		listener, err := endpoint.listen(s.listenerFactory)
 		if err == nil {
-			listeners[i] = listener
+			klog.Infof("Listening on %s for %s", listener.Addr(), endpoint)
+			endpoint.listener = caller.WrapListener(listener)
 		} else {
-			errors = append(errors, err)
+			errors = append(errors, fmt.Errorf("unable to listen for %s: %w", endpoint, err))
 		}
 	}
 
 	if len(errors) != 0 {
 		// let's do a best effort to close all the listeners that we did manage to create
-		for _, listener := range listeners {
-			if listener != nil {
-				listener.Close()
+		for _, endpoint := range s.endpoints {
+			if endpoint.listener != nil {
+				endpoint.listener.Close()
+				endpoint.listener = nil
 			}
 		}
 	}
@@ -103,34 +270,56 @@ func (s *Server) createListeners() (listeners []net.Listener, errors []error) {
 	return
 }
 
-type versionedAPIDone struct {
-	index int
-	err   error
+type endpointDone struct {
+	endpoint *endpoint
+	err      error
 }
 
-// createAndStartGRPCServers creates the GRPC servers, but doesn't start them just yet.
-func (s *Server) createAndStartGRPCServers(listeners []net.Listener) chan *versionedAPIDone {
-	doneChan := make(chan *versionedAPIDone, len(s.versionedAPIs))
-	s.grpcServers = make([]*grpc.Server, len(s.versionedAPIs))
+// createAndStartGRPCServers creates the GRPC servers, registers the API versions
+// on them, and starts serving on the endpoints' listeners.
+func (s *Server) createAndStartGRPCServers() chan *endpointDone {
+	doneChan := make(chan *endpointDone, len(s.endpoints))
 
-	for i, versionedAPI := range s.versionedAPIs {
-		var opts []grpc.ServerOption
+	for _, endpoint := range s.endpoints {
+		opts := s.inFlight.serverOptions()
+		if s.enableTracing {
+			opts = append(opts, tracing.ServerOptions()...)
+		}
 		if s.exposeMetrics {
 			opts = append(opts, metrics.GRPCServerMetricsOptions()...)
 		}
+		if s.auditLogger != nil {
+			opts = append(opts,
+				grpc.ChainUnaryInterceptor(audit.UnaryServerInterceptor(s.auditLogger, s.resolveMethod)),
+				grpc.ChainStreamInterceptor(audit.StreamServerInterceptor(s.auditLogger, s.resolveMethod)))
+		}
+		// authorization comes last so that denied requests are audited
+		if s.authorizer != nil {
+			opts = append(opts,
+				grpc.ChainUnaryInterceptor(s.authorizer.UnaryServerInterceptor()),
+				grpc.ChainStreamInterceptor(s.authorizer.StreamServerInterceptor()))
+		}
+		// the handlers' errors are converted to status errors before any other interceptor sees them
+		opts = append(opts,
+			grpc.ChainUnaryInterceptor(apierrors.UnaryServerInterceptor()),
+			grpc.ChainStreamInterceptor(apierrors.StreamServerInterceptor()))
 		grpcServer := grpc.NewServer(opts...)
-		s.grpcServers[i] = grpcServer
+		endpoint.grpcServer = grpcServer
+
+		endpoint.registerVersionedAPIs(grpcServer)
+		if s.enableReflection {
+			reflection.Register(grpcServer)
+		}
 
-		versionedAPI.Registrant(grpcServer)
 		// this next line is not a tautology, because of how go treats closures...
-		index := i
+		endpoint := endpoint
 
 		go func() {
-			err := grpcServer.Serve(listeners[index])
+			err := grpcServer.Serve(endpoint.listener)
 
-			doneChan <- &versionedAPIDone{
-				index: index,
-				err:   err,
+			doneChan <- &endpointDone{
+				endpoint: endpoint,
+				err:      err,
 			}
 		}()
 	}
@@ -138,11 +327,10 @@ func (s *Server) createAndStartGRPCServers(listeners []net.Listener) chan *versi
 	return doneChan
 }
 
-func (s *Server) waitForGRPCServersToStop(doneChan chan *versionedAPIDone) (errs []error) {
-	processServerDoneEvent := func(event *versionedAPIDone) {
+func (s *Server) waitForGRPCServersToStop(doneChan chan *endpointDone) (errs []error) {
+	processServerDoneEvent := func(event *endpointDone) {
 		if event.err != nil {
-			versionedAPI := s.versionedAPIs[event.index]
-			err := fmt.Errorf("GRPC server for API group %s version %s failed: %w", versionedAPI.Group, versionedAPI.Version, event.err)
+			err := fmt.Errorf("GRPC server for %s failed: %w", event.endpoint, event.err)
 			errs = append(errs, err)
 		}
 	}
@@ -150,22 +338,134 @@ func (s *Server) waitForGRPCServersToStop(doneChan chan *versionedAPIDone) (errs
 	// and now let's wait for at least one server to be done
 	processServerDoneEvent(<-doneChan)
 
-	// let's stop all other servers
-	if err := s.Stop(); err != nil {
-		// cannot happen, as the only error Stop can return is if the server hasn't been started yet
+	// let's stop all other servers, letting them finish their in-flight requests
+	if err := s.GracefulStop(); err != nil {
+		// cannot happen, as the only error GracefulStop can return is if the server hasn't been started yet
 		panic(err)
 	}
 
-	// and wait for them to stop
-	// TODO: do we want a timeout here?
-	for doneCount := 1; doneCount < len(s.versionedAPIs); doneCount++ {
+	// and wait for them to stop, GracefulStop forces them to stop after the shutdown timeout
+	for doneCount := 1; doneCount < len(s.endpoints); doneCount++ {
 		processServerDoneEvent(<-doneChan)
 	}
 
 	return
 }
 
-// Stop stops all GRPC servers.
+// GracefulStop stops all GRPC servers from accepting new requests, and waits
+// for the in-flight requests to complete. If they're not done after the shutdown
+// timeout, the GRPC servers are stopped forcefully.
+// It's safe to call GracefulStop several times, only the first call waits.
+func (s *Server) GracefulStop() error {
+	s.mutex.Lock()
+	started := s.started
+	s.mutex.Unlock()
+
+	if !started {
+		return fmt.Errorf("server not started yet")
+	}
+
+	s.gracefulStop()
+	return nil
+}
+
+func (s *Server) gracefulStop() {
+	s.stopOnce.Do(func() {
+		s.mutex.Lock()
+		endpoints := s.endpoints
+		grpcServers := s.grpcServers()
+		s.mutex.Unlock()
+
+		klog.Infof("Gracefully stopping GRPC servers, %d request(s) in flight", s.inFlight.current())
+		shutdownHealth(endpoints)
+		// GracefulStop doesn't cancel the streams, the API groups end those that wouldn't end by themselves
+		for _, listener := range s.shutdownListeners {
+			listener.Shutdown()
+		}
+
+		drainedChan := make(chan struct{})
+		go func() {
+			var wg sync.WaitGroup
+			for _, grpcServer := range grpcServers {
+				if grpcServer == nil {
+					continue
+				}
+				wg.Add(1)
+				go func(grpcServer *grpc.Server) {
+					defer wg.Done()
+					grpcServer.GracefulStop()
+				}(grpcServer)
+			}
+			wg.Wait()
+			s.inFlight.wait()
+			close(drainedChan)
+		}()
+
+		timer := time.NewTimer(s.shutdownTimeout)
+		defer timer.Stop()
+
+		select {
+		case <-drainedChan:
+			klog.Infof("All in-flight requests completed")
+		case <-timer.C:
+			klog.Warningf("Shutdown timeout %v exceeded with %d request(s) still in flight, stopping GRPC servers", s.shutdownTimeout, s.inFlight.current())
+			s.stopGRPCServers(grpcServers)
+		}
+	})
+}
+
+// resolveMethod returns the API group and version registering the GRPC service of the
+// full method name fullMethod, e.g. "/v1.Disk/SetDiskState", along with the method's name.
+func (s *Server) resolveMethod(fullMethod string) (group, version, method string) {
+	serviceName, method := splitFullMethod(fullMethod)
+	for _, endpoint := range s.endpoints {
+		for i, serviceNames := range endpoint.serviceNames {
+			for _, name := range serviceNames {
+				if name == serviceName {
+					versionedAPI := endpoint.versionedAPIs[i]
+					return versionedAPI.Group, versionedAPI.Version.String(), method
+				}
+			}
+		}
+	}
+	return "", "", method
+}
+
+// splitFullMethod splits a GRPC full method name, e.g. "/v1.Disk/SetDiskState",
+// into its service and method names.
+func splitFullMethod(fullMethod string) (serviceName, method string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+	return "", fullMethod
+}
+
+// Addr returns the address the given API group and version is served on,
+// or nil if the server isn't listening for it.
+func (s *Server) Addr(apiGroupName string, apiVersion apiversion.Version) net.Addr {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, endpoint := range s.endpoints {
+		if endpoint.listener == nil {
+			continue
+		}
+		for _, versionedAPI := range endpoint.versionedAPIs {
+			if versionedAPI.Group == apiGroupName && versionedAPI.Version.Compare(apiVersion) == apiversion.Equal {
+				return endpoint.listener.Addr()
+			}
+		}
+	}
+	return nil
+}
+
+// InFlightRequests returns the number of requests currently being served.
+func (s *Server) InFlightRequests() int64 {
+	return s.inFlight.current()
+}
+
+// Stop stops all GRPC servers immediately, cancelling in-flight requests.
 func (s *Server) Stop() error {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
@@ -174,11 +474,26 @@ func (s *Server) Stop() error {
 		return fmt.Errorf("server not started yet")
 	}
 
-	for _, grpcServer := range s.grpcServers {
+	s.stopGRPCServers(s.grpcServers())
+
+	return nil
+}
+
+// grpcServers returns the endpoints' GRPC servers; the caller must hold the server's mutex.
+func (s *Server) grpcServers() []*grpc.Server {
+	grpcServers := make([]*grpc.Server, 0, len(s.endpoints))
+	for _, endpoint := range s.endpoints {
+		if endpoint.grpcServer != nil {
+			grpcServers = append(grpcServers, endpoint.grpcServer)
+		}
+	}
+	return grpcServers
+}
+
+func (s *Server) stopGRPCServers(grpcServers []*grpc.Server) {
+	for _, grpcServer := range grpcServers {
 		if grpcServer != nil {
 			grpcServer.Stop()
 		}
 	}
-
-	return nil
 }
@@ -0,0 +1,309 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/kubernetes-csi/csi-proxy/client"
+	v1 "github.com/kubernetes-csi/csi-proxy/integrationtests/apigroups/api/dummy/v1"
+	"github.com/kubernetes-csi/csi-proxy/integrationtests/apigroups/api/dummy/v1alpha2"
+	v1client "github.com/kubernetes-csi/csi-proxy/integrationtests/apigroups/client/dummy/v1"
+	v1alpha2client "github.com/kubernetes-csi/csi-proxy/integrationtests/apigroups/client/dummy/v1alpha2"
+	"github.com/kubernetes-csi/csi-proxy/integrationtests/apigroups/server/dummy"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/audit"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/authz"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/caller"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// startTestServer starts a server for the dummy API group with the given config,
+// and returns it along with a function to gracefully stop it.
+func startTestServer(t *testing.T, config Config) (*Server, func()) {
+	s, err := NewServer(config, &dummy.Server{})
+	require.Nil(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	listeningChan := make(chan interface{})
+	errsChan := make(chan []error, 1)
+	go func() {
+		errsChan <- s.Start(ctx, listeningChan)
+	}()
+
+	select {
+	case <-listeningChan:
+	case errs := <-errsChan:
+		t.Fatalf("Server failed to start: %v", errs)
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Timed out waiting for GRPC servers to start listening")
+	}
+
+	return s, func() {
+		cancel()
+		select {
+		case errs := <-errsChan:
+			assert.Empty(t, errs)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Timed out waiting for GRPC servers to stop")
+		}
+	}
+}
+
+func computeDouble(t *testing.T, c *v1alpha2client.Client) {
+	response, err := c.ComputeDouble(context.Background(), &v1alpha2.ComputeDoubleRequest{Input64: 21})
+	require.Nil(t, err)
+	assert.Equal(t, int64(42), response.Response)
+}
+
+func TestServerUnixTransport(t *testing.T) {
+	socketDir := t.TempDir()
+	_, stop := startTestServer(t, Config{ListenerFactory: NewUnixListenerFactory(socketDir)})
+	defer stop()
+
+	c, err := v1alpha2client.NewClientWithDialer(client.SocketPath(socketDir, v1alpha2client.GroupName, v1alpha2client.Version), client.DialUnix)
+	require.Nil(t, err)
+	defer c.Close()
+
+	computeDouble(t, c)
+}
+
+func TestServerTCPTransport(t *testing.T) {
+	listenerFactory, err := NewTCPListenerFactory("127.0.0.1:0")
+	require.Nil(t, err)
+	s, stop := startTestServer(t, Config{ListenerFactory: listenerFactory})
+	defer stop()
+
+	addr := s.Addr(v1alpha2client.GroupName, v1alpha2client.Version)
+	require.NotNil(t, addr)
+
+	c, err := v1alpha2client.NewClientWithDialer(addr.String(), client.DialTCP)
+	require.Nil(t, err)
+	defer c.Close()
+
+	computeDouble(t, c)
+}
+
+func TestServerMultiplexed(t *testing.T) {
+	socketDir := t.TempDir()
+	s, stop := startTestServer(t, Config{ListenerFactory: NewUnixListenerFactory(socketDir), Multiplexed: true})
+	defer stop()
+
+	// all versions are served on the same endpoint
+	assert.Equal(t, s.Addr(v1client.GroupName, v1client.Version), s.Addr(v1alpha2client.GroupName, v1alpha2client.Version))
+	_, err := os.Stat(client.SocketPath(socketDir, v1alpha2client.GroupName, v1alpha2client.Version))
+	assert.True(t, os.IsNotExist(err), "expected no per-version socket, got %v", err)
+
+	connection, err := client.NewMultiplexedConnectionWithDialer(client.MultiplexedSocketPath(socketDir), client.DialUnix)
+	require.Nil(t, err)
+	defer connection.Close()
+
+	v1Client := v1client.NewClientWithConnection(connection)
+	response, err := v1Client.ComputeDouble(context.Background(), &v1.ComputeDoubleRequest{Input64: 21})
+	require.Nil(t, err)
+	assert.Equal(t, int64(42), response.Response)
+	// closing a client doesn't close the shared connection
+	assert.Nil(t, v1Client.Close())
+
+	computeDouble(t, v1alpha2client.NewClientWithConnection(connection))
+}
+
+func TestServerAuditLog(t *testing.T) {
+	socketDir := t.TempDir()
+	var buffer bytes.Buffer
+	_, stop := startTestServer(t, Config{ListenerFactory: NewUnixListenerFactory(socketDir), AuditLogger: audit.NewLogger(&buffer)})
+
+	socketPath := client.SocketPath(socketDir, v1client.GroupName, v1client.Version)
+	c, err := v1client.NewClientWithDialer(socketPath, client.DialUnix)
+	require.Nil(t, err)
+	_, err = c.ComputeDouble(context.Background(), &v1.ComputeDoubleRequest{Input64: 21})
+	require.Nil(t, err)
+	require.Nil(t, c.Close())
+	stop()
+
+	record := &audit.Record{}
+	require.Nil(t, json.Unmarshal(buffer.Bytes(), record))
+	assert.Equal(t, "dummy", record.Group)
+	assert.Equal(t, "v1", record.Version)
+	assert.Equal(t, "ComputeDouble", record.Method)
+	assert.JSONEq(t, `{"input64":"21"}`, string(record.Request))
+	assert.Equal(t, "OK", record.Result)
+	assert.Equal(t, socketPath, record.Caller.Endpoint)
+	if runtime.GOOS == "linux" {
+		assert.Equal(t, os.Getpid(), record.Caller.PID)
+	}
+}
+
+// staticIdentityProvider identifies all clients as the same identity.
+type staticIdentityProvider struct {
+	identity *caller.Identity
+}
+
+func (p *staticIdentityProvider) Identity(context.Context) (*caller.Identity, error) {
+	return p.identity, nil
+}
+
+func TestServerAuthorization(t *testing.T) {
+	policy := &authz.Policy{Rules: []authz.Rule{{
+		Principals: []string{"alice"},
+		Methods:    []string{"dummy.ComputeDouble"},
+	}}}
+
+	testCases := []struct {
+		user         string
+		expectedCode codes.Code
+	}{
+		{user: "alice", expectedCode: codes.OK},
+		{user: "bob", expectedCode: codes.PermissionDenied},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.user, func(t *testing.T) {
+			socketDir := t.TempDir()
+			_, stop := startTestServer(t, Config{
+				ListenerFactory:     NewUnixListenerFactory(socketDir),
+				Multiplexed:         true,
+				AuthorizationPolicy: policy,
+				IdentityProvider:    &staticIdentityProvider{identity: &caller.Identity{User: tc.user}},
+			})
+			defer stop()
+
+			connection, err := client.NewMultiplexedConnectionWithDialer(client.MultiplexedSocketPath(socketDir), client.DialUnix)
+			require.Nil(t, err)
+			defer connection.Close()
+
+			_, err = v1client.NewClientWithConnection(connection).ComputeDouble(context.Background(), &v1.ComputeDoubleRequest{Input64: 21})
+			assert.Equal(t, tc.expectedCode, status.Code(err))
+
+			// health checks are always allowed
+			_, err = healthpb.NewHealthClient(connection).Check(context.Background(), &healthpb.HealthCheckRequest{})
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestServerTracing(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	previousProvider, previousPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer func() {
+		otel.SetTracerProvider(previousProvider)
+		otel.SetTextMapPropagator(previousPropagator)
+	}()
+
+	socketDir := t.TempDir()
+	_, stop := startTestServer(t, Config{ListenerFactory: NewUnixListenerFactory(socketDir), Multiplexed: true, EnableTracing: true})
+	defer stop()
+
+	connection, err := grpc.NewClient("unix://"+client.MultiplexedSocketPath(socketDir),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()))
+	require.Nil(t, err)
+	defer connection.Close()
+
+	ctx, span := provider.Tracer("test").Start(context.Background(), "NodeStageVolume")
+	_, err = v1client.NewClientWithConnection(connection).ComputeDouble(ctx, &v1.ComputeDoubleRequest{Input64: 21})
+	require.Nil(t, err)
+	span.End()
+
+	// the server span continues the trace propagated by the client
+	var serverSpan *tracetest.SpanStub
+	spans := exporter.GetSpans()
+	for i := range spans {
+		if spans[i].SpanKind == trace.SpanKindServer {
+			serverSpan = &spans[i]
+		}
+	}
+	require.NotNil(t, serverSpan, "no server span in %v", spans)
+	assert.Equal(t, span.SpanContext().TraceID(), serverSpan.SpanContext.TraceID())
+	assert.Equal(t, "v1.Dummy/ComputeDouble", serverSpan.Name)
+}
+
+// shutdownDummyServer is the dummy API group, recording when the server shuts it down.
+type shutdownDummyServer struct {
+	dummy.Server
+	shutdown chan struct{}
+}
+
+func (s *shutdownDummyServer) Shutdown() {
+	close(s.shutdown)
+}
+
+func TestServerShutdownListener(t *testing.T) {
+	apiGroup := &shutdownDummyServer{shutdown: make(chan struct{})}
+	s, err := NewServer(Config{ListenerFactory: NewUnixListenerFactory(t.TempDir())}, apiGroup)
+	require.Nil(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	listeningChan := make(chan interface{})
+	errsChan := make(chan []error, 1)
+	go func() {
+		errsChan <- s.Start(ctx, listeningChan)
+	}()
+	<-listeningChan
+
+	select {
+	case <-apiGroup.shutdown:
+		t.Fatalf("API group shut down while serving")
+	default:
+	}
+
+	cancel()
+	select {
+	case <-apiGroup.shutdown:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Timed out waiting for the API group to shut down")
+	}
+	assert.Empty(t, <-errsChan)
+
+	// stopping again doesn't shut the API group down twice
+	require.Nil(t, s.GracefulStop())
+}
+
+func TestNewListenerFactory(t *testing.T) {
+	testCases := []struct {
+		name        string
+		transport   string
+		address     string
+		expectError bool
+	}{
+		{name: "default transport", transport: ""},
+		{name: "pipe", transport: TransportPipe},
+		{name: "unix", transport: TransportUnix, address: t.TempDir()},
+		{name: "unix without a directory", transport: TransportUnix, expectError: true},
+		{name: "tcp with default address", transport: TransportTCP},
+		{name: "tcp on localhost", transport: TransportTCP, address: "localhost:9100"},
+		{name: "tcp on IPv6 loopback", transport: TransportTCP, address: "[::1]:9100"},
+		{name: "tcp on a non-loopback address", transport: TransportTCP, address: "0.0.0.0:9100", expectError: true},
+		{name: "tcp with an invalid port", transport: TransportTCP, address: "127.0.0.1:http", expectError: true},
+		{name: "unknown transport", transport: "carrier-pigeon", expectError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewListenerFactory(tc.transport, tc.address, nil)
+			if tc.expectError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package smb
+
+import (
+	"time"
+
+	"github.com/kubernetes-csi/csi-proxy/pkg/os/smb"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/metrics"
+)
+
+// instrumentedAPI records the duration and the errors of the calls to the smb host API.
+type instrumentedAPI struct {
+	hostAPI smb.API
+}
+
+// NewInstrumentedAPI returns a smb.API recording the duration and the errors of the calls to hostAPI
+// in the host API metrics.
+func NewInstrumentedAPI(hostAPI smb.API) smb.API {
+	return &instrumentedAPI{hostAPI: hostAPI}
+}
+
+func (i *instrumentedAPI) IsSmbMapped(remotePath string) (_ bool, err error) {
+	defer metrics.ObserveHostAPICall("smb", "IsSmbMapped", time.Now(), &err)
+	return i.hostAPI.IsSmbMapped(remotePath)
+}
+
+func (i *instrumentedAPI) NewSmbLink(remotePath, localPath string) (err error) {
+	defer metrics.ObserveHostAPICall("smb", "NewSmbLink", time.Now(), &err)
+	return i.hostAPI.NewSmbLink(remotePath, localPath)
+}
+
+func (i *instrumentedAPI) NewSmbGlobalMapping(remotePath, username, password string) (err error) {
+	defer metrics.ObserveHostAPICall("smb", "NewSmbGlobalMapping", time.Now(), &err)
+	return i.hostAPI.NewSmbGlobalMapping(remotePath, username, password)
+}
+
+func (i *instrumentedAPI) RemoveSmbGlobalMapping(remotePath string) (err error) {
+	defer metrics.ObserveHostAPICall("smb", "RemoveSmbGlobalMapping", time.Now(), &err)
+	return i.hostAPI.RemoveSmbGlobalMapping(remotePath)
+}
+
+func (i *instrumentedAPI) CheckHealth() (err error) {
+	defer metrics.ObserveHostAPICall("smb", "CheckHealth", time.Now(), &err)
+	return i.hostAPI.CheckHealth()
+}
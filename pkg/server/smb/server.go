@@ -8,13 +8,16 @@ import (
 	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
 	"github.com/kubernetes-csi/csi-proxy/pkg/os/smb"
 	fsserver "github.com/kubernetes-csi/csi-proxy/pkg/server/filesystem"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/locks"
 	internal "github.com/kubernetes-csi/csi-proxy/pkg/server/smb/impl"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/tracing"
 	"k8s.io/klog/v2"
 )
 
 type Server struct {
 	hostAPI  smb.API
 	fsServer *fsserver.Server
+	locks    *locks.Manager
 }
 
 // check that Server implements the ServerInterface
@@ -45,14 +48,23 @@ func getRootMappingPath(path string) (string, error) {
 	return strings.ToLower("\\\\" + parts[0] + "\\" + parts[1]), nil
 }
 
-func NewServer(hostAPI smb.API, fsServer *fsserver.Server) (*Server, error) {
+// NewServer returns a Server serializing its mutating operations on the same share with lockManager.
+func NewServer(hostAPI smb.API, fsServer *fsserver.Server, lockManager *locks.Manager) (*Server, error) {
 	return &Server{
 		hostAPI:  hostAPI,
 		fsServer: fsServer,
+		locks:    lockManager,
 	}, nil
 }
 
+// CheckHealth returns an error if the smb host API is unavailable, e.g. because its WMI provider
+// is down. All the versions share the host API, their health is the same.
+func (s *Server) CheckHealth(version apiversion.Version) error {
+	return s.hostAPI.CheckHealth()
+}
+
 func (s *Server) NewSmbGlobalMapping(context context.Context, request *internal.NewSmbGlobalMappingRequest, version apiversion.Version) (*internal.NewSmbGlobalMappingResponse, error) {
+	defer tracing.StartHostAPISpan(context, "smb", "NewSmbGlobalMapping")()
 	klog.V(2).Infof("calling NewSmbGlobalMapping with remote path %q", request.RemotePath)
 	response := &internal.NewSmbGlobalMappingResponse{}
 	remotePath := normalizeWindowsPath(request.RemotePath)
@@ -68,6 +80,13 @@ func (s *Server) NewSmbGlobalMapping(context context.Context, request *internal.
 		return response, err
 	}
 
+	release, err := s.locks.TryAcquire(locks.SMBShareResource(mappingPath), "NewSmbGlobalMapping")
+	if err != nil {
+		klog.Errorf("failed NewSmbGlobalMapping %v", err)
+		return response, err
+	}
+	defer release()
+
 	isMapped, err := s.hostAPI.IsSmbMapped(mappingPath)
 	if err != nil {
 		isMapped = false
@@ -122,6 +141,7 @@ func (s *Server) NewSmbGlobalMapping(context context.Context, request *internal.
 }
 
 func (s *Server) RemoveSmbGlobalMapping(context context.Context, request *internal.RemoveSmbGlobalMappingRequest, version apiversion.Version) (*internal.RemoveSmbGlobalMappingResponse, error) {
+	defer tracing.StartHostAPISpan(context, "smb", "RemoveSmbGlobalMapping")()
 	klog.V(2).Infof("calling RemoveSmbGlobalMapping with remote path %q", request.RemotePath)
 	response := &internal.RemoveSmbGlobalMappingResponse{}
 	remotePath := normalizeWindowsPath(request.RemotePath)
@@ -136,6 +156,13 @@ func (s *Server) RemoveSmbGlobalMapping(context context.Context, request *intern
 		return response, err
 	}
 
+	release, err := s.locks.TryAcquire(locks.SMBShareResource(mappingPath), "RemoveSmbGlobalMapping")
+	if err != nil {
+		klog.Errorf("failed RemoveSmbGlobalMapping %v", err)
+		return response, err
+	}
+	defer release()
+
 	err = s.hostAPI.RemoveSmbGlobalMapping(mappingPath)
 	if err != nil {
 		klog.Errorf("failed RemoveSmbGlobalMapping %v", err)
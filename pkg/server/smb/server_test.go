@@ -8,6 +8,7 @@ import (
 	"github.com/kubernetes-csi/csi-proxy/pkg/os/filesystem"
 	"github.com/kubernetes-csi/csi-proxy/pkg/os/smb"
 	fsserver "github.com/kubernetes-csi/csi-proxy/pkg/server/filesystem"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/locks"
 	internal "github.com/kubernetes-csi/csi-proxy/pkg/server/smb/impl"
 )
 
@@ -31,6 +32,10 @@ func (fakeSmbAPI) NewSmbLink(remotePath, localPath string) error {
 	return nil
 }
 
+func (fakeSmbAPI) CheckHealth() error {
+	return nil
+}
+
 type fakeFileSystemAPI struct{}
 
 var _ filesystem.API = &fakeFileSystemAPI{}
@@ -91,7 +96,7 @@ func TestNewSmbGlobalMapping(t *testing.T) {
 		t.Fatalf("FileSystem Server could not be initialized for testing: %v", err)
 	}
 
-	srv, err := NewServer(&fakeSmbAPI{}, fsSrv)
+	srv, err := NewServer(&fakeSmbAPI{}, fsSrv, locks.NewManager())
 	if err != nil {
 		t.Fatalf("Smb Server could not be initialized for testing: %v", err)
 	}
@@ -0,0 +1,47 @@
+package impl
+
+type ShadowCopy struct {
+	// Shadow copy ID, e.g. {1fb1a4b6-7b1a-4b27-9a0a-cb5a9d8f1f5e}
+	Id string
+	// Volume device ID of the volume, e.g. \\?\Volume{...}\
+	VolumeId string
+	// Device object of the shadow copy, e.g. \\?\GLOBALROOT\Device\HarddiskVolumeShadowCopy1
+	DeviceObject string
+	// Creation time of the shadow copy in seconds since the Unix epoch
+	CreationTime int64
+}
+
+type CreateShadowCopyRequest struct {
+	VolumeId string
+}
+
+type CreateShadowCopyResponse struct {
+	ShadowCopy *ShadowCopy
+}
+
+type ListShadowCopiesRequest struct {
+	// Volume device ID of the volume to list the shadow copies of, all the volumes if empty
+	VolumeId string
+}
+
+type ListShadowCopiesResponse struct {
+	ShadowCopies []*ShadowCopy
+}
+
+type DeleteShadowCopyRequest struct {
+	ShadowCopyId string
+}
+
+type DeleteShadowCopyResponse struct {
+	// Intentionally empty
+}
+
+type ExposeShadowCopyRequest struct {
+	ShadowCopyId string
+	// Path at which the shadow copy is exposed, within the working directories
+	Path string
+}
+
+type ExposeShadowCopyResponse struct {
+	// Intentionally empty
+}
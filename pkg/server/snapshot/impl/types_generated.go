@@ -0,0 +1,22 @@
+// Code generated by csi-proxy-api-gen. DO NOT EDIT.
+
+package impl
+
+import (
+	"context"
+
+	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+	"google.golang.org/grpc"
+)
+
+type VersionedAPI interface {
+	Register(grpcServer *grpc.Server)
+}
+
+// All the functions this group's server needs to define.
+type ServerInterface interface {
+	CreateShadowCopy(context.Context, *CreateShadowCopyRequest, apiversion.Version) (*CreateShadowCopyResponse, error)
+	DeleteShadowCopy(context.Context, *DeleteShadowCopyRequest, apiversion.Version) (*DeleteShadowCopyResponse, error)
+	ExposeShadowCopy(context.Context, *ExposeShadowCopyRequest, apiversion.Version) (*ExposeShadowCopyResponse, error)
+	ListShadowCopies(context.Context, *ListShadowCopiesRequest, apiversion.Version) (*ListShadowCopiesResponse, error)
+}
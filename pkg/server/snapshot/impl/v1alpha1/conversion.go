@@ -0,0 +1,24 @@
+package v1alpha1
+
+import (
+	"github.com/kubernetes-csi/csi-proxy/client/api/snapshot/v1alpha1"
+	impl "github.com/kubernetes-csi/csi-proxy/pkg/server/snapshot/impl"
+)
+
+// Add manual conversion functions here to override automatic conversion functions
+
+func Convert_impl_ListShadowCopiesResponse_To_v1alpha1_ListShadowCopiesResponse(in *impl.ListShadowCopiesResponse, out *v1alpha1.ListShadowCopiesResponse) error {
+	if in.ShadowCopies != nil {
+		in, out := &in.ShadowCopies, &out.ShadowCopies
+		*out = make([]*v1alpha1.ShadowCopy, len(*in))
+		for i := range *in {
+			(*out)[i] = new(v1alpha1.ShadowCopy)
+			if err := Convert_impl_ShadowCopy_To_v1alpha1_ShadowCopy((*in)[i], (*out)[i]); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ShadowCopies = nil
+	}
+	return nil
+}
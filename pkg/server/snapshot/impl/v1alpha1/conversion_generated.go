@@ -0,0 +1,212 @@
+// Code generated by csi-proxy-api-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/kubernetes-csi/csi-proxy/client/api/snapshot/v1alpha1"
+	impl "github.com/kubernetes-csi/csi-proxy/pkg/server/snapshot/impl"
+)
+
+func autoConvert_v1alpha1_CreateShadowCopyRequest_To_impl_CreateShadowCopyRequest(in *v1alpha1.CreateShadowCopyRequest, out *impl.CreateShadowCopyRequest) error {
+	out.VolumeId = in.VolumeId
+	return nil
+}
+
+// Convert_v1alpha1_CreateShadowCopyRequest_To_impl_CreateShadowCopyRequest is an autogenerated conversion function.
+func Convert_v1alpha1_CreateShadowCopyRequest_To_impl_CreateShadowCopyRequest(in *v1alpha1.CreateShadowCopyRequest, out *impl.CreateShadowCopyRequest) error {
+	return autoConvert_v1alpha1_CreateShadowCopyRequest_To_impl_CreateShadowCopyRequest(in, out)
+}
+
+func autoConvert_impl_CreateShadowCopyRequest_To_v1alpha1_CreateShadowCopyRequest(in *impl.CreateShadowCopyRequest, out *v1alpha1.CreateShadowCopyRequest) error {
+	out.VolumeId = in.VolumeId
+	return nil
+}
+
+// Convert_impl_CreateShadowCopyRequest_To_v1alpha1_CreateShadowCopyRequest is an autogenerated conversion function.
+func Convert_impl_CreateShadowCopyRequest_To_v1alpha1_CreateShadowCopyRequest(in *impl.CreateShadowCopyRequest, out *v1alpha1.CreateShadowCopyRequest) error {
+	return autoConvert_impl_CreateShadowCopyRequest_To_v1alpha1_CreateShadowCopyRequest(in, out)
+}
+
+func autoConvert_v1alpha1_CreateShadowCopyResponse_To_impl_CreateShadowCopyResponse(in *v1alpha1.CreateShadowCopyResponse, out *impl.CreateShadowCopyResponse) error {
+	if in.ShadowCopy != nil {
+		in, out := &in.ShadowCopy, &out.ShadowCopy
+		*out = new(impl.ShadowCopy)
+		if err := Convert_v1alpha1_ShadowCopy_To_impl_ShadowCopy(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.ShadowCopy = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_CreateShadowCopyResponse_To_impl_CreateShadowCopyResponse is an autogenerated conversion function.
+func Convert_v1alpha1_CreateShadowCopyResponse_To_impl_CreateShadowCopyResponse(in *v1alpha1.CreateShadowCopyResponse, out *impl.CreateShadowCopyResponse) error {
+	return autoConvert_v1alpha1_CreateShadowCopyResponse_To_impl_CreateShadowCopyResponse(in, out)
+}
+
+func autoConvert_impl_CreateShadowCopyResponse_To_v1alpha1_CreateShadowCopyResponse(in *impl.CreateShadowCopyResponse, out *v1alpha1.CreateShadowCopyResponse) error {
+	if in.ShadowCopy != nil {
+		in, out := &in.ShadowCopy, &out.ShadowCopy
+		*out = new(v1alpha1.ShadowCopy)
+		if err := Convert_impl_ShadowCopy_To_v1alpha1_ShadowCopy(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.ShadowCopy = nil
+	}
+	return nil
+}
+
+// Convert_impl_CreateShadowCopyResponse_To_v1alpha1_CreateShadowCopyResponse is an autogenerated conversion function.
+func Convert_impl_CreateShadowCopyResponse_To_v1alpha1_CreateShadowCopyResponse(in *impl.CreateShadowCopyResponse, out *v1alpha1.CreateShadowCopyResponse) error {
+	return autoConvert_impl_CreateShadowCopyResponse_To_v1alpha1_CreateShadowCopyResponse(in, out)
+}
+
+func autoConvert_v1alpha1_DeleteShadowCopyRequest_To_impl_DeleteShadowCopyRequest(in *v1alpha1.DeleteShadowCopyRequest, out *impl.DeleteShadowCopyRequest) error {
+	out.ShadowCopyId = in.ShadowCopyId
+	return nil
+}
+
+// Convert_v1alpha1_DeleteShadowCopyRequest_To_impl_DeleteShadowCopyRequest is an autogenerated conversion function.
+func Convert_v1alpha1_DeleteShadowCopyRequest_To_impl_DeleteShadowCopyRequest(in *v1alpha1.DeleteShadowCopyRequest, out *impl.DeleteShadowCopyRequest) error {
+	return autoConvert_v1alpha1_DeleteShadowCopyRequest_To_impl_DeleteShadowCopyRequest(in, out)
+}
+
+func autoConvert_impl_DeleteShadowCopyRequest_To_v1alpha1_DeleteShadowCopyRequest(in *impl.DeleteShadowCopyRequest, out *v1alpha1.DeleteShadowCopyRequest) error {
+	out.ShadowCopyId = in.ShadowCopyId
+	return nil
+}
+
+// Convert_impl_DeleteShadowCopyRequest_To_v1alpha1_DeleteShadowCopyRequest is an autogenerated conversion function.
+func Convert_impl_DeleteShadowCopyRequest_To_v1alpha1_DeleteShadowCopyRequest(in *impl.DeleteShadowCopyRequest, out *v1alpha1.DeleteShadowCopyRequest) error {
+	return autoConvert_impl_DeleteShadowCopyRequest_To_v1alpha1_DeleteShadowCopyRequest(in, out)
+}
+
+func autoConvert_v1alpha1_DeleteShadowCopyResponse_To_impl_DeleteShadowCopyResponse(in *v1alpha1.DeleteShadowCopyResponse, out *impl.DeleteShadowCopyResponse) error {
+	return nil
+}
+
+// Convert_v1alpha1_DeleteShadowCopyResponse_To_impl_DeleteShadowCopyResponse is an autogenerated conversion function.
+func Convert_v1alpha1_DeleteShadowCopyResponse_To_impl_DeleteShadowCopyResponse(in *v1alpha1.DeleteShadowCopyResponse, out *impl.DeleteShadowCopyResponse) error {
+	return autoConvert_v1alpha1_DeleteShadowCopyResponse_To_impl_DeleteShadowCopyResponse(in, out)
+}
+
+func autoConvert_impl_DeleteShadowCopyResponse_To_v1alpha1_DeleteShadowCopyResponse(in *impl.DeleteShadowCopyResponse, out *v1alpha1.DeleteShadowCopyResponse) error {
+	return nil
+}
+
+// Convert_impl_DeleteShadowCopyResponse_To_v1alpha1_DeleteShadowCopyResponse is an autogenerated conversion function.
+func Convert_impl_DeleteShadowCopyResponse_To_v1alpha1_DeleteShadowCopyResponse(in *impl.DeleteShadowCopyResponse, out *v1alpha1.DeleteShadowCopyResponse) error {
+	return autoConvert_impl_DeleteShadowCopyResponse_To_v1alpha1_DeleteShadowCopyResponse(in, out)
+}
+
+func autoConvert_v1alpha1_ExposeShadowCopyRequest_To_impl_ExposeShadowCopyRequest(in *v1alpha1.ExposeShadowCopyRequest, out *impl.ExposeShadowCopyRequest) error {
+	out.ShadowCopyId = in.ShadowCopyId
+	out.Path = in.Path
+	return nil
+}
+
+// Convert_v1alpha1_ExposeShadowCopyRequest_To_impl_ExposeShadowCopyRequest is an autogenerated conversion function.
+func Convert_v1alpha1_ExposeShadowCopyRequest_To_impl_ExposeShadowCopyRequest(in *v1alpha1.ExposeShadowCopyRequest, out *impl.ExposeShadowCopyRequest) error {
+	return autoConvert_v1alpha1_ExposeShadowCopyRequest_To_impl_ExposeShadowCopyRequest(in, out)
+}
+
+func autoConvert_impl_ExposeShadowCopyRequest_To_v1alpha1_ExposeShadowCopyRequest(in *impl.ExposeShadowCopyRequest, out *v1alpha1.ExposeShadowCopyRequest) error {
+	out.ShadowCopyId = in.ShadowCopyId
+	out.Path = in.Path
+	return nil
+}
+
+// Convert_impl_ExposeShadowCopyRequest_To_v1alpha1_ExposeShadowCopyRequest is an autogenerated conversion function.
+func Convert_impl_ExposeShadowCopyRequest_To_v1alpha1_ExposeShadowCopyRequest(in *impl.ExposeShadowCopyRequest, out *v1alpha1.ExposeShadowCopyRequest) error {
+	return autoConvert_impl_ExposeShadowCopyRequest_To_v1alpha1_ExposeShadowCopyRequest(in, out)
+}
+
+func autoConvert_v1alpha1_ExposeShadowCopyResponse_To_impl_ExposeShadowCopyResponse(in *v1alpha1.ExposeShadowCopyResponse, out *impl.ExposeShadowCopyResponse) error {
+	return nil
+}
+
+// Convert_v1alpha1_ExposeShadowCopyResponse_To_impl_ExposeShadowCopyResponse is an autogenerated conversion function.
+func Convert_v1alpha1_ExposeShadowCopyResponse_To_impl_ExposeShadowCopyResponse(in *v1alpha1.ExposeShadowCopyResponse, out *impl.ExposeShadowCopyResponse) error {
+	return autoConvert_v1alpha1_ExposeShadowCopyResponse_To_impl_ExposeShadowCopyResponse(in, out)
+}
+
+func autoConvert_impl_ExposeShadowCopyResponse_To_v1alpha1_ExposeShadowCopyResponse(in *impl.ExposeShadowCopyResponse, out *v1alpha1.ExposeShadowCopyResponse) error {
+	return nil
+}
+
+// Convert_impl_ExposeShadowCopyResponse_To_v1alpha1_ExposeShadowCopyResponse is an autogenerated conversion function.
+func Convert_impl_ExposeShadowCopyResponse_To_v1alpha1_ExposeShadowCopyResponse(in *impl.ExposeShadowCopyResponse, out *v1alpha1.ExposeShadowCopyResponse) error {
+	return autoConvert_impl_ExposeShadowCopyResponse_To_v1alpha1_ExposeShadowCopyResponse(in, out)
+}
+
+func autoConvert_v1alpha1_ListShadowCopiesRequest_To_impl_ListShadowCopiesRequest(in *v1alpha1.ListShadowCopiesRequest, out *impl.ListShadowCopiesRequest) error {
+	out.VolumeId = in.VolumeId
+	return nil
+}
+
+// Convert_v1alpha1_ListShadowCopiesRequest_To_impl_ListShadowCopiesRequest is an autogenerated conversion function.
+func Convert_v1alpha1_ListShadowCopiesRequest_To_impl_ListShadowCopiesRequest(in *v1alpha1.ListShadowCopiesRequest, out *impl.ListShadowCopiesRequest) error {
+	return autoConvert_v1alpha1_ListShadowCopiesRequest_To_impl_ListShadowCopiesRequest(in, out)
+}
+
+func autoConvert_impl_ListShadowCopiesRequest_To_v1alpha1_ListShadowCopiesRequest(in *impl.ListShadowCopiesRequest, out *v1alpha1.ListShadowCopiesRequest) error {
+	out.VolumeId = in.VolumeId
+	return nil
+}
+
+// Convert_impl_ListShadowCopiesRequest_To_v1alpha1_ListShadowCopiesRequest is an autogenerated conversion function.
+func Convert_impl_ListShadowCopiesRequest_To_v1alpha1_ListShadowCopiesRequest(in *impl.ListShadowCopiesRequest, out *v1alpha1.ListShadowCopiesRequest) error {
+	return autoConvert_impl_ListShadowCopiesRequest_To_v1alpha1_ListShadowCopiesRequest(in, out)
+}
+
+func autoConvert_v1alpha1_ListShadowCopiesResponse_To_impl_ListShadowCopiesResponse(in *v1alpha1.ListShadowCopiesResponse, out *impl.ListShadowCopiesResponse) error {
+	if in.ShadowCopies != nil {
+		in, out := &in.ShadowCopies, &out.ShadowCopies
+		*out = make([]*impl.ShadowCopy, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_ShadowCopy_To_impl_ShadowCopy(*&(*in)[i], *&(*out)[i]); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ShadowCopies = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ListShadowCopiesResponse_To_impl_ListShadowCopiesResponse is an autogenerated conversion function.
+func Convert_v1alpha1_ListShadowCopiesResponse_To_impl_ListShadowCopiesResponse(in *v1alpha1.ListShadowCopiesResponse, out *impl.ListShadowCopiesResponse) error {
+	return autoConvert_v1alpha1_ListShadowCopiesResponse_To_impl_ListShadowCopiesResponse(in, out)
+}
+
+// detected external conversion function
+// Convert_impl_ListShadowCopiesResponse_To_v1alpha1_ListShadowCopiesResponse(in *impl.ListShadowCopiesResponse, out *v1alpha1.ListShadowCopiesResponse) error
+// skipping generation of the auto function
+
+func autoConvert_v1alpha1_ShadowCopy_To_impl_ShadowCopy(in *v1alpha1.ShadowCopy, out *impl.ShadowCopy) error {
+	out.Id = in.Id
+	out.VolumeId = in.VolumeId
+	out.DeviceObject = in.DeviceObject
+	out.CreationTime = in.CreationTime
+	return nil
+}
+
+// Convert_v1alpha1_ShadowCopy_To_impl_ShadowCopy is an autogenerated conversion function.
+func Convert_v1alpha1_ShadowCopy_To_impl_ShadowCopy(in *v1alpha1.ShadowCopy, out *impl.ShadowCopy) error {
+	return autoConvert_v1alpha1_ShadowCopy_To_impl_ShadowCopy(in, out)
+}
+
+func autoConvert_impl_ShadowCopy_To_v1alpha1_ShadowCopy(in *impl.ShadowCopy, out *v1alpha1.ShadowCopy) error {
+	out.Id = in.Id
+	out.VolumeId = in.VolumeId
+	out.DeviceObject = in.DeviceObject
+	out.CreationTime = in.CreationTime
+	return nil
+}
+
+// Convert_impl_ShadowCopy_To_v1alpha1_ShadowCopy is an autogenerated conversion function.
+func Convert_impl_ShadowCopy_To_v1alpha1_ShadowCopy(in *impl.ShadowCopy, out *v1alpha1.ShadowCopy) error {
+	return autoConvert_impl_ShadowCopy_To_v1alpha1_ShadowCopy(in, out)
+}
@@ -0,0 +1,104 @@
+// Code generated by csi-proxy-api-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/kubernetes-csi/csi-proxy/client/api/snapshot/v1alpha1"
+	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/snapshot/impl"
+	"google.golang.org/grpc"
+)
+
+var version = apiversion.NewVersionOrPanic("v1alpha1")
+
+type versionedAPI struct {
+	apiGroupServer impl.ServerInterface
+}
+
+func NewVersionedServer(apiGroupServer impl.ServerInterface) impl.VersionedAPI {
+	return &versionedAPI{
+		apiGroupServer: apiGroupServer,
+	}
+}
+
+func (s *versionedAPI) Register(grpcServer *grpc.Server) {
+	v1alpha1.RegisterSnapshotServer(grpcServer, s)
+}
+
+func (s *versionedAPI) CreateShadowCopy(context context.Context, versionedRequest *v1alpha1.CreateShadowCopyRequest) (*v1alpha1.CreateShadowCopyResponse, error) {
+	request := &impl.CreateShadowCopyRequest{}
+	if err := Convert_v1alpha1_CreateShadowCopyRequest_To_impl_CreateShadowCopyRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.CreateShadowCopy(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v1alpha1.CreateShadowCopyResponse{}
+	if err := Convert_impl_CreateShadowCopyResponse_To_v1alpha1_CreateShadowCopyResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) DeleteShadowCopy(context context.Context, versionedRequest *v1alpha1.DeleteShadowCopyRequest) (*v1alpha1.DeleteShadowCopyResponse, error) {
+	request := &impl.DeleteShadowCopyRequest{}
+	if err := Convert_v1alpha1_DeleteShadowCopyRequest_To_impl_DeleteShadowCopyRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.DeleteShadowCopy(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v1alpha1.DeleteShadowCopyResponse{}
+	if err := Convert_impl_DeleteShadowCopyResponse_To_v1alpha1_DeleteShadowCopyResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) ExposeShadowCopy(context context.Context, versionedRequest *v1alpha1.ExposeShadowCopyRequest) (*v1alpha1.ExposeShadowCopyResponse, error) {
+	request := &impl.ExposeShadowCopyRequest{}
+	if err := Convert_v1alpha1_ExposeShadowCopyRequest_To_impl_ExposeShadowCopyRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.ExposeShadowCopy(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v1alpha1.ExposeShadowCopyResponse{}
+	if err := Convert_impl_ExposeShadowCopyResponse_To_v1alpha1_ExposeShadowCopyResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) ListShadowCopies(context context.Context, versionedRequest *v1alpha1.ListShadowCopiesRequest) (*v1alpha1.ListShadowCopiesResponse, error) {
+	request := &impl.ListShadowCopiesRequest{}
+	if err := Convert_v1alpha1_ListShadowCopiesRequest_To_impl_ListShadowCopiesRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.ListShadowCopies(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v1alpha1.ListShadowCopiesResponse{}
+	if err := Convert_impl_ListShadowCopiesResponse_To_v1alpha1_ListShadowCopiesResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
@@ -0,0 +1,39 @@
+package snapshot
+
+import (
+	"time"
+
+	"github.com/kubernetes-csi/csi-proxy/pkg/os/snapshot"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/metrics"
+)
+
+// instrumentedAPI records the duration and the errors of the calls to the snapshot host API.
+type instrumentedAPI struct {
+	hostAPI snapshot.API
+}
+
+// NewInstrumentedAPI returns a snapshot.API recording the duration and the errors of the calls to hostAPI
+// in the host API metrics.
+func NewInstrumentedAPI(hostAPI snapshot.API) snapshot.API {
+	return &instrumentedAPI{hostAPI: hostAPI}
+}
+
+func (i *instrumentedAPI) CreateShadowCopy(volumeID string) (_ *snapshot.ShadowCopy, err error) {
+	defer metrics.ObserveHostAPICall("snapshot", "CreateShadowCopy", time.Now(), &err)
+	return i.hostAPI.CreateShadowCopy(volumeID)
+}
+
+func (i *instrumentedAPI) ListShadowCopies(volumeID string) (_ []snapshot.ShadowCopy, err error) {
+	defer metrics.ObserveHostAPICall("snapshot", "ListShadowCopies", time.Now(), &err)
+	return i.hostAPI.ListShadowCopies(volumeID)
+}
+
+func (i *instrumentedAPI) DeleteShadowCopy(shadowCopyID string) (err error) {
+	defer metrics.ObserveHostAPICall("snapshot", "DeleteShadowCopy", time.Now(), &err)
+	return i.hostAPI.DeleteShadowCopy(shadowCopyID)
+}
+
+func (i *instrumentedAPI) ExposeShadowCopy(shadowCopyID, path string) (err error) {
+	defer metrics.ObserveHostAPICall("snapshot", "ExposeShadowCopy", time.Now(), &err)
+	return i.hostAPI.ExposeShadowCopy(shadowCopyID, path)
+}
@@ -0,0 +1,142 @@
+package snapshot
+
+import (
+	"context"
+	"sort"
+
+	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+	"github.com/kubernetes-csi/csi-proxy/pkg/os/snapshot"
+	fsserver "github.com/kubernetes-csi/csi-proxy/pkg/server/filesystem"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/locks"
+	internal "github.com/kubernetes-csi/csi-proxy/pkg/server/snapshot/impl"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/tracing"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+type Server struct {
+	hostAPI  snapshot.API
+	fsServer *fsserver.Server
+	locks    *locks.Manager
+}
+
+// check that Server implements internal.ServerInterface
+var _ internal.ServerInterface = &Server{}
+
+// NewServer returns a Server serializing its mutating operations on the same volume or shadow copy
+// with lockManager, and exposing the shadow copies only within the working directories of fsServer.
+func NewServer(hostAPI snapshot.API, fsServer *fsserver.Server, lockManager *locks.Manager) (*Server, error) {
+	return &Server{
+		hostAPI:  hostAPI,
+		fsServer: fsServer,
+		locks:    lockManager,
+	}, nil
+}
+
+func (s *Server) CreateShadowCopy(context context.Context, request *internal.CreateShadowCopyRequest, version apiversion.Version) (*internal.CreateShadowCopyResponse, error) {
+	defer tracing.StartHostAPISpan(context, "snapshot", "CreateShadowCopy")()
+	klog.V(2).Infof("Request: CreateShadowCopy: volumeID=%s", request.VolumeId)
+	response := &internal.CreateShadowCopyResponse{}
+	volumeID := request.VolumeId
+	if volumeID == "" {
+		return response, status.Error(codes.InvalidArgument, "volume id empty")
+	}
+
+	release, err := s.locks.TryAcquire(locks.VolumeResource(volumeID), "CreateShadowCopy")
+	if err != nil {
+		klog.Errorf("CreateShadowCopy failed: %v", err)
+		return response, err
+	}
+	defer release()
+
+	shadowCopy, err := s.hostAPI.CreateShadowCopy(volumeID)
+	if err != nil {
+		klog.Errorf("CreateShadowCopy failed: %v", err)
+		return response, err
+	}
+
+	response.ShadowCopy = toInternalShadowCopy(shadowCopy)
+	return response, nil
+}
+
+func (s *Server) ListShadowCopies(context context.Context, request *internal.ListShadowCopiesRequest, version apiversion.Version) (*internal.ListShadowCopiesResponse, error) {
+	defer tracing.StartHostAPISpan(context, "snapshot", "ListShadowCopies")()
+	klog.V(4).Infof("Request: ListShadowCopies: volumeID=%s", request.VolumeId)
+	response := &internal.ListShadowCopiesResponse{}
+
+	shadowCopies, err := s.hostAPI.ListShadowCopies(request.VolumeId)
+	if err != nil {
+		klog.Errorf("ListShadowCopies failed: %v", err)
+		return response, err
+	}
+
+	sort.SliceStable(shadowCopies, func(i, j int) bool {
+		return shadowCopies[i].CreationTime.Before(shadowCopies[j].CreationTime)
+	})
+	response.ShadowCopies = make([]*internal.ShadowCopy, 0, len(shadowCopies))
+	for i := range shadowCopies {
+		response.ShadowCopies = append(response.ShadowCopies, toInternalShadowCopy(&shadowCopies[i]))
+	}
+	return response, nil
+}
+
+func (s *Server) DeleteShadowCopy(context context.Context, request *internal.DeleteShadowCopyRequest, version apiversion.Version) (*internal.DeleteShadowCopyResponse, error) {
+	defer tracing.StartHostAPISpan(context, "snapshot", "DeleteShadowCopy")()
+	klog.V(2).Infof("Request: DeleteShadowCopy: shadowCopyID=%s", request.ShadowCopyId)
+	response := &internal.DeleteShadowCopyResponse{}
+	shadowCopyID := request.ShadowCopyId
+	if shadowCopyID == "" {
+		return response, status.Error(codes.InvalidArgument, "shadow copy id empty")
+	}
+
+	release, err := s.locks.TryAcquire(locks.ShadowCopyResource(shadowCopyID), "DeleteShadowCopy")
+	if err != nil {
+		klog.Errorf("DeleteShadowCopy failed: %v", err)
+		return response, err
+	}
+	defer release()
+
+	if err := s.hostAPI.DeleteShadowCopy(shadowCopyID); err != nil {
+		klog.Errorf("DeleteShadowCopy failed: %v", err)
+		return response, err
+	}
+	return response, nil
+}
+
+func (s *Server) ExposeShadowCopy(context context.Context, request *internal.ExposeShadowCopyRequest, version apiversion.Version) (*internal.ExposeShadowCopyResponse, error) {
+	defer tracing.StartHostAPISpan(context, "snapshot", "ExposeShadowCopy")()
+	klog.V(2).Infof("Request: ExposeShadowCopy: shadowCopyID=%s, path=%q", request.ShadowCopyId, request.Path)
+	response := &internal.ExposeShadowCopyResponse{}
+	shadowCopyID := request.ShadowCopyId
+	if shadowCopyID == "" {
+		return response, status.Error(codes.InvalidArgument, "shadow copy id empty")
+	}
+	if err := s.fsServer.ValidatePluginPath(request.Path); err != nil {
+		klog.Errorf("ExposeShadowCopy failed: %v", err)
+		return response, err
+	}
+
+	release, err := s.locks.TryAcquire(locks.ShadowCopyResource(shadowCopyID), "ExposeShadowCopy")
+	if err != nil {
+		klog.Errorf("ExposeShadowCopy failed: %v", err)
+		return response, err
+	}
+	defer release()
+
+	if err := s.hostAPI.ExposeShadowCopy(shadowCopyID, request.Path); err != nil {
+		klog.Errorf("ExposeShadowCopy failed: %v", err)
+		return response, err
+	}
+	return response, nil
+}
+
+// toInternalShadowCopy returns the internal representation of a shadow copy.
+func toInternalShadowCopy(shadowCopy *snapshot.ShadowCopy) *internal.ShadowCopy {
+	return &internal.ShadowCopy{
+		Id:           shadowCopy.ID,
+		VolumeId:     shadowCopy.VolumeID,
+		DeviceObject: shadowCopy.DeviceObject,
+		CreationTime: shadowCopy.CreationTime.Unix(),
+	}
+}
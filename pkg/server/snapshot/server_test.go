@@ -0,0 +1,175 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+	"github.com/kubernetes-csi/csi-proxy/pkg/os/snapshot"
+	fsserver "github.com/kubernetes-csi/csi-proxy/pkg/server/filesystem"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/locks"
+	internal "github.com/kubernetes-csi/csi-proxy/pkg/server/snapshot/impl"
+	"github.com/kubernetes-csi/csi-proxy/pkg/wmi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeSnapshotAPI keeps the shadow copies in memory, each one created a minute after the previous one.
+type fakeSnapshotAPI struct {
+	shadowCopies map[string]snapshot.ShadowCopy
+	exposed      map[string]string
+	created      int
+}
+
+var _ snapshot.API = &fakeSnapshotAPI{}
+
+func newFakeSnapshotAPI() *fakeSnapshotAPI {
+	return &fakeSnapshotAPI{
+		shadowCopies: map[string]snapshot.ShadowCopy{},
+		exposed:      map[string]string{},
+	}
+}
+
+func (f *fakeSnapshotAPI) CreateShadowCopy(volumeID string) (*snapshot.ShadowCopy, error) {
+	f.created++
+	shadowCopy := snapshot.ShadowCopy{
+		ID:           fmt.Sprintf("{%08d-0000-0000-0000-000000000000}", f.created),
+		VolumeID:     volumeID,
+		DeviceObject: fmt.Sprintf(`\\?\GLOBALROOT\Device\HarddiskVolumeShadowCopy%d`, f.created),
+		CreationTime: time.Date(2025, 1, 1, 0, f.created, 0, 0, time.UTC),
+	}
+	f.shadowCopies[shadowCopy.ID] = shadowCopy
+	return &shadowCopy, nil
+}
+
+func (f *fakeSnapshotAPI) ListShadowCopies(volumeID string) ([]snapshot.ShadowCopy, error) {
+	var shadowCopies []snapshot.ShadowCopy
+	for _, shadowCopy := range f.shadowCopies {
+		if volumeID == "" || shadowCopy.VolumeID == volumeID {
+			shadowCopies = append(shadowCopies, shadowCopy)
+		}
+	}
+	return shadowCopies, nil
+}
+
+func (f *fakeSnapshotAPI) DeleteShadowCopy(shadowCopyID string) error {
+	delete(f.shadowCopies, shadowCopyID)
+	return nil
+}
+
+func (f *fakeSnapshotAPI) ExposeShadowCopy(shadowCopyID, path string) error {
+	if _, ok := f.shadowCopies[shadowCopyID]; !ok {
+		return fmt.Errorf("failed to query shadow copy %s: %w", shadowCopyID, wmi.ErrNotFound)
+	}
+	f.exposed[path] = shadowCopyID
+	return nil
+}
+
+func newTestServer(t *testing.T, hostAPI snapshot.API) *Server {
+	fsSrv, err := fsserver.NewServer([]string{`C:\var\lib\kubelet`}, nil)
+	require.NoError(t, err)
+	srv, err := NewServer(hostAPI, fsSrv, locks.NewManager())
+	require.NoError(t, err)
+	return srv
+}
+
+func TestShadowCopies(t *testing.T) {
+	v1alpha1, err := apiversion.NewVersion("v1alpha1")
+	require.NoError(t, err)
+	hostAPI := newFakeSnapshotAPI()
+	srv := newTestServer(t, hostAPI)
+
+	var ids []string
+	for _, volumeID := range []string{`\\?\Volume{a}\`, `\\?\Volume{b}\`, `\\?\Volume{a}\`} {
+		response, err := srv.CreateShadowCopy(context.TODO(), &internal.CreateShadowCopyRequest{VolumeId: volumeID}, v1alpha1)
+		require.NoError(t, err)
+		assert.Equal(t, volumeID, response.ShadowCopy.VolumeId)
+		ids = append(ids, response.ShadowCopy.Id)
+	}
+
+	listResponse, err := srv.ListShadowCopies(context.TODO(), &internal.ListShadowCopiesRequest{}, v1alpha1)
+	require.NoError(t, err)
+	require.Len(t, listResponse.ShadowCopies, 3)
+	for i, shadowCopy := range listResponse.ShadowCopies {
+		assert.Equal(t, ids[i], shadowCopy.Id, "the shadow copies are listed the oldest first")
+	}
+	assert.Equal(t, time.Date(2025, 1, 1, 0, 1, 0, 0, time.UTC).Unix(), listResponse.ShadowCopies[0].CreationTime)
+
+	listResponse, err = srv.ListShadowCopies(context.TODO(), &internal.ListShadowCopiesRequest{VolumeId: `\\?\Volume{a}\`}, v1alpha1)
+	require.NoError(t, err)
+	require.Len(t, listResponse.ShadowCopies, 2)
+	assert.Equal(t, ids[0], listResponse.ShadowCopies[0].Id)
+	assert.Equal(t, ids[2], listResponse.ShadowCopies[1].Id)
+
+	_, err = srv.DeleteShadowCopy(context.TODO(), &internal.DeleteShadowCopyRequest{ShadowCopyId: ids[0]}, v1alpha1)
+	require.NoError(t, err)
+	assert.NotContains(t, hostAPI.shadowCopies, ids[0])
+
+	// deleting a shadow copy twice succeeds
+	_, err = srv.DeleteShadowCopy(context.TODO(), &internal.DeleteShadowCopyRequest{ShadowCopyId: ids[0]}, v1alpha1)
+	assert.NoError(t, err)
+
+	_, err = srv.CreateShadowCopy(context.TODO(), &internal.CreateShadowCopyRequest{}, v1alpha1)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "err=%v", err)
+	_, err = srv.DeleteShadowCopy(context.TODO(), &internal.DeleteShadowCopyRequest{}, v1alpha1)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "err=%v", err)
+}
+
+func TestExposeShadowCopy(t *testing.T) {
+	v1alpha1, err := apiversion.NewVersion("v1alpha1")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name              string
+		emptyShadowCopyID bool
+		path              string
+		expectedCode      codes.Code
+	}{
+		{
+			name:         "path within the working directories",
+			path:         `C:\var\lib\kubelet\plugins\csi-plugin\snapshot`,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "path outside of the working directories",
+			path:         `C:\Windows\snapshot`,
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "relative path",
+			path:         `snapshot`,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:              "empty shadow copy id",
+			emptyShadowCopyID: true,
+			path:              `C:\var\lib\kubelet\plugins\csi-plugin\snapshot`,
+			expectedCode:      codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			hostAPI := newFakeSnapshotAPI()
+			srv := newTestServer(t, hostAPI)
+			shadowCopy, err := hostAPI.CreateShadowCopy(`\\?\Volume{a}\`)
+			require.NoError(t, err)
+
+			shadowCopyID := shadowCopy.ID
+			if tc.emptyShadowCopyID {
+				shadowCopyID = ""
+			}
+			_, err = srv.ExposeShadowCopy(context.TODO(), &internal.ExposeShadowCopyRequest{ShadowCopyId: shadowCopyID, Path: tc.path}, v1alpha1)
+			assert.Equal(t, tc.expectedCode, status.Code(err), "err=%v", err)
+			if tc.expectedCode == codes.OK {
+				assert.Equal(t, shadowCopy.ID, hostAPI.exposed[tc.path])
+			} else {
+				assert.Empty(t, hostAPI.exposed)
+			}
+		})
+	}
+}
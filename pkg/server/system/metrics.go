@@ -0,0 +1,39 @@
+package system
+
+import (
+	"time"
+
+	"github.com/kubernetes-csi/csi-proxy/pkg/os/system"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/metrics"
+)
+
+// instrumentedAPI records the duration and the errors of the calls to the system host API.
+type instrumentedAPI struct {
+	hostAPI API
+}
+
+// NewInstrumentedAPI returns a API recording the duration and the errors of the calls to hostAPI
+// in the host API metrics.
+func NewInstrumentedAPI(hostAPI API) API {
+	return &instrumentedAPI{hostAPI: hostAPI}
+}
+
+func (i *instrumentedAPI) GetBIOSSerialNumber() (_ string, err error) {
+	defer metrics.ObserveHostAPICall("system", "GetBIOSSerialNumber", time.Now(), &err)
+	return i.hostAPI.GetBIOSSerialNumber()
+}
+
+func (i *instrumentedAPI) GetService(name string) (_ *system.ServiceInfo, err error) {
+	defer metrics.ObserveHostAPICall("system", "GetService", time.Now(), &err)
+	return i.hostAPI.GetService(name)
+}
+
+func (i *instrumentedAPI) StartService(name string) (err error) {
+	defer metrics.ObserveHostAPICall("system", "StartService", time.Now(), &err)
+	return i.hostAPI.StartService(name)
+}
+
+func (i *instrumentedAPI) StopService(name string, force bool) (err error) {
+	defer metrics.ObserveHostAPICall("system", "StopService", time.Now(), &err)
+	return i.hostAPI.StopService(name, force)
+}
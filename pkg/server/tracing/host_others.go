@@ -0,0 +1,11 @@
+//go:build !windows
+// +build !windows
+
+package tracing
+
+import "context"
+
+// setHostContext does nothing, there are no WMI calls to trace outside of Windows.
+func setHostContext(_ context.Context) func() {
+	return func() {}
+}
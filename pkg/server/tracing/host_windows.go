@@ -0,0 +1,16 @@
+//go:build windows
+// +build windows
+
+package tracing
+
+import (
+	"context"
+
+	"github.com/kubernetes-csi/csi-proxy/pkg/wmi"
+)
+
+// setHostContext makes ctx the parent of the spans of the WMI calls made by the calling goroutine,
+// until the returned function is called.
+func setHostContext(ctx context.Context) func() {
+	return wmi.SetTraceContext(ctx)
+}
@@ -0,0 +1,78 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.41.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+)
+
+const (
+	// ServiceName is the name csi-proxy reports its spans under.
+	ServiceName = "csi-proxy"
+
+	tracerName = "github.com/kubernetes-csi/csi-proxy/pkg/server"
+)
+
+// Setup configures the global tracer provider to export the spans to the OTLP gRPC collector at endpoint,
+// e.g. "http://127.0.0.1:4317" (an http URL disables TLS), sampling samplingRatio of the traces
+// which don't have a sampled parent. The returned function flushes the pending spans and stops exporting.
+func Setup(ctx context.Context, endpoint string, samplingRatio float64) (func(context.Context) error, error) {
+	if samplingRatio < 0 || samplingRatio > 1 {
+		return nil, fmt.Errorf("invalid tracing sampling ratio %v, expected a value between 0 and 1", samplingRatio)
+	}
+
+	exporter, err := otlptracegrpc.New(ctx, otlptracegrpc.WithEndpointURL(endpoint))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the OTLP trace exporter for %s: %w", endpoint, err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(samplingRatio))),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(ServiceName))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	return provider.Shutdown, nil
+}
+
+// ServerOptions returns the ServerOption starting a span for every gRPC call,
+// continuing the trace propagated in the client's metadata if any.
+func ServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+	}
+}
+
+// StartHostAPISpan starts a child span of the one in ctx for the API group's operation,
+// and returns the function ending it. The spans of the WMI calls made by the calling
+// goroutine in the meantime are children of that span, so it's meant to be deferred
+// at the beginning of the operation:
+//
+//	defer tracing.StartHostAPISpan(ctx, "disk", "PartitionDisk")()
+func StartHostAPISpan(ctx context.Context, api, operation string) func() {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return func() {}
+	}
+
+	ctx, span := otel.Tracer(tracerName).Start(ctx, api+"."+operation, trace.WithAttributes(
+		attribute.String("csi_proxy.api", api),
+		attribute.String("csi_proxy.operation", operation),
+	))
+	restore := setHostContext(ctx)
+	return func() {
+		restore()
+		span.End()
+	}
+}
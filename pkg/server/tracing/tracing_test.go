@@ -0,0 +1,88 @@
+package tracing
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	collectortrace "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/grpc"
+)
+
+// fakeCollector is a stand-in OTLP collector keeping the spans it receives.
+type fakeCollector struct {
+	collectortrace.UnimplementedTraceServiceServer
+
+	mutex sync.Mutex
+	spans []*tracepb.Span
+}
+
+func (c *fakeCollector) Export(_ context.Context, request *collectortrace.ExportTraceServiceRequest) (*collectortrace.ExportTraceServiceResponse, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for _, resourceSpans := range request.ResourceSpans {
+		for _, scopeSpans := range resourceSpans.ScopeSpans {
+			c.spans = append(c.spans, scopeSpans.Spans...)
+		}
+	}
+	return &collectortrace.ExportTraceServiceResponse{}, nil
+}
+
+func (c *fakeCollector) spansByName() map[string]*tracepb.Span {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	spans := make(map[string]*tracepb.Span)
+	for _, span := range c.spans {
+		spans[span.Name] = span
+	}
+	return spans
+}
+
+func startFakeCollector(t *testing.T) (*fakeCollector, string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+
+	collector := &fakeCollector{}
+	grpcServer := grpc.NewServer()
+	collectortrace.RegisterTraceServiceServer(grpcServer, collector)
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+	t.Cleanup(grpcServer.Stop)
+
+	return collector, "http://" + listener.Addr().String()
+}
+
+func TestSetup(t *testing.T) {
+	collector, endpoint := startFakeCollector(t)
+	previous := otel.GetTracerProvider()
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	shutdown, err := Setup(context.Background(), endpoint, 1)
+	require.Nil(t, err)
+
+	ctx, span := otel.Tracer("test").Start(context.Background(), "v1.Disk/PartitionDisk")
+	func() {
+		defer StartHostAPISpan(ctx, "disk", "PartitionDisk")()
+	}()
+	span.End()
+
+	// shutting down exports the pending spans
+	require.Nil(t, shutdown(context.Background()))
+
+	spans := collector.spansByName()
+	require.Contains(t, spans, "v1.Disk/PartitionDisk")
+	require.Contains(t, spans, "disk.PartitionDisk")
+	assert.Equal(t, spans["v1.Disk/PartitionDisk"].TraceId, spans["disk.PartitionDisk"].TraceId)
+	assert.Equal(t, spans["v1.Disk/PartitionDisk"].SpanId, spans["disk.PartitionDisk"].ParentSpanId)
+}
+
+func TestSetupInvalidSamplingRatio(t *testing.T) {
+	_, err := Setup(context.Background(), "http://127.0.0.1:4317", 2)
+	assert.NotNil(t, err)
+}
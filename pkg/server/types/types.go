@@ -17,3 +17,23 @@ type VersionedAPI struct {
 type APIGroup interface {
 	VersionedAPIs() []*VersionedAPI
 }
+
+// HealthReporter is optionally implemented by API groups to report whether
+// a given version is able to serve requests, e.g. because a host dependency
+// is unavailable. API groups that don't implement it, e.g. filesystem, are always serving.
+type HealthReporter interface {
+	// CheckHealth returns an error if the given version can't serve requests.
+	CheckHealth(version apiversion.Version) error
+}
+
+// ShutdownListener is optionally implemented by API groups serving streams that only end when
+// their client cancels them, e.g. watches. Shutdown is called when the server starts stopping
+// gracefully, so that they end them rather than delay the stop until the shutdown timeout.
+type ShutdownListener interface {
+	Shutdown()
+}
+
+// ResolveMethodFunc returns the API group, version and method name of a GRPC full method name,
+// e.g. "disk", "v1" and "SetDiskState" for "/v1.Disk/SetDiskState". The group and version
+// are empty for services that aren't part of an API group, e.g. grpc.health.v1.Health.
+type ResolveMethodFunc func(fullMethod string) (group, version, method string)
@@ -12,6 +12,7 @@ import (
 	"github.com/kubernetes-csi/csi-proxy/pkg/server/volume/impl/v1beta2"
 	"github.com/kubernetes-csi/csi-proxy/pkg/server/volume/impl/v1beta3"
 	"github.com/kubernetes-csi/csi-proxy/pkg/server/volume/impl/v2alpha1"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/volume/impl/v2alpha2"
 )
 
 const name = "volume"
@@ -26,6 +27,7 @@ func (s *Server) VersionedAPIs() []*srvtypes.VersionedAPI {
 	v1beta3Server := v1beta3.NewVersionedServer(s)
 	v1Server := v1.NewVersionedServer(s)
 	v2alpha1Server := v2alpha1.NewVersionedServer(s)
+	v2alpha2Server := v2alpha2.NewVersionedServer(s)
 
 	return []*srvtypes.VersionedAPI{
 		{
@@ -58,5 +60,10 @@ func (s *Server) VersionedAPIs() []*srvtypes.VersionedAPI {
 			Version:    apiversion.NewVersionOrPanic("v2alpha1"),
 			Registrant: v2alpha1Server.Register,
 		},
+		{
+			Group:      name,
+			Version:    apiversion.NewVersionOrPanic("v2alpha2"),
+			Registrant: v2alpha2Server.Register,
+		},
 	}
 }
@@ -28,10 +28,22 @@ type IsVolumeFormattedRequest struct {
 
 type IsVolumeFormattedResponse struct {
 	Formatted bool
+	// FileSystem of the volume, empty if it isn't formatted
+	FileSystem string
 }
 
 type FormatVolumeRequest struct {
 	VolumeId string
+	// Force formats the volume even if it already has a file system
+	Force bool
+	// FileSystem is NTFS, ReFS, exFAT or FAT32, NTFS if empty
+	FileSystem          string
+	Label               string
+	AllocationUnitSize  uint32
+	Full                bool
+	Compress            bool
+	UseLargeFrs         bool
+	SetIntegrityStreams bool
 }
 
 type FormatVolumeResponse struct {
@@ -55,9 +67,17 @@ type UnmountVolumeResponse struct {
 type ResizeVolumeRequest struct {
 	VolumeId  string
 	SizeBytes int64
+	// AllowShrink shrinks the volume if SizeBytes is smaller than its partition
+	AllowShrink bool
 }
 
 type ResizeVolumeResponse struct {
+	PartitionSizeBefore  int64
+	PartitionSizeAfter   int64
+	FileSystemSizeBefore int64
+	FileSystemSizeAfter  int64
+	Skipped              bool
+	SkipReason           string
 }
 
 type GetVolumeStatsRequest struct {
@@ -65,8 +85,71 @@ type GetVolumeStatsRequest struct {
 }
 
 type GetVolumeStatsResponse struct {
-	TotalBytes int64
-	UsedBytes  int64
+	TotalBytes        int64
+	UsedBytes         int64
+	HealthStatus      HealthStatus
+	OperationalStatus []OperationalStatus
+}
+
+type HealthStatus uint32
+
+const (
+	HealthStatusUnknown HealthStatus = iota
+	HealthStatusHealthy
+	HealthStatusWarning
+	HealthStatusUnhealthy
+)
+
+// OperationalStatus values match the MSFT_Volume OperationalStatus values
+type OperationalStatus uint32
+
+const (
+	OperationalStatusOK               OperationalStatus = 2
+	OperationalStatusScanNeeded       OperationalStatus = 0xD00D
+	OperationalStatusSpotFixNeeded    OperationalStatus = 0xD00E
+	OperationalStatusFullRepairNeeded OperationalStatus = 0xD00F
+)
+
+// RepairResult values match the MSFT_Volume Repair output values
+type RepairResult uint32
+
+const (
+	RepairResultNoErrorsFound RepairResult = iota
+	RepairResultErrorsFixed
+	RepairResultSpotFixNeeded
+	RepairResultFullRepairNeeded
+)
+
+type ScanVolumeRequest struct {
+	VolumeId string
+}
+
+type ScanVolumeResponse struct {
+	Result            RepairResult
+	HealthStatus      HealthStatus
+	OperationalStatus []OperationalStatus
+	// Dirty is true if the dirty bit of the volume is set
+	Dirty bool
+}
+
+type RepairMode uint32
+
+const (
+	RepairModeSpotFix RepairMode = iota
+	RepairModeOfflineScanAndFix
+)
+
+type RepairVolumeRequest struct {
+	VolumeId string
+	Mode     RepairMode
+}
+
+type RepairVolumeResponse struct {
+	Result            RepairResult
+	HealthStatus      HealthStatus
+	OperationalStatus []OperationalStatus
+	// Dirty is true if the dirty bit of the volume is still set
+	Dirty bool
 }
 
 type GetDiskNumberFromVolumeIDRequest struct {
@@ -26,7 +26,9 @@ type ServerInterface interface {
 	IsVolumeFormatted(context.Context, *IsVolumeFormattedRequest, apiversion.Version) (*IsVolumeFormattedResponse, error)
 	ListVolumesOnDisk(context.Context, *ListVolumesOnDiskRequest, apiversion.Version) (*ListVolumesOnDiskResponse, error)
 	MountVolume(context.Context, *MountVolumeRequest, apiversion.Version) (*MountVolumeResponse, error)
+	RepairVolume(context.Context, *RepairVolumeRequest, apiversion.Version) (*RepairVolumeResponse, error)
 	ResizeVolume(context.Context, *ResizeVolumeRequest, apiversion.Version) (*ResizeVolumeResponse, error)
+	ScanVolume(context.Context, *ScanVolumeRequest, apiversion.Version) (*ScanVolumeResponse, error)
 	UnmountVolume(context.Context, *UnmountVolumeRequest, apiversion.Version) (*UnmountVolumeResponse, error)
 	VolumeStats(context.Context, *VolumeStatsRequest, apiversion.Version) (*VolumeStatsResponse, error)
 	WriteVolumeCache(context.Context, *WriteVolumeCacheRequest, apiversion.Version) (*WriteVolumeCacheResponse, error)
@@ -0,0 +1,3 @@
+package v2alpha2
+
+// Add manual conversion functions here to override automatic conversion functions
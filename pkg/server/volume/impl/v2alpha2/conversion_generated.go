@@ -0,0 +1,628 @@
+// Code generated by csi-proxy-api-gen. DO NOT EDIT.
+
+package v2alpha2
+
+import (
+	unsafe "unsafe"
+
+	v2alpha2 "github.com/kubernetes-csi/csi-proxy/client/api/volume/v2alpha2"
+	impl "github.com/kubernetes-csi/csi-proxy/pkg/server/volume/impl"
+)
+
+func autoConvert_v2alpha2_FormatVolumeRequest_To_impl_FormatVolumeRequest(in *v2alpha2.FormatVolumeRequest, out *impl.FormatVolumeRequest) error {
+	out.VolumeId = in.VolumeId
+	out.Force = in.Force
+	out.FileSystem = in.FileSystem
+	out.Label = in.Label
+	out.AllocationUnitSize = in.AllocationUnitSize
+	out.Full = in.Full
+	out.Compress = in.Compress
+	out.UseLargeFrs = in.UseLargeFrs
+	out.SetIntegrityStreams = in.SetIntegrityStreams
+	return nil
+}
+
+// Convert_v2alpha2_FormatVolumeRequest_To_impl_FormatVolumeRequest is an autogenerated conversion function.
+func Convert_v2alpha2_FormatVolumeRequest_To_impl_FormatVolumeRequest(in *v2alpha2.FormatVolumeRequest, out *impl.FormatVolumeRequest) error {
+	return autoConvert_v2alpha2_FormatVolumeRequest_To_impl_FormatVolumeRequest(in, out)
+}
+
+func autoConvert_impl_FormatVolumeRequest_To_v2alpha2_FormatVolumeRequest(in *impl.FormatVolumeRequest, out *v2alpha2.FormatVolumeRequest) error {
+	out.VolumeId = in.VolumeId
+	out.Force = in.Force
+	out.FileSystem = in.FileSystem
+	out.Label = in.Label
+	out.AllocationUnitSize = in.AllocationUnitSize
+	out.Full = in.Full
+	out.Compress = in.Compress
+	out.UseLargeFrs = in.UseLargeFrs
+	out.SetIntegrityStreams = in.SetIntegrityStreams
+	return nil
+}
+
+// Convert_impl_FormatVolumeRequest_To_v2alpha2_FormatVolumeRequest is an autogenerated conversion function.
+func Convert_impl_FormatVolumeRequest_To_v2alpha2_FormatVolumeRequest(in *impl.FormatVolumeRequest, out *v2alpha2.FormatVolumeRequest) error {
+	return autoConvert_impl_FormatVolumeRequest_To_v2alpha2_FormatVolumeRequest(in, out)
+}
+
+func autoConvert_v2alpha2_FormatVolumeResponse_To_impl_FormatVolumeResponse(in *v2alpha2.FormatVolumeResponse, out *impl.FormatVolumeResponse) error {
+	return nil
+}
+
+// Convert_v2alpha2_FormatVolumeResponse_To_impl_FormatVolumeResponse is an autogenerated conversion function.
+func Convert_v2alpha2_FormatVolumeResponse_To_impl_FormatVolumeResponse(in *v2alpha2.FormatVolumeResponse, out *impl.FormatVolumeResponse) error {
+	return autoConvert_v2alpha2_FormatVolumeResponse_To_impl_FormatVolumeResponse(in, out)
+}
+
+func autoConvert_impl_FormatVolumeResponse_To_v2alpha2_FormatVolumeResponse(in *impl.FormatVolumeResponse, out *v2alpha2.FormatVolumeResponse) error {
+	return nil
+}
+
+// Convert_impl_FormatVolumeResponse_To_v2alpha2_FormatVolumeResponse is an autogenerated conversion function.
+func Convert_impl_FormatVolumeResponse_To_v2alpha2_FormatVolumeResponse(in *impl.FormatVolumeResponse, out *v2alpha2.FormatVolumeResponse) error {
+	return autoConvert_impl_FormatVolumeResponse_To_v2alpha2_FormatVolumeResponse(in, out)
+}
+
+func autoConvert_v2alpha2_GetClosestVolumeIDFromTargetPathRequest_To_impl_GetClosestVolumeIDFromTargetPathRequest(in *v2alpha2.GetClosestVolumeIDFromTargetPathRequest, out *impl.GetClosestVolumeIDFromTargetPathRequest) error {
+	out.TargetPath = in.TargetPath
+	return nil
+}
+
+// Convert_v2alpha2_GetClosestVolumeIDFromTargetPathRequest_To_impl_GetClosestVolumeIDFromTargetPathRequest is an autogenerated conversion function.
+func Convert_v2alpha2_GetClosestVolumeIDFromTargetPathRequest_To_impl_GetClosestVolumeIDFromTargetPathRequest(in *v2alpha2.GetClosestVolumeIDFromTargetPathRequest, out *impl.GetClosestVolumeIDFromTargetPathRequest) error {
+	return autoConvert_v2alpha2_GetClosestVolumeIDFromTargetPathRequest_To_impl_GetClosestVolumeIDFromTargetPathRequest(in, out)
+}
+
+func autoConvert_impl_GetClosestVolumeIDFromTargetPathRequest_To_v2alpha2_GetClosestVolumeIDFromTargetPathRequest(in *impl.GetClosestVolumeIDFromTargetPathRequest, out *v2alpha2.GetClosestVolumeIDFromTargetPathRequest) error {
+	out.TargetPath = in.TargetPath
+	return nil
+}
+
+// Convert_impl_GetClosestVolumeIDFromTargetPathRequest_To_v2alpha2_GetClosestVolumeIDFromTargetPathRequest is an autogenerated conversion function.
+func Convert_impl_GetClosestVolumeIDFromTargetPathRequest_To_v2alpha2_GetClosestVolumeIDFromTargetPathRequest(in *impl.GetClosestVolumeIDFromTargetPathRequest, out *v2alpha2.GetClosestVolumeIDFromTargetPathRequest) error {
+	return autoConvert_impl_GetClosestVolumeIDFromTargetPathRequest_To_v2alpha2_GetClosestVolumeIDFromTargetPathRequest(in, out)
+}
+
+func autoConvert_v2alpha2_GetClosestVolumeIDFromTargetPathResponse_To_impl_GetClosestVolumeIDFromTargetPathResponse(in *v2alpha2.GetClosestVolumeIDFromTargetPathResponse, out *impl.GetClosestVolumeIDFromTargetPathResponse) error {
+	out.VolumeId = in.VolumeId
+	return nil
+}
+
+// Convert_v2alpha2_GetClosestVolumeIDFromTargetPathResponse_To_impl_GetClosestVolumeIDFromTargetPathResponse is an autogenerated conversion function.
+func Convert_v2alpha2_GetClosestVolumeIDFromTargetPathResponse_To_impl_GetClosestVolumeIDFromTargetPathResponse(in *v2alpha2.GetClosestVolumeIDFromTargetPathResponse, out *impl.GetClosestVolumeIDFromTargetPathResponse) error {
+	return autoConvert_v2alpha2_GetClosestVolumeIDFromTargetPathResponse_To_impl_GetClosestVolumeIDFromTargetPathResponse(in, out)
+}
+
+func autoConvert_impl_GetClosestVolumeIDFromTargetPathResponse_To_v2alpha2_GetClosestVolumeIDFromTargetPathResponse(in *impl.GetClosestVolumeIDFromTargetPathResponse, out *v2alpha2.GetClosestVolumeIDFromTargetPathResponse) error {
+	out.VolumeId = in.VolumeId
+	return nil
+}
+
+// Convert_impl_GetClosestVolumeIDFromTargetPathResponse_To_v2alpha2_GetClosestVolumeIDFromTargetPathResponse is an autogenerated conversion function.
+func Convert_impl_GetClosestVolumeIDFromTargetPathResponse_To_v2alpha2_GetClosestVolumeIDFromTargetPathResponse(in *impl.GetClosestVolumeIDFromTargetPathResponse, out *v2alpha2.GetClosestVolumeIDFromTargetPathResponse) error {
+	return autoConvert_impl_GetClosestVolumeIDFromTargetPathResponse_To_v2alpha2_GetClosestVolumeIDFromTargetPathResponse(in, out)
+}
+
+func autoConvert_v2alpha2_GetDiskNumberFromVolumeIDRequest_To_impl_GetDiskNumberFromVolumeIDRequest(in *v2alpha2.GetDiskNumberFromVolumeIDRequest, out *impl.GetDiskNumberFromVolumeIDRequest) error {
+	out.VolumeId = in.VolumeId
+	return nil
+}
+
+// Convert_v2alpha2_GetDiskNumberFromVolumeIDRequest_To_impl_GetDiskNumberFromVolumeIDRequest is an autogenerated conversion function.
+func Convert_v2alpha2_GetDiskNumberFromVolumeIDRequest_To_impl_GetDiskNumberFromVolumeIDRequest(in *v2alpha2.GetDiskNumberFromVolumeIDRequest, out *impl.GetDiskNumberFromVolumeIDRequest) error {
+	return autoConvert_v2alpha2_GetDiskNumberFromVolumeIDRequest_To_impl_GetDiskNumberFromVolumeIDRequest(in, out)
+}
+
+func autoConvert_impl_GetDiskNumberFromVolumeIDRequest_To_v2alpha2_GetDiskNumberFromVolumeIDRequest(in *impl.GetDiskNumberFromVolumeIDRequest, out *v2alpha2.GetDiskNumberFromVolumeIDRequest) error {
+	out.VolumeId = in.VolumeId
+	return nil
+}
+
+// Convert_impl_GetDiskNumberFromVolumeIDRequest_To_v2alpha2_GetDiskNumberFromVolumeIDRequest is an autogenerated conversion function.
+func Convert_impl_GetDiskNumberFromVolumeIDRequest_To_v2alpha2_GetDiskNumberFromVolumeIDRequest(in *impl.GetDiskNumberFromVolumeIDRequest, out *v2alpha2.GetDiskNumberFromVolumeIDRequest) error {
+	return autoConvert_impl_GetDiskNumberFromVolumeIDRequest_To_v2alpha2_GetDiskNumberFromVolumeIDRequest(in, out)
+}
+
+func autoConvert_v2alpha2_GetDiskNumberFromVolumeIDResponse_To_impl_GetDiskNumberFromVolumeIDResponse(in *v2alpha2.GetDiskNumberFromVolumeIDResponse, out *impl.GetDiskNumberFromVolumeIDResponse) error {
+	out.DiskNumber = in.DiskNumber
+	return nil
+}
+
+// Convert_v2alpha2_GetDiskNumberFromVolumeIDResponse_To_impl_GetDiskNumberFromVolumeIDResponse is an autogenerated conversion function.
+func Convert_v2alpha2_GetDiskNumberFromVolumeIDResponse_To_impl_GetDiskNumberFromVolumeIDResponse(in *v2alpha2.GetDiskNumberFromVolumeIDResponse, out *impl.GetDiskNumberFromVolumeIDResponse) error {
+	return autoConvert_v2alpha2_GetDiskNumberFromVolumeIDResponse_To_impl_GetDiskNumberFromVolumeIDResponse(in, out)
+}
+
+func autoConvert_impl_GetDiskNumberFromVolumeIDResponse_To_v2alpha2_GetDiskNumberFromVolumeIDResponse(in *impl.GetDiskNumberFromVolumeIDResponse, out *v2alpha2.GetDiskNumberFromVolumeIDResponse) error {
+	out.DiskNumber = in.DiskNumber
+	return nil
+}
+
+// Convert_impl_GetDiskNumberFromVolumeIDResponse_To_v2alpha2_GetDiskNumberFromVolumeIDResponse is an autogenerated conversion function.
+func Convert_impl_GetDiskNumberFromVolumeIDResponse_To_v2alpha2_GetDiskNumberFromVolumeIDResponse(in *impl.GetDiskNumberFromVolumeIDResponse, out *v2alpha2.GetDiskNumberFromVolumeIDResponse) error {
+	return autoConvert_impl_GetDiskNumberFromVolumeIDResponse_To_v2alpha2_GetDiskNumberFromVolumeIDResponse(in, out)
+}
+
+func autoConvert_v2alpha2_GetVolumeIDFromTargetPathRequest_To_impl_GetVolumeIDFromTargetPathRequest(in *v2alpha2.GetVolumeIDFromTargetPathRequest, out *impl.GetVolumeIDFromTargetPathRequest) error {
+	out.TargetPath = in.TargetPath
+	return nil
+}
+
+// Convert_v2alpha2_GetVolumeIDFromTargetPathRequest_To_impl_GetVolumeIDFromTargetPathRequest is an autogenerated conversion function.
+func Convert_v2alpha2_GetVolumeIDFromTargetPathRequest_To_impl_GetVolumeIDFromTargetPathRequest(in *v2alpha2.GetVolumeIDFromTargetPathRequest, out *impl.GetVolumeIDFromTargetPathRequest) error {
+	return autoConvert_v2alpha2_GetVolumeIDFromTargetPathRequest_To_impl_GetVolumeIDFromTargetPathRequest(in, out)
+}
+
+func autoConvert_impl_GetVolumeIDFromTargetPathRequest_To_v2alpha2_GetVolumeIDFromTargetPathRequest(in *impl.GetVolumeIDFromTargetPathRequest, out *v2alpha2.GetVolumeIDFromTargetPathRequest) error {
+	out.TargetPath = in.TargetPath
+	return nil
+}
+
+// Convert_impl_GetVolumeIDFromTargetPathRequest_To_v2alpha2_GetVolumeIDFromTargetPathRequest is an autogenerated conversion function.
+func Convert_impl_GetVolumeIDFromTargetPathRequest_To_v2alpha2_GetVolumeIDFromTargetPathRequest(in *impl.GetVolumeIDFromTargetPathRequest, out *v2alpha2.GetVolumeIDFromTargetPathRequest) error {
+	return autoConvert_impl_GetVolumeIDFromTargetPathRequest_To_v2alpha2_GetVolumeIDFromTargetPathRequest(in, out)
+}
+
+func autoConvert_v2alpha2_GetVolumeIDFromTargetPathResponse_To_impl_GetVolumeIDFromTargetPathResponse(in *v2alpha2.GetVolumeIDFromTargetPathResponse, out *impl.GetVolumeIDFromTargetPathResponse) error {
+	out.VolumeId = in.VolumeId
+	return nil
+}
+
+// Convert_v2alpha2_GetVolumeIDFromTargetPathResponse_To_impl_GetVolumeIDFromTargetPathResponse is an autogenerated conversion function.
+func Convert_v2alpha2_GetVolumeIDFromTargetPathResponse_To_impl_GetVolumeIDFromTargetPathResponse(in *v2alpha2.GetVolumeIDFromTargetPathResponse, out *impl.GetVolumeIDFromTargetPathResponse) error {
+	return autoConvert_v2alpha2_GetVolumeIDFromTargetPathResponse_To_impl_GetVolumeIDFromTargetPathResponse(in, out)
+}
+
+func autoConvert_impl_GetVolumeIDFromTargetPathResponse_To_v2alpha2_GetVolumeIDFromTargetPathResponse(in *impl.GetVolumeIDFromTargetPathResponse, out *v2alpha2.GetVolumeIDFromTargetPathResponse) error {
+	out.VolumeId = in.VolumeId
+	return nil
+}
+
+// Convert_impl_GetVolumeIDFromTargetPathResponse_To_v2alpha2_GetVolumeIDFromTargetPathResponse is an autogenerated conversion function.
+func Convert_impl_GetVolumeIDFromTargetPathResponse_To_v2alpha2_GetVolumeIDFromTargetPathResponse(in *impl.GetVolumeIDFromTargetPathResponse, out *v2alpha2.GetVolumeIDFromTargetPathResponse) error {
+	return autoConvert_impl_GetVolumeIDFromTargetPathResponse_To_v2alpha2_GetVolumeIDFromTargetPathResponse(in, out)
+}
+
+func autoConvert_v2alpha2_GetVolumeStatsRequest_To_impl_GetVolumeStatsRequest(in *v2alpha2.GetVolumeStatsRequest, out *impl.GetVolumeStatsRequest) error {
+	out.VolumeId = in.VolumeId
+	return nil
+}
+
+// Convert_v2alpha2_GetVolumeStatsRequest_To_impl_GetVolumeStatsRequest is an autogenerated conversion function.
+func Convert_v2alpha2_GetVolumeStatsRequest_To_impl_GetVolumeStatsRequest(in *v2alpha2.GetVolumeStatsRequest, out *impl.GetVolumeStatsRequest) error {
+	return autoConvert_v2alpha2_GetVolumeStatsRequest_To_impl_GetVolumeStatsRequest(in, out)
+}
+
+func autoConvert_impl_GetVolumeStatsRequest_To_v2alpha2_GetVolumeStatsRequest(in *impl.GetVolumeStatsRequest, out *v2alpha2.GetVolumeStatsRequest) error {
+	out.VolumeId = in.VolumeId
+	return nil
+}
+
+// Convert_impl_GetVolumeStatsRequest_To_v2alpha2_GetVolumeStatsRequest is an autogenerated conversion function.
+func Convert_impl_GetVolumeStatsRequest_To_v2alpha2_GetVolumeStatsRequest(in *impl.GetVolumeStatsRequest, out *v2alpha2.GetVolumeStatsRequest) error {
+	return autoConvert_impl_GetVolumeStatsRequest_To_v2alpha2_GetVolumeStatsRequest(in, out)
+}
+
+func autoConvert_v2alpha2_GetVolumeStatsResponse_To_impl_GetVolumeStatsResponse(in *v2alpha2.GetVolumeStatsResponse, out *impl.GetVolumeStatsResponse) error {
+	out.TotalBytes = in.TotalBytes
+	out.UsedBytes = in.UsedBytes
+	out.HealthStatus = impl.HealthStatus(in.HealthStatus)
+	if in.OperationalStatus != nil {
+		in, out := &in.OperationalStatus, &out.OperationalStatus
+		*out = make([]impl.OperationalStatus, len(*in))
+		for i := range *in {
+			(*out)[i] = impl.OperationalStatus((*in)[i])
+		}
+	} else {
+		out.OperationalStatus = nil
+	}
+	return nil
+}
+
+// Convert_v2alpha2_GetVolumeStatsResponse_To_impl_GetVolumeStatsResponse is an autogenerated conversion function.
+func Convert_v2alpha2_GetVolumeStatsResponse_To_impl_GetVolumeStatsResponse(in *v2alpha2.GetVolumeStatsResponse, out *impl.GetVolumeStatsResponse) error {
+	return autoConvert_v2alpha2_GetVolumeStatsResponse_To_impl_GetVolumeStatsResponse(in, out)
+}
+
+func autoConvert_impl_GetVolumeStatsResponse_To_v2alpha2_GetVolumeStatsResponse(in *impl.GetVolumeStatsResponse, out *v2alpha2.GetVolumeStatsResponse) error {
+	out.TotalBytes = in.TotalBytes
+	out.UsedBytes = in.UsedBytes
+	out.HealthStatus = v2alpha2.HealthStatus(in.HealthStatus)
+	if in.OperationalStatus != nil {
+		in, out := &in.OperationalStatus, &out.OperationalStatus
+		*out = make([]v2alpha2.OperationalStatus, len(*in))
+		for i := range *in {
+			(*out)[i] = v2alpha2.OperationalStatus((*in)[i])
+		}
+	} else {
+		out.OperationalStatus = nil
+	}
+	return nil
+}
+
+// Convert_impl_GetVolumeStatsResponse_To_v2alpha2_GetVolumeStatsResponse is an autogenerated conversion function.
+func Convert_impl_GetVolumeStatsResponse_To_v2alpha2_GetVolumeStatsResponse(in *impl.GetVolumeStatsResponse, out *v2alpha2.GetVolumeStatsResponse) error {
+	return autoConvert_impl_GetVolumeStatsResponse_To_v2alpha2_GetVolumeStatsResponse(in, out)
+}
+
+func autoConvert_v2alpha2_IsVolumeFormattedRequest_To_impl_IsVolumeFormattedRequest(in *v2alpha2.IsVolumeFormattedRequest, out *impl.IsVolumeFormattedRequest) error {
+	out.VolumeId = in.VolumeId
+	return nil
+}
+
+// Convert_v2alpha2_IsVolumeFormattedRequest_To_impl_IsVolumeFormattedRequest is an autogenerated conversion function.
+func Convert_v2alpha2_IsVolumeFormattedRequest_To_impl_IsVolumeFormattedRequest(in *v2alpha2.IsVolumeFormattedRequest, out *impl.IsVolumeFormattedRequest) error {
+	return autoConvert_v2alpha2_IsVolumeFormattedRequest_To_impl_IsVolumeFormattedRequest(in, out)
+}
+
+func autoConvert_impl_IsVolumeFormattedRequest_To_v2alpha2_IsVolumeFormattedRequest(in *impl.IsVolumeFormattedRequest, out *v2alpha2.IsVolumeFormattedRequest) error {
+	out.VolumeId = in.VolumeId
+	return nil
+}
+
+// Convert_impl_IsVolumeFormattedRequest_To_v2alpha2_IsVolumeFormattedRequest is an autogenerated conversion function.
+func Convert_impl_IsVolumeFormattedRequest_To_v2alpha2_IsVolumeFormattedRequest(in *impl.IsVolumeFormattedRequest, out *v2alpha2.IsVolumeFormattedRequest) error {
+	return autoConvert_impl_IsVolumeFormattedRequest_To_v2alpha2_IsVolumeFormattedRequest(in, out)
+}
+
+func autoConvert_v2alpha2_IsVolumeFormattedResponse_To_impl_IsVolumeFormattedResponse(in *v2alpha2.IsVolumeFormattedResponse, out *impl.IsVolumeFormattedResponse) error {
+	out.Formatted = in.Formatted
+	out.FileSystem = in.FileSystem
+	return nil
+}
+
+// Convert_v2alpha2_IsVolumeFormattedResponse_To_impl_IsVolumeFormattedResponse is an autogenerated conversion function.
+func Convert_v2alpha2_IsVolumeFormattedResponse_To_impl_IsVolumeFormattedResponse(in *v2alpha2.IsVolumeFormattedResponse, out *impl.IsVolumeFormattedResponse) error {
+	return autoConvert_v2alpha2_IsVolumeFormattedResponse_To_impl_IsVolumeFormattedResponse(in, out)
+}
+
+func autoConvert_impl_IsVolumeFormattedResponse_To_v2alpha2_IsVolumeFormattedResponse(in *impl.IsVolumeFormattedResponse, out *v2alpha2.IsVolumeFormattedResponse) error {
+	out.Formatted = in.Formatted
+	out.FileSystem = in.FileSystem
+	return nil
+}
+
+// Convert_impl_IsVolumeFormattedResponse_To_v2alpha2_IsVolumeFormattedResponse is an autogenerated conversion function.
+func Convert_impl_IsVolumeFormattedResponse_To_v2alpha2_IsVolumeFormattedResponse(in *impl.IsVolumeFormattedResponse, out *v2alpha2.IsVolumeFormattedResponse) error {
+	return autoConvert_impl_IsVolumeFormattedResponse_To_v2alpha2_IsVolumeFormattedResponse(in, out)
+}
+
+func autoConvert_v2alpha2_ListVolumesOnDiskRequest_To_impl_ListVolumesOnDiskRequest(in *v2alpha2.ListVolumesOnDiskRequest, out *impl.ListVolumesOnDiskRequest) error {
+	out.DiskNumber = in.DiskNumber
+	out.PartitionNumber = in.PartitionNumber
+	return nil
+}
+
+// Convert_v2alpha2_ListVolumesOnDiskRequest_To_impl_ListVolumesOnDiskRequest is an autogenerated conversion function.
+func Convert_v2alpha2_ListVolumesOnDiskRequest_To_impl_ListVolumesOnDiskRequest(in *v2alpha2.ListVolumesOnDiskRequest, out *impl.ListVolumesOnDiskRequest) error {
+	return autoConvert_v2alpha2_ListVolumesOnDiskRequest_To_impl_ListVolumesOnDiskRequest(in, out)
+}
+
+func autoConvert_impl_ListVolumesOnDiskRequest_To_v2alpha2_ListVolumesOnDiskRequest(in *impl.ListVolumesOnDiskRequest, out *v2alpha2.ListVolumesOnDiskRequest) error {
+	out.DiskNumber = in.DiskNumber
+	out.PartitionNumber = in.PartitionNumber
+	return nil
+}
+
+// Convert_impl_ListVolumesOnDiskRequest_To_v2alpha2_ListVolumesOnDiskRequest is an autogenerated conversion function.
+func Convert_impl_ListVolumesOnDiskRequest_To_v2alpha2_ListVolumesOnDiskRequest(in *impl.ListVolumesOnDiskRequest, out *v2alpha2.ListVolumesOnDiskRequest) error {
+	return autoConvert_impl_ListVolumesOnDiskRequest_To_v2alpha2_ListVolumesOnDiskRequest(in, out)
+}
+
+func autoConvert_v2alpha2_ListVolumesOnDiskResponse_To_impl_ListVolumesOnDiskResponse(in *v2alpha2.ListVolumesOnDiskResponse, out *impl.ListVolumesOnDiskResponse) error {
+	out.VolumeIds = *(*[]string)(unsafe.Pointer(&in.VolumeIds))
+	return nil
+}
+
+// Convert_v2alpha2_ListVolumesOnDiskResponse_To_impl_ListVolumesOnDiskResponse is an autogenerated conversion function.
+func Convert_v2alpha2_ListVolumesOnDiskResponse_To_impl_ListVolumesOnDiskResponse(in *v2alpha2.ListVolumesOnDiskResponse, out *impl.ListVolumesOnDiskResponse) error {
+	return autoConvert_v2alpha2_ListVolumesOnDiskResponse_To_impl_ListVolumesOnDiskResponse(in, out)
+}
+
+func autoConvert_impl_ListVolumesOnDiskResponse_To_v2alpha2_ListVolumesOnDiskResponse(in *impl.ListVolumesOnDiskResponse, out *v2alpha2.ListVolumesOnDiskResponse) error {
+	out.VolumeIds = *(*[]string)(unsafe.Pointer(&in.VolumeIds))
+	return nil
+}
+
+// Convert_impl_ListVolumesOnDiskResponse_To_v2alpha2_ListVolumesOnDiskResponse is an autogenerated conversion function.
+func Convert_impl_ListVolumesOnDiskResponse_To_v2alpha2_ListVolumesOnDiskResponse(in *impl.ListVolumesOnDiskResponse, out *v2alpha2.ListVolumesOnDiskResponse) error {
+	return autoConvert_impl_ListVolumesOnDiskResponse_To_v2alpha2_ListVolumesOnDiskResponse(in, out)
+}
+
+func autoConvert_v2alpha2_MountVolumeRequest_To_impl_MountVolumeRequest(in *v2alpha2.MountVolumeRequest, out *impl.MountVolumeRequest) error {
+	out.VolumeId = in.VolumeId
+	out.TargetPath = in.TargetPath
+	return nil
+}
+
+// Convert_v2alpha2_MountVolumeRequest_To_impl_MountVolumeRequest is an autogenerated conversion function.
+func Convert_v2alpha2_MountVolumeRequest_To_impl_MountVolumeRequest(in *v2alpha2.MountVolumeRequest, out *impl.MountVolumeRequest) error {
+	return autoConvert_v2alpha2_MountVolumeRequest_To_impl_MountVolumeRequest(in, out)
+}
+
+func autoConvert_impl_MountVolumeRequest_To_v2alpha2_MountVolumeRequest(in *impl.MountVolumeRequest, out *v2alpha2.MountVolumeRequest) error {
+	out.VolumeId = in.VolumeId
+	out.TargetPath = in.TargetPath
+	return nil
+}
+
+// Convert_impl_MountVolumeRequest_To_v2alpha2_MountVolumeRequest is an autogenerated conversion function.
+func Convert_impl_MountVolumeRequest_To_v2alpha2_MountVolumeRequest(in *impl.MountVolumeRequest, out *v2alpha2.MountVolumeRequest) error {
+	return autoConvert_impl_MountVolumeRequest_To_v2alpha2_MountVolumeRequest(in, out)
+}
+
+func autoConvert_v2alpha2_MountVolumeResponse_To_impl_MountVolumeResponse(in *v2alpha2.MountVolumeResponse, out *impl.MountVolumeResponse) error {
+	return nil
+}
+
+// Convert_v2alpha2_MountVolumeResponse_To_impl_MountVolumeResponse is an autogenerated conversion function.
+func Convert_v2alpha2_MountVolumeResponse_To_impl_MountVolumeResponse(in *v2alpha2.MountVolumeResponse, out *impl.MountVolumeResponse) error {
+	return autoConvert_v2alpha2_MountVolumeResponse_To_impl_MountVolumeResponse(in, out)
+}
+
+func autoConvert_impl_MountVolumeResponse_To_v2alpha2_MountVolumeResponse(in *impl.MountVolumeResponse, out *v2alpha2.MountVolumeResponse) error {
+	return nil
+}
+
+// Convert_impl_MountVolumeResponse_To_v2alpha2_MountVolumeResponse is an autogenerated conversion function.
+func Convert_impl_MountVolumeResponse_To_v2alpha2_MountVolumeResponse(in *impl.MountVolumeResponse, out *v2alpha2.MountVolumeResponse) error {
+	return autoConvert_impl_MountVolumeResponse_To_v2alpha2_MountVolumeResponse(in, out)
+}
+
+func autoConvert_v2alpha2_RepairVolumeRequest_To_impl_RepairVolumeRequest(in *v2alpha2.RepairVolumeRequest, out *impl.RepairVolumeRequest) error {
+	out.VolumeId = in.VolumeId
+	out.Mode = impl.RepairMode(in.Mode)
+	return nil
+}
+
+// Convert_v2alpha2_RepairVolumeRequest_To_impl_RepairVolumeRequest is an autogenerated conversion function.
+func Convert_v2alpha2_RepairVolumeRequest_To_impl_RepairVolumeRequest(in *v2alpha2.RepairVolumeRequest, out *impl.RepairVolumeRequest) error {
+	return autoConvert_v2alpha2_RepairVolumeRequest_To_impl_RepairVolumeRequest(in, out)
+}
+
+func autoConvert_impl_RepairVolumeRequest_To_v2alpha2_RepairVolumeRequest(in *impl.RepairVolumeRequest, out *v2alpha2.RepairVolumeRequest) error {
+	out.VolumeId = in.VolumeId
+	out.Mode = v2alpha2.RepairMode(in.Mode)
+	return nil
+}
+
+// Convert_impl_RepairVolumeRequest_To_v2alpha2_RepairVolumeRequest is an autogenerated conversion function.
+func Convert_impl_RepairVolumeRequest_To_v2alpha2_RepairVolumeRequest(in *impl.RepairVolumeRequest, out *v2alpha2.RepairVolumeRequest) error {
+	return autoConvert_impl_RepairVolumeRequest_To_v2alpha2_RepairVolumeRequest(in, out)
+}
+
+func autoConvert_v2alpha2_RepairVolumeResponse_To_impl_RepairVolumeResponse(in *v2alpha2.RepairVolumeResponse, out *impl.RepairVolumeResponse) error {
+	out.Result = impl.RepairResult(in.Result)
+	out.HealthStatus = impl.HealthStatus(in.HealthStatus)
+	if in.OperationalStatus != nil {
+		in, out := &in.OperationalStatus, &out.OperationalStatus
+		*out = make([]impl.OperationalStatus, len(*in))
+		for i := range *in {
+			(*out)[i] = impl.OperationalStatus((*in)[i])
+		}
+	} else {
+		out.OperationalStatus = nil
+	}
+	out.Dirty = in.Dirty
+	return nil
+}
+
+// Convert_v2alpha2_RepairVolumeResponse_To_impl_RepairVolumeResponse is an autogenerated conversion function.
+func Convert_v2alpha2_RepairVolumeResponse_To_impl_RepairVolumeResponse(in *v2alpha2.RepairVolumeResponse, out *impl.RepairVolumeResponse) error {
+	return autoConvert_v2alpha2_RepairVolumeResponse_To_impl_RepairVolumeResponse(in, out)
+}
+
+func autoConvert_impl_RepairVolumeResponse_To_v2alpha2_RepairVolumeResponse(in *impl.RepairVolumeResponse, out *v2alpha2.RepairVolumeResponse) error {
+	out.Result = v2alpha2.RepairResult(in.Result)
+	out.HealthStatus = v2alpha2.HealthStatus(in.HealthStatus)
+	if in.OperationalStatus != nil {
+		in, out := &in.OperationalStatus, &out.OperationalStatus
+		*out = make([]v2alpha2.OperationalStatus, len(*in))
+		for i := range *in {
+			(*out)[i] = v2alpha2.OperationalStatus((*in)[i])
+		}
+	} else {
+		out.OperationalStatus = nil
+	}
+	out.Dirty = in.Dirty
+	return nil
+}
+
+// Convert_impl_RepairVolumeResponse_To_v2alpha2_RepairVolumeResponse is an autogenerated conversion function.
+func Convert_impl_RepairVolumeResponse_To_v2alpha2_RepairVolumeResponse(in *impl.RepairVolumeResponse, out *v2alpha2.RepairVolumeResponse) error {
+	return autoConvert_impl_RepairVolumeResponse_To_v2alpha2_RepairVolumeResponse(in, out)
+}
+
+func autoConvert_v2alpha2_ResizeVolumeRequest_To_impl_ResizeVolumeRequest(in *v2alpha2.ResizeVolumeRequest, out *impl.ResizeVolumeRequest) error {
+	out.VolumeId = in.VolumeId
+	out.SizeBytes = in.SizeBytes
+	out.AllowShrink = in.AllowShrink
+	return nil
+}
+
+// Convert_v2alpha2_ResizeVolumeRequest_To_impl_ResizeVolumeRequest is an autogenerated conversion function.
+func Convert_v2alpha2_ResizeVolumeRequest_To_impl_ResizeVolumeRequest(in *v2alpha2.ResizeVolumeRequest, out *impl.ResizeVolumeRequest) error {
+	return autoConvert_v2alpha2_ResizeVolumeRequest_To_impl_ResizeVolumeRequest(in, out)
+}
+
+func autoConvert_impl_ResizeVolumeRequest_To_v2alpha2_ResizeVolumeRequest(in *impl.ResizeVolumeRequest, out *v2alpha2.ResizeVolumeRequest) error {
+	out.VolumeId = in.VolumeId
+	out.SizeBytes = in.SizeBytes
+	out.AllowShrink = in.AllowShrink
+	return nil
+}
+
+// Convert_impl_ResizeVolumeRequest_To_v2alpha2_ResizeVolumeRequest is an autogenerated conversion function.
+func Convert_impl_ResizeVolumeRequest_To_v2alpha2_ResizeVolumeRequest(in *impl.ResizeVolumeRequest, out *v2alpha2.ResizeVolumeRequest) error {
+	return autoConvert_impl_ResizeVolumeRequest_To_v2alpha2_ResizeVolumeRequest(in, out)
+}
+
+func autoConvert_v2alpha2_ResizeVolumeResponse_To_impl_ResizeVolumeResponse(in *v2alpha2.ResizeVolumeResponse, out *impl.ResizeVolumeResponse) error {
+	out.PartitionSizeBefore = in.PartitionSizeBefore
+	out.PartitionSizeAfter = in.PartitionSizeAfter
+	out.FileSystemSizeBefore = in.FileSystemSizeBefore
+	out.FileSystemSizeAfter = in.FileSystemSizeAfter
+	out.Skipped = in.Skipped
+	out.SkipReason = in.SkipReason
+	return nil
+}
+
+// Convert_v2alpha2_ResizeVolumeResponse_To_impl_ResizeVolumeResponse is an autogenerated conversion function.
+func Convert_v2alpha2_ResizeVolumeResponse_To_impl_ResizeVolumeResponse(in *v2alpha2.ResizeVolumeResponse, out *impl.ResizeVolumeResponse) error {
+	return autoConvert_v2alpha2_ResizeVolumeResponse_To_impl_ResizeVolumeResponse(in, out)
+}
+
+func autoConvert_impl_ResizeVolumeResponse_To_v2alpha2_ResizeVolumeResponse(in *impl.ResizeVolumeResponse, out *v2alpha2.ResizeVolumeResponse) error {
+	out.PartitionSizeBefore = in.PartitionSizeBefore
+	out.PartitionSizeAfter = in.PartitionSizeAfter
+	out.FileSystemSizeBefore = in.FileSystemSizeBefore
+	out.FileSystemSizeAfter = in.FileSystemSizeAfter
+	out.Skipped = in.Skipped
+	out.SkipReason = in.SkipReason
+	return nil
+}
+
+// Convert_impl_ResizeVolumeResponse_To_v2alpha2_ResizeVolumeResponse is an autogenerated conversion function.
+func Convert_impl_ResizeVolumeResponse_To_v2alpha2_ResizeVolumeResponse(in *impl.ResizeVolumeResponse, out *v2alpha2.ResizeVolumeResponse) error {
+	return autoConvert_impl_ResizeVolumeResponse_To_v2alpha2_ResizeVolumeResponse(in, out)
+}
+
+func autoConvert_v2alpha2_ScanVolumeRequest_To_impl_ScanVolumeRequest(in *v2alpha2.ScanVolumeRequest, out *impl.ScanVolumeRequest) error {
+	out.VolumeId = in.VolumeId
+	return nil
+}
+
+// Convert_v2alpha2_ScanVolumeRequest_To_impl_ScanVolumeRequest is an autogenerated conversion function.
+func Convert_v2alpha2_ScanVolumeRequest_To_impl_ScanVolumeRequest(in *v2alpha2.ScanVolumeRequest, out *impl.ScanVolumeRequest) error {
+	return autoConvert_v2alpha2_ScanVolumeRequest_To_impl_ScanVolumeRequest(in, out)
+}
+
+func autoConvert_impl_ScanVolumeRequest_To_v2alpha2_ScanVolumeRequest(in *impl.ScanVolumeRequest, out *v2alpha2.ScanVolumeRequest) error {
+	out.VolumeId = in.VolumeId
+	return nil
+}
+
+// Convert_impl_ScanVolumeRequest_To_v2alpha2_ScanVolumeRequest is an autogenerated conversion function.
+func Convert_impl_ScanVolumeRequest_To_v2alpha2_ScanVolumeRequest(in *impl.ScanVolumeRequest, out *v2alpha2.ScanVolumeRequest) error {
+	return autoConvert_impl_ScanVolumeRequest_To_v2alpha2_ScanVolumeRequest(in, out)
+}
+
+func autoConvert_v2alpha2_ScanVolumeResponse_To_impl_ScanVolumeResponse(in *v2alpha2.ScanVolumeResponse, out *impl.ScanVolumeResponse) error {
+	out.Result = impl.RepairResult(in.Result)
+	out.HealthStatus = impl.HealthStatus(in.HealthStatus)
+	if in.OperationalStatus != nil {
+		in, out := &in.OperationalStatus, &out.OperationalStatus
+		*out = make([]impl.OperationalStatus, len(*in))
+		for i := range *in {
+			(*out)[i] = impl.OperationalStatus((*in)[i])
+		}
+	} else {
+		out.OperationalStatus = nil
+	}
+	out.Dirty = in.Dirty
+	return nil
+}
+
+// Convert_v2alpha2_ScanVolumeResponse_To_impl_ScanVolumeResponse is an autogenerated conversion function.
+func Convert_v2alpha2_ScanVolumeResponse_To_impl_ScanVolumeResponse(in *v2alpha2.ScanVolumeResponse, out *impl.ScanVolumeResponse) error {
+	return autoConvert_v2alpha2_ScanVolumeResponse_To_impl_ScanVolumeResponse(in, out)
+}
+
+func autoConvert_impl_ScanVolumeResponse_To_v2alpha2_ScanVolumeResponse(in *impl.ScanVolumeResponse, out *v2alpha2.ScanVolumeResponse) error {
+	out.Result = v2alpha2.RepairResult(in.Result)
+	out.HealthStatus = v2alpha2.HealthStatus(in.HealthStatus)
+	if in.OperationalStatus != nil {
+		in, out := &in.OperationalStatus, &out.OperationalStatus
+		*out = make([]v2alpha2.OperationalStatus, len(*in))
+		for i := range *in {
+			(*out)[i] = v2alpha2.OperationalStatus((*in)[i])
+		}
+	} else {
+		out.OperationalStatus = nil
+	}
+	out.Dirty = in.Dirty
+	return nil
+}
+
+// Convert_impl_ScanVolumeResponse_To_v2alpha2_ScanVolumeResponse is an autogenerated conversion function.
+func Convert_impl_ScanVolumeResponse_To_v2alpha2_ScanVolumeResponse(in *impl.ScanVolumeResponse, out *v2alpha2.ScanVolumeResponse) error {
+	return autoConvert_impl_ScanVolumeResponse_To_v2alpha2_ScanVolumeResponse(in, out)
+}
+
+func autoConvert_v2alpha2_UnmountVolumeRequest_To_impl_UnmountVolumeRequest(in *v2alpha2.UnmountVolumeRequest, out *impl.UnmountVolumeRequest) error {
+	out.VolumeId = in.VolumeId
+	out.TargetPath = in.TargetPath
+	return nil
+}
+
+// Convert_v2alpha2_UnmountVolumeRequest_To_impl_UnmountVolumeRequest is an autogenerated conversion function.
+func Convert_v2alpha2_UnmountVolumeRequest_To_impl_UnmountVolumeRequest(in *v2alpha2.UnmountVolumeRequest, out *impl.UnmountVolumeRequest) error {
+	return autoConvert_v2alpha2_UnmountVolumeRequest_To_impl_UnmountVolumeRequest(in, out)
+}
+
+func autoConvert_impl_UnmountVolumeRequest_To_v2alpha2_UnmountVolumeRequest(in *impl.UnmountVolumeRequest, out *v2alpha2.UnmountVolumeRequest) error {
+	out.VolumeId = in.VolumeId
+	out.TargetPath = in.TargetPath
+	return nil
+}
+
+// Convert_impl_UnmountVolumeRequest_To_v2alpha2_UnmountVolumeRequest is an autogenerated conversion function.
+func Convert_impl_UnmountVolumeRequest_To_v2alpha2_UnmountVolumeRequest(in *impl.UnmountVolumeRequest, out *v2alpha2.UnmountVolumeRequest) error {
+	return autoConvert_impl_UnmountVolumeRequest_To_v2alpha2_UnmountVolumeRequest(in, out)
+}
+
+func autoConvert_v2alpha2_UnmountVolumeResponse_To_impl_UnmountVolumeResponse(in *v2alpha2.UnmountVolumeResponse, out *impl.UnmountVolumeResponse) error {
+	return nil
+}
+
+// Convert_v2alpha2_UnmountVolumeResponse_To_impl_UnmountVolumeResponse is an autogenerated conversion function.
+func Convert_v2alpha2_UnmountVolumeResponse_To_impl_UnmountVolumeResponse(in *v2alpha2.UnmountVolumeResponse, out *impl.UnmountVolumeResponse) error {
+	return autoConvert_v2alpha2_UnmountVolumeResponse_To_impl_UnmountVolumeResponse(in, out)
+}
+
+func autoConvert_impl_UnmountVolumeResponse_To_v2alpha2_UnmountVolumeResponse(in *impl.UnmountVolumeResponse, out *v2alpha2.UnmountVolumeResponse) error {
+	return nil
+}
+
+// Convert_impl_UnmountVolumeResponse_To_v2alpha2_UnmountVolumeResponse is an autogenerated conversion function.
+func Convert_impl_UnmountVolumeResponse_To_v2alpha2_UnmountVolumeResponse(in *impl.UnmountVolumeResponse, out *v2alpha2.UnmountVolumeResponse) error {
+	return autoConvert_impl_UnmountVolumeResponse_To_v2alpha2_UnmountVolumeResponse(in, out)
+}
+
+func autoConvert_v2alpha2_WriteVolumeCacheRequest_To_impl_WriteVolumeCacheRequest(in *v2alpha2.WriteVolumeCacheRequest, out *impl.WriteVolumeCacheRequest) error {
+	out.VolumeId = in.VolumeId
+	return nil
+}
+
+// Convert_v2alpha2_WriteVolumeCacheRequest_To_impl_WriteVolumeCacheRequest is an autogenerated conversion function.
+func Convert_v2alpha2_WriteVolumeCacheRequest_To_impl_WriteVolumeCacheRequest(in *v2alpha2.WriteVolumeCacheRequest, out *impl.WriteVolumeCacheRequest) error {
+	return autoConvert_v2alpha2_WriteVolumeCacheRequest_To_impl_WriteVolumeCacheRequest(in, out)
+}
+
+func autoConvert_impl_WriteVolumeCacheRequest_To_v2alpha2_WriteVolumeCacheRequest(in *impl.WriteVolumeCacheRequest, out *v2alpha2.WriteVolumeCacheRequest) error {
+	out.VolumeId = in.VolumeId
+	return nil
+}
+
+// Convert_impl_WriteVolumeCacheRequest_To_v2alpha2_WriteVolumeCacheRequest is an autogenerated conversion function.
+func Convert_impl_WriteVolumeCacheRequest_To_v2alpha2_WriteVolumeCacheRequest(in *impl.WriteVolumeCacheRequest, out *v2alpha2.WriteVolumeCacheRequest) error {
+	return autoConvert_impl_WriteVolumeCacheRequest_To_v2alpha2_WriteVolumeCacheRequest(in, out)
+}
+
+func autoConvert_v2alpha2_WriteVolumeCacheResponse_To_impl_WriteVolumeCacheResponse(in *v2alpha2.WriteVolumeCacheResponse, out *impl.WriteVolumeCacheResponse) error {
+	return nil
+}
+
+// Convert_v2alpha2_WriteVolumeCacheResponse_To_impl_WriteVolumeCacheResponse is an autogenerated conversion function.
+func Convert_v2alpha2_WriteVolumeCacheResponse_To_impl_WriteVolumeCacheResponse(in *v2alpha2.WriteVolumeCacheResponse, out *impl.WriteVolumeCacheResponse) error {
+	return autoConvert_v2alpha2_WriteVolumeCacheResponse_To_impl_WriteVolumeCacheResponse(in, out)
+}
+
+func autoConvert_impl_WriteVolumeCacheResponse_To_v2alpha2_WriteVolumeCacheResponse(in *impl.WriteVolumeCacheResponse, out *v2alpha2.WriteVolumeCacheResponse) error {
+	return nil
+}
+
+// Convert_impl_WriteVolumeCacheResponse_To_v2alpha2_WriteVolumeCacheResponse is an autogenerated conversion function.
+func Convert_impl_WriteVolumeCacheResponse_To_v2alpha2_WriteVolumeCacheResponse(in *impl.WriteVolumeCacheResponse, out *v2alpha2.WriteVolumeCacheResponse) error {
+	return autoConvert_impl_WriteVolumeCacheResponse_To_v2alpha2_WriteVolumeCacheResponse(in, out)
+}
@@ -0,0 +1,275 @@
+// Code generated by csi-proxy-api-gen. DO NOT EDIT.
+
+package v2alpha2
+
+import (
+	"context"
+
+	"github.com/kubernetes-csi/csi-proxy/client/api/volume/v2alpha2"
+	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/volume/impl"
+	"google.golang.org/grpc"
+)
+
+var version = apiversion.NewVersionOrPanic("v2alpha2")
+
+type versionedAPI struct {
+	apiGroupServer impl.ServerInterface
+}
+
+func NewVersionedServer(apiGroupServer impl.ServerInterface) impl.VersionedAPI {
+	return &versionedAPI{
+		apiGroupServer: apiGroupServer,
+	}
+}
+
+func (s *versionedAPI) Register(grpcServer *grpc.Server) {
+	v2alpha2.RegisterVolumeServer(grpcServer, s)
+}
+
+func (s *versionedAPI) FormatVolume(context context.Context, versionedRequest *v2alpha2.FormatVolumeRequest) (*v2alpha2.FormatVolumeResponse, error) {
+	request := &impl.FormatVolumeRequest{}
+	if err := Convert_v2alpha2_FormatVolumeRequest_To_impl_FormatVolumeRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.FormatVolume(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha2.FormatVolumeResponse{}
+	if err := Convert_impl_FormatVolumeResponse_To_v2alpha2_FormatVolumeResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) GetClosestVolumeIDFromTargetPath(context context.Context, versionedRequest *v2alpha2.GetClosestVolumeIDFromTargetPathRequest) (*v2alpha2.GetClosestVolumeIDFromTargetPathResponse, error) {
+	request := &impl.GetClosestVolumeIDFromTargetPathRequest{}
+	if err := Convert_v2alpha2_GetClosestVolumeIDFromTargetPathRequest_To_impl_GetClosestVolumeIDFromTargetPathRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.GetClosestVolumeIDFromTargetPath(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha2.GetClosestVolumeIDFromTargetPathResponse{}
+	if err := Convert_impl_GetClosestVolumeIDFromTargetPathResponse_To_v2alpha2_GetClosestVolumeIDFromTargetPathResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) GetDiskNumberFromVolumeID(context context.Context, versionedRequest *v2alpha2.GetDiskNumberFromVolumeIDRequest) (*v2alpha2.GetDiskNumberFromVolumeIDResponse, error) {
+	request := &impl.GetDiskNumberFromVolumeIDRequest{}
+	if err := Convert_v2alpha2_GetDiskNumberFromVolumeIDRequest_To_impl_GetDiskNumberFromVolumeIDRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.GetDiskNumberFromVolumeID(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha2.GetDiskNumberFromVolumeIDResponse{}
+	if err := Convert_impl_GetDiskNumberFromVolumeIDResponse_To_v2alpha2_GetDiskNumberFromVolumeIDResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) GetVolumeIDFromTargetPath(context context.Context, versionedRequest *v2alpha2.GetVolumeIDFromTargetPathRequest) (*v2alpha2.GetVolumeIDFromTargetPathResponse, error) {
+	request := &impl.GetVolumeIDFromTargetPathRequest{}
+	if err := Convert_v2alpha2_GetVolumeIDFromTargetPathRequest_To_impl_GetVolumeIDFromTargetPathRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.GetVolumeIDFromTargetPath(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha2.GetVolumeIDFromTargetPathResponse{}
+	if err := Convert_impl_GetVolumeIDFromTargetPathResponse_To_v2alpha2_GetVolumeIDFromTargetPathResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) GetVolumeStats(context context.Context, versionedRequest *v2alpha2.GetVolumeStatsRequest) (*v2alpha2.GetVolumeStatsResponse, error) {
+	request := &impl.GetVolumeStatsRequest{}
+	if err := Convert_v2alpha2_GetVolumeStatsRequest_To_impl_GetVolumeStatsRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.GetVolumeStats(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha2.GetVolumeStatsResponse{}
+	if err := Convert_impl_GetVolumeStatsResponse_To_v2alpha2_GetVolumeStatsResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) IsVolumeFormatted(context context.Context, versionedRequest *v2alpha2.IsVolumeFormattedRequest) (*v2alpha2.IsVolumeFormattedResponse, error) {
+	request := &impl.IsVolumeFormattedRequest{}
+	if err := Convert_v2alpha2_IsVolumeFormattedRequest_To_impl_IsVolumeFormattedRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.IsVolumeFormatted(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha2.IsVolumeFormattedResponse{}
+	if err := Convert_impl_IsVolumeFormattedResponse_To_v2alpha2_IsVolumeFormattedResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) ListVolumesOnDisk(context context.Context, versionedRequest *v2alpha2.ListVolumesOnDiskRequest) (*v2alpha2.ListVolumesOnDiskResponse, error) {
+	request := &impl.ListVolumesOnDiskRequest{}
+	if err := Convert_v2alpha2_ListVolumesOnDiskRequest_To_impl_ListVolumesOnDiskRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.ListVolumesOnDisk(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha2.ListVolumesOnDiskResponse{}
+	if err := Convert_impl_ListVolumesOnDiskResponse_To_v2alpha2_ListVolumesOnDiskResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) MountVolume(context context.Context, versionedRequest *v2alpha2.MountVolumeRequest) (*v2alpha2.MountVolumeResponse, error) {
+	request := &impl.MountVolumeRequest{}
+	if err := Convert_v2alpha2_MountVolumeRequest_To_impl_MountVolumeRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.MountVolume(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha2.MountVolumeResponse{}
+	if err := Convert_impl_MountVolumeResponse_To_v2alpha2_MountVolumeResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) RepairVolume(context context.Context, versionedRequest *v2alpha2.RepairVolumeRequest) (*v2alpha2.RepairVolumeResponse, error) {
+	request := &impl.RepairVolumeRequest{}
+	if err := Convert_v2alpha2_RepairVolumeRequest_To_impl_RepairVolumeRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.RepairVolume(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha2.RepairVolumeResponse{}
+	if err := Convert_impl_RepairVolumeResponse_To_v2alpha2_RepairVolumeResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) ResizeVolume(context context.Context, versionedRequest *v2alpha2.ResizeVolumeRequest) (*v2alpha2.ResizeVolumeResponse, error) {
+	request := &impl.ResizeVolumeRequest{}
+	if err := Convert_v2alpha2_ResizeVolumeRequest_To_impl_ResizeVolumeRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.ResizeVolume(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha2.ResizeVolumeResponse{}
+	if err := Convert_impl_ResizeVolumeResponse_To_v2alpha2_ResizeVolumeResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) ScanVolume(context context.Context, versionedRequest *v2alpha2.ScanVolumeRequest) (*v2alpha2.ScanVolumeResponse, error) {
+	request := &impl.ScanVolumeRequest{}
+	if err := Convert_v2alpha2_ScanVolumeRequest_To_impl_ScanVolumeRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.ScanVolume(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha2.ScanVolumeResponse{}
+	if err := Convert_impl_ScanVolumeResponse_To_v2alpha2_ScanVolumeResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) UnmountVolume(context context.Context, versionedRequest *v2alpha2.UnmountVolumeRequest) (*v2alpha2.UnmountVolumeResponse, error) {
+	request := &impl.UnmountVolumeRequest{}
+	if err := Convert_v2alpha2_UnmountVolumeRequest_To_impl_UnmountVolumeRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.UnmountVolume(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha2.UnmountVolumeResponse{}
+	if err := Convert_impl_UnmountVolumeResponse_To_v2alpha2_UnmountVolumeResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) WriteVolumeCache(context context.Context, versionedRequest *v2alpha2.WriteVolumeCacheRequest) (*v2alpha2.WriteVolumeCacheResponse, error) {
+	request := &impl.WriteVolumeCacheRequest{}
+	if err := Convert_v2alpha2_WriteVolumeCacheRequest_To_impl_WriteVolumeCacheRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.WriteVolumeCache(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha2.WriteVolumeCacheResponse{}
+	if err := Convert_impl_WriteVolumeCacheResponse_To_v2alpha2_WriteVolumeCacheResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
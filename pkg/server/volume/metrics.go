@@ -0,0 +1,84 @@
+package volume
+
+import (
+	"time"
+
+	"github.com/kubernetes-csi/csi-proxy/pkg/os/volume"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/metrics"
+)
+
+// instrumentedAPI records the duration and the errors of the calls to the volume host API.
+type instrumentedAPI struct {
+	hostAPI volume.API
+}
+
+// NewInstrumentedAPI returns a volume.API recording the duration and the errors of the calls to hostAPI
+// in the host API metrics.
+func NewInstrumentedAPI(hostAPI volume.API) volume.API {
+	return &instrumentedAPI{hostAPI: hostAPI}
+}
+
+func (i *instrumentedAPI) ListVolumesOnDisk(diskNumber uint32, partitionNumber uint32) (_ []string, err error) {
+	defer metrics.ObserveHostAPICall("volume", "ListVolumesOnDisk", time.Now(), &err)
+	return i.hostAPI.ListVolumesOnDisk(diskNumber, partitionNumber)
+}
+
+func (i *instrumentedAPI) MountVolume(volumeID, targetPath string) (err error) {
+	defer metrics.ObserveHostAPICall("volume", "MountVolume", time.Now(), &err)
+	return i.hostAPI.MountVolume(volumeID, targetPath)
+}
+
+func (i *instrumentedAPI) UnmountVolume(volumeID, targetPath string) (err error) {
+	defer metrics.ObserveHostAPICall("volume", "UnmountVolume", time.Now(), &err)
+	return i.hostAPI.UnmountVolume(volumeID, targetPath)
+}
+
+func (i *instrumentedAPI) GetVolumeFileSystem(volumeID string) (_ string, err error) {
+	defer metrics.ObserveHostAPICall("volume", "GetVolumeFileSystem", time.Now(), &err)
+	return i.hostAPI.GetVolumeFileSystem(volumeID)
+}
+
+func (i *instrumentedAPI) FormatVolume(volumeID string, options volume.FormatOptions) (err error) {
+	defer metrics.ObserveHostAPICall("volume", "FormatVolume", time.Now(), &err)
+	return i.hostAPI.FormatVolume(volumeID, options)
+}
+
+func (i *instrumentedAPI) ResizeVolume(volumeID string, sizeBytes int64, allowShrink bool) (_ *volume.ResizeResult, err error) {
+	defer metrics.ObserveHostAPICall("volume", "ResizeVolume", time.Now(), &err)
+	return i.hostAPI.ResizeVolume(volumeID, sizeBytes, allowShrink)
+}
+
+func (i *instrumentedAPI) GetVolumeStats(volumeID string) (_ *volume.VolumeStats, err error) {
+	defer metrics.ObserveHostAPICall("volume", "GetVolumeStats", time.Now(), &err)
+	return i.hostAPI.GetVolumeStats(volumeID)
+}
+
+func (i *instrumentedAPI) RepairVolume(volumeID string, mode volume.RepairMode) (_ *volume.RepairResult, err error) {
+	defer metrics.ObserveHostAPICall("volume", "RepairVolume", time.Now(), &err)
+	return i.hostAPI.RepairVolume(volumeID, mode)
+}
+
+func (i *instrumentedAPI) GetDiskNumberFromVolumeID(volumeID string) (_ uint32, err error) {
+	defer metrics.ObserveHostAPICall("volume", "GetDiskNumberFromVolumeID", time.Now(), &err)
+	return i.hostAPI.GetDiskNumberFromVolumeID(volumeID)
+}
+
+func (i *instrumentedAPI) GetVolumeIDFromTargetPath(targetPath string) (_ string, err error) {
+	defer metrics.ObserveHostAPICall("volume", "GetVolumeIDFromTargetPath", time.Now(), &err)
+	return i.hostAPI.GetVolumeIDFromTargetPath(targetPath)
+}
+
+func (i *instrumentedAPI) WriteVolumeCache(volumeID string) (err error) {
+	defer metrics.ObserveHostAPICall("volume", "WriteVolumeCache", time.Now(), &err)
+	return i.hostAPI.WriteVolumeCache(volumeID)
+}
+
+func (i *instrumentedAPI) GetClosestVolumeIDFromTargetPath(targetPath string) (_ string, err error) {
+	defer metrics.ObserveHostAPICall("volume", "GetClosestVolumeIDFromTargetPath", time.Now(), &err)
+	return i.hostAPI.GetClosestVolumeIDFromTargetPath(targetPath)
+}
+
+func (i *instrumentedAPI) CheckHealth() (err error) {
+	defer metrics.ObserveHostAPICall("volume", "CheckHealth", time.Now(), &err)
+	return i.hostAPI.CheckHealth()
+}
@@ -2,26 +2,46 @@ package volume
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
 	"github.com/kubernetes-csi/csi-proxy/pkg/os/volume"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/guard"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/locks"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/tracing"
 	internal "github.com/kubernetes-csi/csi-proxy/pkg/server/volume/impl"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"k8s.io/klog/v2"
 )
 
 // Server wraps the host API and implements the autogenerated server interface
 type Server struct {
 	hostAPI volume.API
+	locks   *locks.Manager
+	guard   *guard.Guard
 }
 
-func NewServer(hostAPI volume.API) (*Server, error) {
+// NewServer returns a Server serializing its mutating operations on the same volume with lockManager,
+// and refusing to format the volumes diskGuard protects.
+func NewServer(hostAPI volume.API, lockManager *locks.Manager, diskGuard *guard.Guard) (*Server, error) {
 	return &Server{
 		hostAPI: hostAPI,
+		locks:   lockManager,
+		guard:   diskGuard,
 	}, nil
 }
 
+// CheckHealth returns an error if the volume host API is unavailable, e.g. because its WMI provider
+// is down. All the versions share the host API, their health is the same.
+func (s *Server) CheckHealth(version apiversion.Version) error {
+	return s.hostAPI.CheckHealth()
+}
+
 func (s *Server) ListVolumesOnDisk(context context.Context, request *internal.ListVolumesOnDiskRequest, version apiversion.Version) (*internal.ListVolumesOnDiskResponse, error) {
+	defer tracing.StartHostAPISpan(context, "volume", "ListVolumesOnDisk")()
 	klog.V(2).Infof("ListVolumesOnDisk: Request: %+v", request)
 	response := &internal.ListVolumesOnDiskResponse{}
 
@@ -36,6 +56,7 @@ func (s *Server) ListVolumesOnDisk(context context.Context, request *internal.Li
 }
 
 func (s *Server) MountVolume(context context.Context, request *internal.MountVolumeRequest, version apiversion.Version) (*internal.MountVolumeResponse, error) {
+	defer tracing.StartHostAPISpan(context, "volume", "MountVolume")()
 	klog.V(2).Infof("MountVolume: Request: %+v", request)
 	response := &internal.MountVolumeResponse{}
 
@@ -50,7 +71,14 @@ func (s *Server) MountVolume(context context.Context, request *internal.MountVol
 		return response, fmt.Errorf("MountVolumeRequest.TargetPath is empty")
 	}
 
-	err := s.hostAPI.MountVolume(volumeID, targetPath)
+	release, err := s.locks.TryAcquire(locks.VolumeResource(volumeID), "MountVolume")
+	if err != nil {
+		klog.Errorf("failed MountVolume %v", err)
+		return response, err
+	}
+	defer release()
+
+	err = s.hostAPI.MountVolume(volumeID, targetPath)
 	if err != nil {
 		klog.Errorf("failed MountVolume %v", err)
 		return response, err
@@ -65,13 +93,15 @@ func (s *Server) DismountVolume(context context.Context, request *internal.Dismo
 	}
 	_, err := s.UnmountVolume(context, unmountVolumeRequest, version)
 	if err != nil {
-		return nil, fmt.Errorf("Forward to UnmountVolume failed, err=%+v", err)
+		klog.Errorf("Forward to UnmountVolume failed: %+v", err)
+		return nil, err
 	}
 	dismountVolumeResponse := &internal.DismountVolumeResponse{}
 	return dismountVolumeResponse, nil
 }
 
 func (s *Server) UnmountVolume(context context.Context, request *internal.UnmountVolumeRequest, version apiversion.Version) (*internal.UnmountVolumeResponse, error) {
+	defer tracing.StartHostAPISpan(context, "volume", "UnmountVolume")()
 	klog.V(2).Infof("UnmountVolume: Request: %+v", request)
 	response := &internal.UnmountVolumeResponse{}
 
@@ -85,7 +115,14 @@ func (s *Server) UnmountVolume(context context.Context, request *internal.Unmoun
 		klog.Errorf("target path empty")
 		return response, fmt.Errorf("target path empty")
 	}
-	err := s.hostAPI.UnmountVolume(volumeID, targetPath)
+	release, err := s.locks.TryAcquire(locks.VolumeResource(volumeID), "UnmountVolume")
+	if err != nil {
+		klog.Errorf("failed UnmountVolume %v", err)
+		return response, err
+	}
+	defer release()
+
+	err = s.hostAPI.UnmountVolume(volumeID, targetPath)
 	if err != nil {
 		klog.Errorf("failed UnmountVolume %v", err)
 		return response, err
@@ -94,6 +131,7 @@ func (s *Server) UnmountVolume(context context.Context, request *internal.Unmoun
 }
 
 func (s *Server) IsVolumeFormatted(context context.Context, request *internal.IsVolumeFormattedRequest, version apiversion.Version) (*internal.IsVolumeFormattedResponse, error) {
+	defer tracing.StartHostAPISpan(context, "volume", "IsVolumeFormatted")()
 	klog.V(2).Infof("IsVolumeFormatted: Request: %+v", request)
 	response := &internal.IsVolumeFormattedResponse{}
 
@@ -102,17 +140,22 @@ func (s *Server) IsVolumeFormatted(context context.Context, request *internal.Is
 		klog.Errorf("volume id empty")
 		return response, fmt.Errorf("volume id empty")
 	}
-	isFormatted, err := s.hostAPI.IsVolumeFormatted(volumeID)
+	fileSystem, err := s.hostAPI.GetVolumeFileSystem(volumeID)
 	if err != nil {
 		klog.Errorf("failed IsVolumeFormatted %v", err)
 		return response, err
 	}
-	klog.V(5).Infof("IsVolumeFormatted: return: %v", isFormatted)
-	response.Formatted = isFormatted
+	klog.V(5).Infof("IsVolumeFormatted: return: %q", fileSystem)
+	response.Formatted = fileSystem != ""
+	response.FileSystem = fileSystem
 	return response, nil
 }
 
+// forceVersion is the first version whose FormatVolumeRequest has the Force field.
+var forceVersion = apiversion.NewVersionOrPanic("v2alpha2")
+
 func (s *Server) FormatVolume(context context.Context, request *internal.FormatVolumeRequest, version apiversion.Version) (*internal.FormatVolumeResponse, error) {
+	defer tracing.StartHostAPISpan(context, "volume", "FormatVolume")()
 	klog.V(2).Infof("FormatVolume: Request: %+v", request)
 	response := &internal.FormatVolumeResponse{}
 
@@ -121,8 +164,44 @@ func (s *Server) FormatVolume(context context.Context, request *internal.FormatV
 		klog.Errorf("volume id empty")
 		return response, fmt.Errorf("volume id empty")
 	}
+	options, err := formatOptions(request)
+	if err != nil {
+		klog.Errorf("failed FormatVolume %v", err)
+		return response, err
+	}
+
+	release, err := s.locks.TryAcquire(locks.VolumeResource(volumeID), "FormatVolume")
+	if err != nil {
+		klog.Errorf("failed FormatVolume %v", err)
+		return response, err
+	}
+	defer release()
 
-	err := s.hostAPI.FormatVolume(volumeID)
+	diskNumber, err := s.hostAPI.GetDiskNumberFromVolumeID(volumeID)
+	if err != nil {
+		klog.Errorf("failed GetDiskNumberFromVolumeID %v", err)
+		return response, err
+	}
+	if err := s.guard.CheckDisk(diskNumber, "FormatVolume"); err != nil {
+		klog.Errorf("failed FormatVolume %v", err)
+		return response, err
+	}
+
+	// Versions before v2alpha2 have no Force field to override the protection of formatted
+	// volumes, their clients expect FormatVolume to format them as before.
+	if version.Compare(forceVersion) >= 0 {
+		fileSystem, err := s.hostAPI.GetVolumeFileSystem(volumeID)
+		if err != nil {
+			klog.Errorf("failed IsVolumeFormatted %v", err)
+			return response, err
+		}
+		if err := s.guard.CheckFormat(volumeID, fileSystem != "", request.Force); err != nil {
+			klog.Errorf("failed FormatVolume %v", err)
+			return response, err
+		}
+	}
+
+	err = s.hostAPI.FormatVolume(volumeID, options)
 	if err != nil {
 		klog.Errorf("failed FormatVolume %v", err)
 		return response, err
@@ -130,7 +209,59 @@ func (s *Server) FormatVolume(context context.Context, request *internal.FormatV
 	return response, nil
 }
 
+// fileSystems maps the lower case names of the file systems FormatVolume supports to their names.
+var fileSystems = map[string]string{
+	"":      volume.FileSystemNTFS,
+	"ntfs":  volume.FileSystemNTFS,
+	"refs":  volume.FileSystemReFS,
+	"exfat": volume.FileSystemExFAT,
+	"fat32": volume.FileSystemFAT32,
+}
+
+// formatOptions validates the options of request against its file system and returns them.
+func formatOptions(request *internal.FormatVolumeRequest) (volume.FormatOptions, error) {
+	fileSystem, ok := fileSystems[strings.ToLower(request.FileSystem)]
+	if !ok {
+		return volume.FormatOptions{}, status.Errorf(codes.InvalidArgument, "unsupported file system %q, supported: NTFS, ReFS, exFAT and FAT32", request.FileSystem)
+	}
+
+	maxLabelLength := 32
+	if fileSystem == volume.FileSystemExFAT || fileSystem == volume.FileSystemFAT32 {
+		maxLabelLength = 11
+	}
+	if len([]rune(request.Label)) > maxLabelLength {
+		return volume.FormatOptions{}, status.Errorf(codes.InvalidArgument, "label %q is longer than %d characters, the maximum for %s", request.Label, maxLabelLength, fileSystem)
+	}
+
+	if size := request.AllocationUnitSize; size != 0 {
+		if size&(size-1) != 0 || size < 512 || size > 2*1024*1024 {
+			return volume.FormatOptions{}, status.Errorf(codes.InvalidArgument, "allocation unit size %d isn't a power of 2 from 512 to 2MiB", size)
+		}
+		if fileSystem == volume.FileSystemReFS && size != 4096 && size != 64*1024 {
+			return volume.FormatOptions{}, status.Errorf(codes.InvalidArgument, "allocation unit size %d isn't supported by ReFS, which supports 4KiB and 64KiB", size)
+		}
+	}
+
+	if (request.Compress || request.UseLargeFrs) && fileSystem != volume.FileSystemNTFS {
+		return volume.FormatOptions{}, status.Errorf(codes.InvalidArgument, "compression and large file record segments are only supported by NTFS, not %s", fileSystem)
+	}
+	if request.SetIntegrityStreams && fileSystem != volume.FileSystemReFS {
+		return volume.FormatOptions{}, status.Errorf(codes.InvalidArgument, "integrity streams are only supported by ReFS, not %s", fileSystem)
+	}
+
+	return volume.FormatOptions{
+		FileSystem:          fileSystem,
+		Label:               request.Label,
+		AllocationUnitSize:  request.AllocationUnitSize,
+		Full:                request.Full,
+		Compress:            request.Compress,
+		UseLargeFRS:         request.UseLargeFrs,
+		SetIntegrityStreams: request.SetIntegrityStreams,
+	}, nil
+}
+
 func (s *Server) WriteVolumeCache(context context.Context, request *internal.WriteVolumeCacheRequest, version apiversion.Version) (*internal.WriteVolumeCacheResponse, error) {
+	defer tracing.StartHostAPISpan(context, "volume", "WriteVolumeCache")()
 	klog.V(2).Infof("WriteVolumeCache: Request: %+v", request)
 	response := &internal.WriteVolumeCacheResponse{}
 
@@ -140,7 +271,14 @@ func (s *Server) WriteVolumeCache(context context.Context, request *internal.Wri
 		return response, fmt.Errorf("volume id empty")
 	}
 
-	err := s.hostAPI.WriteVolumeCache(volumeID)
+	release, err := s.locks.TryAcquire(locks.VolumeResource(volumeID), "WriteVolumeCache")
+	if err != nil {
+		klog.Errorf("failed WriteVolumeCache %v", err)
+		return response, err
+	}
+	defer release()
+
+	err = s.hostAPI.WriteVolumeCache(volumeID)
 	if err != nil {
 		klog.Errorf("failed WriteVolumeCache %v", err)
 		return response, err
@@ -149,6 +287,7 @@ func (s *Server) WriteVolumeCache(context context.Context, request *internal.Wri
 }
 
 func (s *Server) ResizeVolume(context context.Context, request *internal.ResizeVolumeRequest, version apiversion.Version) (*internal.ResizeVolumeResponse, error) {
+	defer tracing.StartHostAPISpan(context, "volume", "ResizeVolume")()
 	klog.V(2).Infof("ResizeVolume: Request: %+v", request)
 	response := &internal.ResizeVolumeResponse{}
 
@@ -158,13 +297,44 @@ func (s *Server) ResizeVolume(context context.Context, request *internal.ResizeV
 		return response, fmt.Errorf("volume id empty")
 	}
 	sizeBytes := request.SizeBytes
-	// TODO : Validate size param
+	if sizeBytes < 0 {
+		klog.Errorf("failed ResizeVolume: negative size %d", sizeBytes)
+		return response, status.Errorf(codes.InvalidArgument, "invalid negative size %d", sizeBytes)
+	}
 
-	err := s.hostAPI.ResizeVolume(volumeID, sizeBytes)
+	release, err := s.locks.TryAcquire(locks.VolumeResource(volumeID), "ResizeVolume")
 	if err != nil {
 		klog.Errorf("failed ResizeVolume %v", err)
 		return response, err
 	}
+	defer release()
+
+	if request.AllowShrink {
+		diskNumber, err := s.hostAPI.GetDiskNumberFromVolumeID(volumeID)
+		if err != nil {
+			klog.Errorf("failed GetDiskNumberFromVolumeID %v", err)
+			return response, err
+		}
+		if err := s.guard.CheckDisk(diskNumber, "ResizeVolume"); err != nil {
+			klog.Errorf("failed ResizeVolume %v", err)
+			return response, err
+		}
+	}
+
+	result, err := s.hostAPI.ResizeVolume(volumeID, sizeBytes, request.AllowShrink)
+	if err != nil {
+		klog.Errorf("failed ResizeVolume %v", err)
+		if errors.Is(err, volume.ErrBelowMinimumSize) || errors.Is(err, volume.ErrAboveMaximumSize) {
+			return response, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return response, err
+	}
+	response.PartitionSizeBefore = result.PartitionSizeBefore
+	response.PartitionSizeAfter = result.PartitionSizeAfter
+	response.FileSystemSizeBefore = result.FileSystemSizeBefore
+	response.FileSystemSizeAfter = result.FileSystemSizeAfter
+	response.Skipped = result.Skipped
+	response.SkipReason = result.SkipReason
 	return response, nil
 }
 
@@ -179,7 +349,8 @@ func (s *Server) VolumeStats(context context.Context, request *internal.VolumeSt
 	}
 	getVolumeStatsResponse, err := s.GetVolumeStats(context, getVolumeStatsRequest, version)
 	if err != nil {
-		return nil, fmt.Errorf("Forward to GetVolumeStats failed, err=%+v", err)
+		klog.Errorf("Forward to GetVolumeStats failed: %+v", err)
+		return nil, err
 	}
 	volumeStatsResponse := &internal.VolumeStatsResponse{
 		VolumeSize:     getVolumeStatsResponse.TotalBytes,
@@ -189,28 +360,132 @@ func (s *Server) VolumeStats(context context.Context, request *internal.VolumeSt
 }
 
 func (s *Server) GetVolumeStats(context context.Context, request *internal.GetVolumeStatsRequest, version apiversion.Version) (*internal.GetVolumeStatsResponse, error) {
+	defer tracing.StartHostAPISpan(context, "volume", "GetVolumeStats")()
 	klog.V(2).Infof("GetVolumeStats: Request: %+v", request)
 	volumeID := request.VolumeId
 	if volumeID == "" {
 		return nil, fmt.Errorf("volume id empty")
 	}
 
-	totalBytes, usedBytes, err := s.hostAPI.GetVolumeStats(volumeID)
+	stats, err := s.hostAPI.GetVolumeStats(volumeID)
 	if err != nil {
 		klog.Errorf("failed GetVolumeStats %v", err)
 		return nil, err
 	}
 
-	klog.V(2).Infof("VolumeStats: returned: Capacity %v Used %v", totalBytes, usedBytes)
+	klog.V(2).Infof("VolumeStats: returned: Capacity %v Used %v Health %v", stats.TotalBytes, stats.UsedBytes, stats.HealthStatus)
 
 	response := &internal.GetVolumeStatsResponse{
-		TotalBytes: totalBytes,
-		UsedBytes:  usedBytes,
+		TotalBytes:        stats.TotalBytes,
+		UsedBytes:         stats.UsedBytes,
+		HealthStatus:      healthStatus(stats.HealthStatus),
+		OperationalStatus: operationalStatus(stats.OperationalStatus),
 	}
 
 	return response, nil
 }
 
+func (s *Server) ScanVolume(context context.Context, request *internal.ScanVolumeRequest, version apiversion.Version) (*internal.ScanVolumeResponse, error) {
+	defer tracing.StartHostAPISpan(context, "volume", "ScanVolume")()
+	klog.V(2).Infof("ScanVolume: Request: %+v", request)
+	volumeID := request.VolumeId
+	if volumeID == "" {
+		return nil, status.Error(codes.InvalidArgument, "volume id empty")
+	}
+
+	release, err := s.locks.TryAcquire(locks.VolumeResource(volumeID), "ScanVolume")
+	if err != nil {
+		klog.Errorf("failed ScanVolume %v", err)
+		return nil, err
+	}
+	defer release()
+
+	result, err := s.hostAPI.RepairVolume(volumeID, volume.RepairModeScan)
+	if err != nil {
+		klog.Errorf("failed ScanVolume %v", err)
+		return nil, err
+	}
+
+	return &internal.ScanVolumeResponse{
+		Result:            internal.RepairResult(result.Output),
+		HealthStatus:      healthStatus(result.HealthStatus),
+		OperationalStatus: operationalStatus(result.OperationalStatus),
+		Dirty:             result.Dirty,
+	}, nil
+}
+
+// repairModes maps the repair modes of RepairVolume to the ones of the host API.
+var repairModes = map[internal.RepairMode]volume.RepairMode{
+	internal.RepairModeSpotFix:           volume.RepairModeSpotFix,
+	internal.RepairModeOfflineScanAndFix: volume.RepairModeOfflineScanAndFix,
+}
+
+func (s *Server) RepairVolume(context context.Context, request *internal.RepairVolumeRequest, version apiversion.Version) (*internal.RepairVolumeResponse, error) {
+	defer tracing.StartHostAPISpan(context, "volume", "RepairVolume")()
+	klog.V(2).Infof("RepairVolume: Request: %+v", request)
+	volumeID := request.VolumeId
+	if volumeID == "" {
+		return nil, status.Error(codes.InvalidArgument, "volume id empty")
+	}
+	mode, ok := repairModes[request.Mode]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown repair mode %d", request.Mode)
+	}
+
+	release, err := s.locks.TryAcquire(locks.VolumeResource(volumeID), "RepairVolume")
+	if err != nil {
+		klog.Errorf("failed RepairVolume %v", err)
+		return nil, err
+	}
+	defer release()
+
+	// the volume is taken offline during the repair
+	diskNumber, err := s.hostAPI.GetDiskNumberFromVolumeID(volumeID)
+	if err != nil {
+		klog.Errorf("failed GetDiskNumberFromVolumeID %v", err)
+		return nil, err
+	}
+	if err := s.guard.CheckDisk(diskNumber, "RepairVolume"); err != nil {
+		klog.Errorf("failed RepairVolume %v", err)
+		return nil, err
+	}
+
+	result, err := s.hostAPI.RepairVolume(volumeID, mode)
+	if err != nil {
+		klog.Errorf("failed RepairVolume %v", err)
+		return nil, err
+	}
+
+	return &internal.RepairVolumeResponse{
+		Result:            internal.RepairResult(result.Output),
+		HealthStatus:      healthStatus(result.HealthStatus),
+		OperationalStatus: operationalStatus(result.OperationalStatus),
+		Dirty:             result.Dirty,
+	}, nil
+}
+
+// healthStatus returns the health status of a MSFT_Volume HealthStatus value.
+func healthStatus(wmiHealthStatus uint16) internal.HealthStatus {
+	switch wmiHealthStatus {
+	case 0:
+		return internal.HealthStatusHealthy
+	case 1:
+		return internal.HealthStatusWarning
+	case 2:
+		return internal.HealthStatusUnhealthy
+	}
+	return internal.HealthStatusUnknown
+}
+
+// operationalStatus returns the operational statuses of MSFT_Volume OperationalStatus values.
+func operationalStatus(wmiOperationalStatus []uint16) []internal.OperationalStatus {
+	var statuses []internal.OperationalStatus
+	for _, value := range wmiOperationalStatus {
+		statuses = append(statuses, internal.OperationalStatus(value))
+	}
+	return statuses
+}
+
 func (s *Server) GetVolumeDiskNumber(context context.Context, request *internal.VolumeDiskNumberRequest, version apiversion.Version) (*internal.VolumeDiskNumberResponse, error) {
 	minimumVersion := apiversion.NewVersionOrPanic("v1beta1")
 	if version.Compare(minimumVersion) < 0 {
@@ -222,7 +497,8 @@ func (s *Server) GetVolumeDiskNumber(context context.Context, request *internal.
 	}
 	getDiskNumberFromVolumeIDResponse, err := s.GetDiskNumberFromVolumeID(context, getDiskNumberFromVolumeIDRequest, version)
 	if err != nil {
-		return nil, fmt.Errorf("Forward to GetDiskNumberFromVolumeID failed, err=%+v", err)
+		klog.Errorf("Forward to GetDiskNumberFromVolumeID failed: %+v", err)
+		return nil, err
 	}
 	volumeStatsResponse := &internal.VolumeDiskNumberResponse{
 		DiskNumber: int64(getDiskNumberFromVolumeIDResponse.DiskNumber),
@@ -231,6 +507,7 @@ func (s *Server) GetVolumeDiskNumber(context context.Context, request *internal.
 }
 
 func (s *Server) GetDiskNumberFromVolumeID(context context.Context, request *internal.GetDiskNumberFromVolumeIDRequest, version apiversion.Version) (*internal.GetDiskNumberFromVolumeIDResponse, error) {
+	defer tracing.StartHostAPISpan(context, "volume", "GetDiskNumberFromVolumeID")()
 	klog.V(2).Infof("GetDiskNumberFromVolumeID: Request: %+v", request)
 
 	volumeId := request.VolumeId
@@ -262,7 +539,8 @@ func (s *Server) GetVolumeIDFromMount(context context.Context, request *internal
 	}
 	getVolumeIDFromTargetPathResponse, err := s.GetVolumeIDFromTargetPath(context, getVolumeIDFromTargetPathRequest, version)
 	if err != nil {
-		return nil, fmt.Errorf("Forward to GetVolumeIDFromTargetPath failed, err=%+v", err)
+		klog.Errorf("Forward to GetVolumeIDFromTargetPath failed: %+v", err)
+		return nil, err
 	}
 	volumeIDFromMountResponse := &internal.VolumeIDFromMountResponse{
 		VolumeId: getVolumeIDFromTargetPathResponse.VolumeId,
@@ -271,6 +549,7 @@ func (s *Server) GetVolumeIDFromMount(context context.Context, request *internal
 }
 
 func (s *Server) GetVolumeIDFromTargetPath(context context.Context, request *internal.GetVolumeIDFromTargetPathRequest, version apiversion.Version) (*internal.GetVolumeIDFromTargetPathResponse, error) {
+	defer tracing.StartHostAPISpan(context, "volume", "GetVolumeIDFromTargetPath")()
 	klog.V(2).Infof("GetVolumeIDFromTargetPath: Request: %+v", request)
 
 	targetPath := request.TargetPath
@@ -292,6 +571,7 @@ func (s *Server) GetVolumeIDFromTargetPath(context context.Context, request *int
 }
 
 func (s *Server) GetClosestVolumeIDFromTargetPath(context context.Context, request *internal.GetClosestVolumeIDFromTargetPathRequest, version apiversion.Version) (*internal.GetClosestVolumeIDFromTargetPathResponse, error) {
+	defer tracing.StartHostAPISpan(context, "volume", "GetClosestVolumeIDFromTargetPath")()
 	klog.V(2).Infof("GetClosestVolumeIDFromTargetPath: Request: %+v", request)
 
 	targetPath := request.TargetPath
@@ -3,15 +3,26 @@ package volume
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
 	"github.com/kubernetes-csi/csi-proxy/pkg/os/volume"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/guard"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/locks"
 	internal "github.com/kubernetes-csi/csi-proxy/pkg/server/volume/impl"
+	shared "github.com/kubernetes-csi/csi-proxy/pkg/shared/disk"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type fakeVolumeAPI struct {
 	diskVolMap map[uint32][]string
+	formatted  []string
+	options    []volume.FormatOptions
+	dirty      map[string]bool
+	repairs    []volume.RepairMode
 }
 
 var _ volume.API = &fakeVolumeAPI{}
@@ -38,19 +49,52 @@ func (volumeAPI *fakeVolumeAPI) UnmountVolume(volumeID, path string) error {
 	return nil
 }
 
-func (volumeAPI *fakeVolumeAPI) IsVolumeFormatted(volumeID string) (bool, error) {
-	return true, nil
+func (volumeAPI *fakeVolumeAPI) GetVolumeFileSystem(volumeID string) (string, error) {
+	return volume.FileSystemNTFS, nil
 }
 
-func (volumeAPI *fakeVolumeAPI) FormatVolume(volumeID string) error {
+func (volumeAPI *fakeVolumeAPI) FormatVolume(volumeID string, options volume.FormatOptions) error {
+	volumeAPI.formatted = append(volumeAPI.formatted, volumeID)
+	volumeAPI.options = append(volumeAPI.options, options)
 	return nil
 }
 
-func (volumeAPI *fakeVolumeAPI) ResizeVolume(volumeID string, size int64) error {
-	return nil
+// ResizeVolume resizes partitions of 1GiB, with a file system 1MiB smaller, which can't shrink below 512MiB
+// nor grow beyond 4GiB.
+func (volumeAPI *fakeVolumeAPI) ResizeVolume(volumeID string, size int64, allowShrink bool) (*volume.ResizeResult, error) {
+	const currentSize, minSize, maxSize, fileSystemOverhead = 1024 * 1024 * 1024, 512 * 1024 * 1024, 4096 * 1024 * 1024, 1024 * 1024
+	result := &volume.ResizeResult{
+		PartitionSizeBefore:  currentSize,
+		PartitionSizeAfter:   size,
+		FileSystemSizeBefore: currentSize - fileSystemOverhead,
+		FileSystemSizeAfter:  size - fileSystemOverhead,
+	}
+	switch {
+	case size < currentSize && !allowShrink:
+		result.PartitionSizeAfter, result.FileSystemSizeAfter = result.PartitionSizeBefore, result.FileSystemSizeBefore
+		result.Skipped, result.SkipReason = true, "shrinking isn't allowed"
+	case size < minSize:
+		return nil, fmt.Errorf("%w: minimum size is %d bytes", volume.ErrBelowMinimumSize, minSize)
+	case size > maxSize:
+		return nil, fmt.Errorf("%w: maximum size is %d bytes", volume.ErrAboveMaximumSize, maxSize)
+	}
+	return result, nil
 }
 
+// missingVolume is the ID of a volume the fake reports as not found.
+const missingVolume = "missingVolume"
+
 func (volumeAPI *fakeVolumeAPI) GetDiskNumberFromVolumeID(volumeID string) (uint32, error) {
+	if volumeID == missingVolume {
+		return 0, status.Errorf(codes.NotFound, "volume %s not found", volumeID)
+	}
+	for diskNumber, volumeIDs := range volumeAPI.diskVolMap {
+		for _, id := range volumeIDs {
+			if id == volumeID {
+				return diskNumber, nil
+			}
+		}
+	}
 	return 0, nil
 }
 
@@ -62,14 +106,454 @@ func (volumeAPI *fakeVolumeAPI) GetClosestVolumeIDFromTargetPath(mount string) (
 	return "id", nil
 }
 
-func (volumeAPI *fakeVolumeAPI) GetVolumeStats(volumeID string) (int64, int64, error) {
-	return -1, -1, nil
+// GetVolumeStats reports the dirty volumes as needing a scan.
+func (volumeAPI *fakeVolumeAPI) GetVolumeStats(volumeID string) (*volume.VolumeStats, error) {
+	if volumeID == missingVolume {
+		return nil, status.Errorf(codes.NotFound, "volume %s not found", volumeID)
+	}
+	stats := &volume.VolumeStats{TotalBytes: 1024, UsedBytes: 512, OperationalStatus: []uint16{2}}
+	if volumeAPI.dirty[volumeID] {
+		stats.HealthStatus, stats.OperationalStatus = 1, []uint16{0xD00D}
+	}
+	return stats, nil
+}
+
+// RepairVolume finds errors needing a spot fix on the dirty volumes, which any fix clears.
+func (volumeAPI *fakeVolumeAPI) RepairVolume(volumeID string, mode volume.RepairMode) (*volume.RepairResult, error) {
+	volumeAPI.repairs = append(volumeAPI.repairs, mode)
+	if !volumeAPI.dirty[volumeID] {
+		return &volume.RepairResult{OperationalStatus: []uint16{2}}, nil
+	}
+	if mode == volume.RepairModeScan {
+		return &volume.RepairResult{Output: 2, HealthStatus: 1, OperationalStatus: []uint16{0xD00E}, Dirty: true}, nil
+	}
+	delete(volumeAPI.dirty, volumeID)
+	return &volume.RepairResult{Output: 1, OperationalStatus: []uint16{2}}, nil
 }
 
 func (volumeAPI *fakeVolumeAPI) WriteVolumeCache(volumeID string) error {
 	return nil
 }
 
+func (volumeAPI *fakeVolumeAPI) CheckHealth() error {
+	return nil
+}
+
+// fakeDiskAPI reports disk 0 as the boot disk.
+type fakeDiskAPI struct{}
+
+func (fakeDiskAPI) GetDiskInfo(diskNumber uint32) (*shared.DiskInfo, error) {
+	return &shared.DiskInfo{Number: diskNumber, IsBoot: diskNumber == 0, IsSystem: diskNumber == 0}, nil
+}
+
+func (fakeDiskAPI) ListPageFileDisks() ([]uint32, error) {
+	return []uint32{0}, nil
+}
+
+func TestFormatVolume(t *testing.T) {
+	v2alpha2, err := apiversion.NewVersion("v2alpha2")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+
+	testCases := []struct {
+		name          string
+		volumeID      string
+		version       *apiversion.Version
+		force         bool
+		policy        guard.Policy
+		expectedCode  codes.Code
+		expectedError string
+	}{
+		{
+			name:          "volume on the boot disk",
+			volumeID:      "bootVolume",
+			force:         true,
+			policy:        guard.DefaultPolicy(),
+			expectedCode:  codes.FailedPrecondition,
+			expectedError: "it is the boot disk, the system disk, a page file disk",
+		},
+		{
+			name:          "formatted volume",
+			volumeID:      "dataVolume",
+			policy:        guard.DefaultPolicy(),
+			expectedCode:  codes.FailedPrecondition,
+			expectedError: "already has a file system",
+		},
+		{
+			name:         "formatted volume forced",
+			volumeID:     "dataVolume",
+			force:        true,
+			policy:       guard.DefaultPolicy(),
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "formatted volume not protected",
+			volumeID:     "dataVolume",
+			policy:       guard.Policy{BootDisks: true},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "formatted volume in a version without force",
+			volumeID:     "dataVolume",
+			version:      &v2alpha1,
+			policy:       guard.DefaultPolicy(),
+			expectedCode: codes.OK,
+		},
+		{
+			name:          "volume on the boot disk in a version without force",
+			volumeID:      "bootVolume",
+			version:       &v2alpha1,
+			policy:        guard.DefaultPolicy(),
+			expectedCode:  codes.FailedPrecondition,
+			expectedError: "it is the boot disk, the system disk, a page file disk",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			volAPI := &fakeVolumeAPI{
+				diskVolMap: map[uint32][]string{0: {"bootVolume"}, 1: {"dataVolume"}},
+			}
+			volumeSrv, err := NewServer(volAPI, locks.NewManager(), guard.NewGuard(&fakeDiskAPI{}, tc.policy))
+			if err != nil {
+				t.Fatalf("Volume server could not be initialized: %v", err)
+			}
+
+			version := v2alpha2
+			if tc.version != nil {
+				version = *tc.version
+			}
+			request := &internal.FormatVolumeRequest{VolumeId: tc.volumeID, Force: tc.force}
+			_, err = volumeSrv.FormatVolume(context.TODO(), request, version)
+			if status.Code(err) != tc.expectedCode {
+				t.Fatalf("Expected code %v, got error: %v", tc.expectedCode, err)
+			}
+			if tc.expectedCode != codes.OK {
+				if !strings.Contains(err.Error(), tc.expectedError) {
+					t.Fatalf("Expected error containing %q, got: %v", tc.expectedError, err)
+				}
+				if len(volAPI.formatted) != 0 {
+					t.Fatalf("Volume %s formatted", tc.volumeID)
+				}
+			} else if len(volAPI.formatted) != 1 {
+				t.Fatalf("Volume %s not formatted", tc.volumeID)
+			}
+		})
+	}
+}
+
+func TestFormatVolumeOptions(t *testing.T) {
+	v2alpha2, err := apiversion.NewVersion("v2alpha2")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+
+	testCases := []struct {
+		name            string
+		request         *internal.FormatVolumeRequest
+		expectedCode    codes.Code
+		expectedOptions volume.FormatOptions
+	}{
+		{
+			name:            "defaults",
+			request:         &internal.FormatVolumeRequest{},
+			expectedOptions: volume.FormatOptions{FileSystem: volume.FileSystemNTFS},
+		},
+		{
+			name:            "NTFS options",
+			request:         &internal.FormatVolumeRequest{FileSystem: "ntfs", Label: "data", AllocationUnitSize: 65536, Full: true, Compress: true, UseLargeFrs: true},
+			expectedOptions: volume.FormatOptions{FileSystem: volume.FileSystemNTFS, Label: "data", AllocationUnitSize: 65536, Full: true, Compress: true, UseLargeFRS: true},
+		},
+		{
+			name:            "ReFS integrity streams",
+			request:         &internal.FormatVolumeRequest{FileSystem: "REFS", AllocationUnitSize: 4096, SetIntegrityStreams: true},
+			expectedOptions: volume.FormatOptions{FileSystem: volume.FileSystemReFS, AllocationUnitSize: 4096, SetIntegrityStreams: true},
+		},
+		{
+			name:            "exFAT",
+			request:         &internal.FormatVolumeRequest{FileSystem: "exfat", Label: "EXCHANGE"},
+			expectedOptions: volume.FormatOptions{FileSystem: volume.FileSystemExFAT, Label: "EXCHANGE"},
+		},
+		{
+			name:         "unsupported file system",
+			request:      &internal.FormatVolumeRequest{FileSystem: "ext4"},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "FAT32 label too long",
+			request:      &internal.FormatVolumeRequest{FileSystem: "FAT32", Label: "a label too long"},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "allocation unit size not a power of 2",
+			request:      &internal.FormatVolumeRequest{AllocationUnitSize: 3000},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "ReFS allocation unit size",
+			request:      &internal.FormatVolumeRequest{FileSystem: "ReFS", AllocationUnitSize: 8192},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "ReFS compression",
+			request:      &internal.FormatVolumeRequest{FileSystem: "ReFS", Compress: true},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "NTFS integrity streams",
+			request:      &internal.FormatVolumeRequest{SetIntegrityStreams: true},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			volAPI := &fakeVolumeAPI{
+				diskVolMap: map[uint32][]string{1: {"dataVolume"}},
+			}
+			volumeSrv, err := NewServer(volAPI, locks.NewManager(), guard.NewGuard(&fakeDiskAPI{}, guard.DefaultPolicy()))
+			if err != nil {
+				t.Fatalf("Volume server could not be initialized: %v", err)
+			}
+
+			tc.request.VolumeId = "dataVolume"
+			tc.request.Force = true
+			_, err = volumeSrv.FormatVolume(context.TODO(), tc.request, v2alpha2)
+			if status.Code(err) != tc.expectedCode {
+				t.Fatalf("Expected code %v, got error: %v", tc.expectedCode, err)
+			}
+			if tc.expectedCode != codes.OK {
+				if len(volAPI.formatted) != 0 {
+					t.Fatalf("Volume formatted")
+				}
+				return
+			}
+			if len(volAPI.options) != 1 || volAPI.options[0] != tc.expectedOptions {
+				t.Fatalf("Expected options %+v, got: %+v", tc.expectedOptions, volAPI.options)
+			}
+		})
+	}
+}
+
+func TestIsVolumeFormatted(t *testing.T) {
+	v2alpha2, err := apiversion.NewVersion("v2alpha2")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+
+	volumeSrv, err := NewServer(&fakeVolumeAPI{}, locks.NewManager(), guard.NewGuard(&fakeDiskAPI{}, guard.DefaultPolicy()))
+	if err != nil {
+		t.Fatalf("Volume server could not be initialized: %v", err)
+	}
+	response, err := volumeSrv.IsVolumeFormatted(context.TODO(), &internal.IsVolumeFormattedRequest{VolumeId: "dataVolume"}, v2alpha2)
+	if err != nil {
+		t.Fatalf("IsVolumeFormatted failed: %v", err)
+	}
+	if !response.Formatted || response.FileSystem != volume.FileSystemNTFS {
+		t.Fatalf("Expected the volume to be formatted with NTFS, got: %+v", response)
+	}
+}
+
+func TestResizeVolume(t *testing.T) {
+	v2alpha2, err := apiversion.NewVersion("v2alpha2")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+	const mb = 1024 * 1024
+
+	testCases := []struct {
+		name             string
+		request          *internal.ResizeVolumeRequest
+		expectedCode     codes.Code
+		expectedResponse internal.ResizeVolumeResponse
+	}{
+		{
+			name:    "grow",
+			request: &internal.ResizeVolumeRequest{VolumeId: "dataVolume", SizeBytes: 2048 * mb},
+			expectedResponse: internal.ResizeVolumeResponse{
+				PartitionSizeBefore: 1024 * mb, PartitionSizeAfter: 2048 * mb,
+				FileSystemSizeBefore: 1023 * mb, FileSystemSizeAfter: 2047 * mb,
+			},
+		},
+		{
+			name:    "shrink not allowed",
+			request: &internal.ResizeVolumeRequest{VolumeId: "dataVolume", SizeBytes: 768 * mb},
+			expectedResponse: internal.ResizeVolumeResponse{
+				PartitionSizeBefore: 1024 * mb, PartitionSizeAfter: 1024 * mb,
+				FileSystemSizeBefore: 1023 * mb, FileSystemSizeAfter: 1023 * mb,
+				Skipped: true, SkipReason: "shrinking isn't allowed",
+			},
+		},
+		{
+			name:    "shrink",
+			request: &internal.ResizeVolumeRequest{VolumeId: "dataVolume", SizeBytes: 768 * mb, AllowShrink: true},
+			expectedResponse: internal.ResizeVolumeResponse{
+				PartitionSizeBefore: 1024 * mb, PartitionSizeAfter: 768 * mb,
+				FileSystemSizeBefore: 1023 * mb, FileSystemSizeAfter: 767 * mb,
+			},
+		},
+		{
+			name:         "shrink below the minimum size",
+			request:      &internal.ResizeVolumeRequest{VolumeId: "dataVolume", SizeBytes: 256 * mb, AllowShrink: true},
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "grow beyond the maximum size",
+			request:      &internal.ResizeVolumeRequest{VolumeId: "dataVolume", SizeBytes: 8192 * mb},
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "shrink on the boot disk",
+			request:      &internal.ResizeVolumeRequest{VolumeId: "bootVolume", SizeBytes: 768 * mb, AllowShrink: true},
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "negative size",
+			request:      &internal.ResizeVolumeRequest{VolumeId: "dataVolume", SizeBytes: -1},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			volAPI := &fakeVolumeAPI{
+				diskVolMap: map[uint32][]string{0: {"bootVolume"}, 1: {"dataVolume"}},
+			}
+			volumeSrv, err := NewServer(volAPI, locks.NewManager(), guard.NewGuard(&fakeDiskAPI{}, guard.DefaultPolicy()))
+			if err != nil {
+				t.Fatalf("Volume server could not be initialized: %v", err)
+			}
+
+			response, err := volumeSrv.ResizeVolume(context.TODO(), tc.request, v2alpha2)
+			if status.Code(err) != tc.expectedCode {
+				t.Fatalf("Expected code %v, got error: %v", tc.expectedCode, err)
+			}
+			if err == nil && *response != tc.expectedResponse {
+				t.Fatalf("Expected response %+v, got: %+v", tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestScanAndRepairVolume(t *testing.T) {
+	v2alpha2, err := apiversion.NewVersion("v2alpha2")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+	volAPI := &fakeVolumeAPI{
+		diskVolMap: map[uint32][]string{0: {"bootVolume"}, 1: {"dataVolume"}},
+		dirty:      map[string]bool{"bootVolume": true, "dataVolume": true},
+	}
+	volumeSrv, err := NewServer(volAPI, locks.NewManager(), guard.NewGuard(&fakeDiskAPI{}, guard.DefaultPolicy()))
+	if err != nil {
+		t.Fatalf("Volume server could not be initialized: %v", err)
+	}
+
+	statsResponse, err := volumeSrv.GetVolumeStats(context.TODO(), &internal.GetVolumeStatsRequest{VolumeId: "dataVolume"}, v2alpha2)
+	if err != nil {
+		t.Fatalf("GetVolumeStats failed: %v", err)
+	}
+	expectedStats := internal.GetVolumeStatsResponse{
+		TotalBytes:        1024,
+		UsedBytes:         512,
+		HealthStatus:      internal.HealthStatusWarning,
+		OperationalStatus: []internal.OperationalStatus{internal.OperationalStatusScanNeeded},
+	}
+	if !reflect.DeepEqual(*statsResponse, expectedStats) {
+		t.Fatalf("Expected stats %+v, got: %+v", expectedStats, statsResponse)
+	}
+
+	scanResponse, err := volumeSrv.ScanVolume(context.TODO(), &internal.ScanVolumeRequest{VolumeId: "dataVolume"}, v2alpha2)
+	if err != nil {
+		t.Fatalf("ScanVolume failed: %v", err)
+	}
+	expectedScan := internal.ScanVolumeResponse{
+		Result:            internal.RepairResultSpotFixNeeded,
+		HealthStatus:      internal.HealthStatusWarning,
+		OperationalStatus: []internal.OperationalStatus{internal.OperationalStatusSpotFixNeeded},
+		Dirty:             true,
+	}
+	if !reflect.DeepEqual(*scanResponse, expectedScan) {
+		t.Fatalf("Expected scan %+v, got: %+v", expectedScan, scanResponse)
+	}
+
+	repairResponse, err := volumeSrv.RepairVolume(context.TODO(), &internal.RepairVolumeRequest{VolumeId: "dataVolume", Mode: internal.RepairModeSpotFix}, v2alpha2)
+	if err != nil {
+		t.Fatalf("RepairVolume failed: %v", err)
+	}
+	expectedRepair := internal.RepairVolumeResponse{
+		Result:            internal.RepairResultErrorsFixed,
+		HealthStatus:      internal.HealthStatusHealthy,
+		OperationalStatus: []internal.OperationalStatus{internal.OperationalStatusOK},
+	}
+	if !reflect.DeepEqual(*repairResponse, expectedRepair) {
+		t.Fatalf("Expected repair %+v, got: %+v", expectedRepair, repairResponse)
+	}
+
+	// the boot volume can be scanned, but not taken offline to be repaired
+	if _, err := volumeSrv.ScanVolume(context.TODO(), &internal.ScanVolumeRequest{VolumeId: "bootVolume"}, v2alpha2); err != nil {
+		t.Fatalf("ScanVolume failed: %v", err)
+	}
+	_, err = volumeSrv.RepairVolume(context.TODO(), &internal.RepairVolumeRequest{VolumeId: "bootVolume", Mode: internal.RepairModeOfflineScanAndFix}, v2alpha2)
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("Expected code %v, got error: %v", codes.FailedPrecondition, err)
+	}
+
+	_, err = volumeSrv.RepairVolume(context.TODO(), &internal.RepairVolumeRequest{VolumeId: "dataVolume", Mode: 5}, v2alpha2)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("Expected code %v, got error: %v", codes.InvalidArgument, err)
+	}
+
+	expectedRepairs := []volume.RepairMode{volume.RepairModeScan, volume.RepairModeSpotFix, volume.RepairModeScan}
+	if !reflect.DeepEqual(volAPI.repairs, expectedRepairs) {
+		t.Fatalf("Expected repairs %v, got: %v", expectedRepairs, volAPI.repairs)
+	}
+}
+
+func TestForwardedErrorCodes(t *testing.T) {
+	v1beta3 := apiversion.NewVersionOrPanic("v1beta3")
+
+	volumeSrv, err := NewServer(&fakeVolumeAPI{}, locks.NewManager(), guard.NewGuard(&fakeDiskAPI{}, guard.DefaultPolicy()))
+	if err != nil {
+		t.Fatalf("Volume server could not be initialized: %v", err)
+	}
+
+	_, err = volumeSrv.VolumeStats(context.TODO(), &internal.VolumeStatsRequest{VolumeId: missingVolume}, v1beta3)
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("Expected code %v from VolumeStats, got error: %v", codes.NotFound, err)
+	}
+	_, err = volumeSrv.GetVolumeDiskNumber(context.TODO(), &internal.VolumeDiskNumberRequest{VolumeId: missingVolume}, v1beta3)
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("Expected code %v from GetVolumeDiskNumber, got error: %v", codes.NotFound, err)
+	}
+}
+
+func TestDismountVolumeInProgress(t *testing.T) {
+	v1beta3 := apiversion.NewVersionOrPanic("v1beta3")
+
+	lockManager := locks.NewManager()
+	volumeSrv, err := NewServer(&fakeVolumeAPI{}, lockManager, guard.NewGuard(&fakeDiskAPI{}, guard.DefaultPolicy()))
+	if err != nil {
+		t.Fatalf("Volume server could not be initialized: %v", err)
+	}
+	release, err := lockManager.TryAcquire(locks.VolumeResource("dataVolume"), "FormatVolume")
+	if err != nil {
+		t.Fatalf("Could not acquire the volume: %v", err)
+	}
+	defer release()
+
+	// the client must be able to tell that it should retry later
+	_, err = volumeSrv.DismountVolume(context.TODO(), &internal.DismountVolumeRequest{VolumeId: "dataVolume", Path: `C:\mnt`}, v1beta3)
+	if status.Code(err) != codes.Aborted {
+		t.Fatalf("Expected code %v from DismountVolume, got error: %v", codes.Aborted, err)
+	}
+}
+
 func TestListVolumesOnDisk(t *testing.T) {
 	v1, err := apiversion.NewVersion("v1")
 	if err != nil {
@@ -115,7 +599,7 @@ func TestListVolumesOnDisk(t *testing.T) {
 	}
 	volAPI.Fill(diskToVolMap)
 
-	volumeSrv, err := NewServer(volAPI)
+	volumeSrv, err := NewServer(volAPI, locks.NewManager(), guard.NewGuard(&fakeDiskAPI{}, guard.DefaultPolicy()))
 	if err != nil {
 		t.Fatalf("Volume server could not be initialized: %v", err)
 	}
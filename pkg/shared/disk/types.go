@@ -15,4 +15,75 @@ type DiskLocation struct {
 type DiskIDs struct {
 	Page83       string
 	SerialNumber string
+	// DeviceSerialNumber is the serial number of the STORAGE_DEVICE_DESCRIPTOR, e.g. the NVMe controller serial number
+	DeviceSerialNumber string
+	// Identifiers are all the device identification descriptors (VPD page 0x83) of the disk
+	Identifiers []DiskIdentifier
+}
+
+// DiskIdentifier definition
+type DiskIdentifier struct {
+	// Type is the STORAGE_IDENTIFIER_TYPE, e.g. 2 for EUI-64 and 3 for NAA
+	Type uint32
+	// Association is the STORAGE_ASSOCIATION_TYPE, 0 for the device itself
+	Association uint32
+	// Value is the identifier, hex encoded if binary
+	Value string
+}
+
+// DiskInfo definition
+type DiskInfo struct {
+	Number             uint32
+	Size               uint64
+	LogicalSectorSize  uint32
+	PhysicalSectorSize uint32
+	// BusType is the MSFT_Disk BusType, e.g. 9 for iSCSI
+	BusType      uint16
+	FriendlyName string
+	Model        string
+	SerialNumber string
+	Page83       string
+	// PartitionStyle is the MSFT_Disk PartitionStyle, 0 for RAW, 1 for MBR and 2 for GPT
+	PartitionStyle uint16
+	IsBoot         bool
+	IsSystem       bool
+	IsClustered    bool
+	IsReadOnly     bool
+	IsOffline      bool
+	// OfflineReason is the MSFT_Disk OfflineReason, 0 when the disk is online
+	OfflineReason uint16
+	Location      DiskLocation
+}
+
+// PartitionOptions definition, the zero values select the defaults of MSFT_Disk CreatePartition
+type PartitionOptions struct {
+	// Size in bytes, 0 to use the largest free extent
+	Size      uint64
+	Offset    uint64
+	Alignment uint32
+	// MbrType is the MSFT_Partition MbrType on MBR disks, 0 for the IFS type
+	MbrType uint16
+	// GptType is the MSFT_Partition GptType on GPT disks, empty for the basic data type
+	GptType string
+	// Name is the GPT partition name, empty to leave it unset
+	Name string
+}
+
+// PartitionInfo definition
+type PartitionInfo struct {
+	DiskNumber      uint32
+	PartitionNumber uint32
+	Offset          uint64
+	Size            uint64
+	MbrType         uint16
+	GptType         string
+	GUID            string
+	DriveLetter     string
+	IsBoot          bool
+	IsSystem        bool
+	IsHidden        bool
+	IsReadOnly      bool
+	IsOffline       bool
+	// IsReserved is true for the Microsoft Reserved Partition of GPT disks
+	IsReserved bool
 }
@@ -21,11 +21,14 @@ package wmi
 
 import (
 	"fmt"
+
+	"github.com/go-ole/go-ole"
 )
 
 const (
 	MSFTDiskClass           = "MSFT_Disk"
 	MSFTStorageSettingClass = "MSFT_StorageSetting"
+	Win32PageFileUsageClass = "Win32_PageFileUsage"
 
 	// PartitionStyleUnknown indicates an unknown partition table format
 	PartitionStyleUnknown = 0
@@ -41,6 +44,18 @@ const (
 	// Reserved by Windows for system use
 	GPTPartitionTypeMicrosoftReserved = "{e3c9e316-0b5c-4db8-817d-f92df00215ae}"
 
+	// MBRPartitionTypeIFS is the MBR partition type of the installable file systems (NTFS, ReFS, exFAT)
+	MBRPartitionTypeIFS = 7
+
+	// SANPolicyOnlineAll brings all the disks the host discovers online, the NewDiskPolicy of MSFT_StorageSetting
+	SANPolicyOnlineAll = 1
+	// SANPolicyOfflineShared leaves the disks on a shared bus (e.g. iSCSI, Fibre Channel) offline
+	SANPolicyOfflineShared = 2
+	// SANPolicyOfflineAll leaves all the disks the host discovers offline
+	SANPolicyOfflineAll = 3
+	// SANPolicyOfflineInternal leaves the internal disks the host discovers offline
+	SANPolicyOfflineInternal = 4
+
 	// ErrorCodeCreatePartitionAccessPathAlreadyInUse is the error code (42002) returned when the driver letter failed to assign after partition created
 	ErrorCodeCreatePartitionAccessPathAlreadyInUse = 42002
 )
@@ -50,7 +65,14 @@ var (
 	DiskSelectorListForPartitionStyle        = []string{"PartitionStyle"}
 	DiskSelectorListForPathAndSerialNumber   = []string{"Path", "SerialNumber"}
 	DiskSelectorListForIsOffline             = []string{"IsOffline"}
+	DiskSelectorListForIsReadOnly            = []string{"IsReadOnly"}
 	DiskSelectorListForSize                  = []string{"Size"}
+	DiskSelectorListForInfo                  = []string{
+		"Number", "Size", "LogicalSectorSize", "PhysicalSectorSize", "BusType", "FriendlyName", "Model",
+		"SerialNumber", "UniqueId", "PartitionStyle", "IsBoot", "IsSystem", "IsClustered", "IsReadOnly",
+		"IsOffline", "OfflineReason", "Location",
+	}
+	PageFileSelectorList = []string{"Name"}
 )
 
 // QueryDiskByNumber retrieves disk information for a specific disk identified by its number.
@@ -166,6 +188,82 @@ func SetDiskState(disk *COMDispatchObject, online bool) (string, error) {
 	return status, nil
 }
 
+// SetDiskReadOnly sets the read only attribute of a disk.
+//
+// Refer to https://learn.microsoft.com/en-us/windows-hardware/drivers/storage/msft-disk-setattributes
+// for the WMI method definition.
+func SetDiskReadOnly(disk *COMDispatchObject, readOnly bool) (string, error) {
+	var status string
+	// the signature and the GUID of the disk are left unchanged
+	result, err := disk.CallUint32("SetAttributes", readOnly, nil, nil, &status)
+	if err != nil {
+		return "", fmt.Errorf("failed to set disk attributes: %w", err)
+	}
+	if result != 0 {
+		return "", NewWMIError(MSFTDiskClass, "SetAttributes", disk.Dispatch(), result)
+	}
+	return status, nil
+}
+
+// ClearDisk removes the partitions and the data of a disk, leaving it uninitialized (RAW).
+//
+// Refer to https://learn.microsoft.com/en-us/windows-hardware/drivers/storage/msft-disk-clear
+// for the WMI method definition.
+func ClearDisk(disk *COMDispatchObject, removeOEM bool) (string, error) {
+	var status string
+	// the data partitions are removed, and the disk isn't zeroed
+	result, err := disk.CallUint32("Clear", true, removeOEM, false, &status)
+	if err != nil {
+		return "", fmt.Errorf("failed to clear disk: %w", err)
+	}
+	if result != 0 {
+		return "", NewWMIError(MSFTDiskClass, "Clear", disk.Dispatch(), result)
+	}
+	return status, nil
+}
+
+// GetSANPolicy returns the policy the host applies to the disks it discovers, one of the SANPolicy values.
+//
+// Refer to https://learn.microsoft.com/en-us/windows-hardware/drivers/storage/msft-storagesetting-get
+// for the WMI method definition.
+func GetSANPolicy() (uint16, error) {
+	var policy uint16
+	result, _, err := CallMethodOnWMIClass(WMINamespaceStorage, MSFTStorageSettingClass, "Get", nil, func(name string, value *ole.VARIANT) (interface{}, error) {
+		if name != "StorageSetting" || value.VT != ole.VT_DISPATCH {
+			return nil, nil
+		}
+		setting := NewCOMDispatchObject(value.ToIDispatch())
+		defer setting.release()
+
+		var err error
+		policy, err = setting.GetUint16Property("NewDiskPolicy")
+		return nil, err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get storage setting: %w", err)
+	}
+	if result != 0 {
+		return 0, NewWMIError(MSFTStorageSettingClass, "Get", nil, result)
+	}
+	return policy, nil
+}
+
+// SetSANPolicy sets the policy the host applies to the disks it discovers, one of the SANPolicy values.
+//
+// Refer to https://learn.microsoft.com/en-us/windows-hardware/drivers/storage/msft-storagesetting-set
+// for the WMI method definition.
+func SetSANPolicy(policy uint16) error {
+	params := map[string]interface{}{"NewDiskPolicy": int32(policy)}
+	result, _, err := CallMethodOnWMIClass(WMINamespaceStorage, MSFTStorageSettingClass, "Set", params, DiscardOutputParameter)
+	if err != nil {
+		return fmt.Errorf("failed to set storage setting: %w", err)
+	}
+	if result != 0 {
+		return NewWMIError(MSFTStorageSettingClass, "Set", nil, result)
+	}
+	return nil
+}
+
 // RescanDisks rescans all changes by updating the internal cache of software objects (that is, Disks, Partitions, Volumes)
 // for the storage setting.
 //
@@ -216,3 +314,82 @@ func GetDiskPath(disk *COMDispatchObject) (string, error) {
 func GetDiskSerialNumber(disk *COMDispatchObject) (string, error) {
 	return disk.GetStringProperty("SerialNumber")
 }
+
+// GetDiskLogicalSectorSize returns the logical sector size of a disk.
+func GetDiskLogicalSectorSize(disk *COMDispatchObject) (uint32, error) {
+	return disk.GetUint32Property("LogicalSectorSize")
+}
+
+// GetDiskPhysicalSectorSize returns the physical sector size of a disk.
+func GetDiskPhysicalSectorSize(disk *COMDispatchObject) (uint32, error) {
+	return disk.GetUint32Property("PhysicalSectorSize")
+}
+
+// GetDiskBusType returns the type of bus a disk is connected to.
+func GetDiskBusType(disk *COMDispatchObject) (uint16, error) {
+	return disk.GetUint16Property("BusType")
+}
+
+// GetDiskFriendlyName returns the friendly name of a disk.
+func GetDiskFriendlyName(disk *COMDispatchObject) (string, error) {
+	return disk.GetStringProperty("FriendlyName")
+}
+
+// GetDiskModel returns the model of a disk.
+func GetDiskModel(disk *COMDispatchObject) (string, error) {
+	return disk.GetStringProperty("Model")
+}
+
+// GetDiskUniqueID returns the unique ID of a disk, its page83 ID when the device reports one.
+func GetDiskUniqueID(disk *COMDispatchObject) (string, error) {
+	return disk.GetStringProperty("UniqueId")
+}
+
+// IsDiskBoot returns whether a disk is the boot disk.
+func IsDiskBoot(disk *COMDispatchObject) (bool, error) {
+	return disk.GetBoolProperty("IsBoot")
+}
+
+// IsDiskSystem returns whether a disk holds the system partition.
+func IsDiskSystem(disk *COMDispatchObject) (bool, error) {
+	return disk.GetBoolProperty("IsSystem")
+}
+
+// IsDiskClustered returns whether a disk is used by a failover cluster.
+func IsDiskClustered(disk *COMDispatchObject) (bool, error) {
+	return disk.GetBoolProperty("IsClustered")
+}
+
+// IsDiskReadOnly returns whether a disk is read only.
+func IsDiskReadOnly(disk *COMDispatchObject) (bool, error) {
+	return disk.GetBoolProperty("IsReadOnly")
+}
+
+// GetDiskOfflineReason returns the reason a disk is offline, 0 when it is online.
+func GetDiskOfflineReason(disk *COMDispatchObject) (uint16, error) {
+	return disk.GetUint16Property("OfflineReason")
+}
+
+// ListPageFiles retrieves the page files in use.
+//
+// The equivalent WMI query is:
+//
+//	SELECT [selectors] FROM Win32_PageFileUsage
+//
+// Refer to https://learn.microsoft.com/en-us/previous-versions/windows/desktop/legacy/aa394246(v=vs.85)
+// for the WMI class definition.
+func ListPageFiles(scope *Scope, selectorList []string) ([]*COMDispatchObject, error) {
+	q := NewQuery(Win32PageFileUsageClass).
+		Select(selectorList...)
+
+	pageFiles, err := QueryObjectsWithBuilder(scope, q)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query page files: %w", err)
+	}
+	return pageFiles, nil
+}
+
+// GetPageFileName returns the path of a page file, e.g. C:\pagefile.sys.
+func GetPageFileName(pageFile *COMDispatchObject) (string, error) {
+	return pageFile.GetStringProperty("Name")
+}
@@ -0,0 +1,254 @@
+//go:build windows
+// +build windows
+
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wmi
+
+import (
+	"fmt"
+
+	"github.com/go-ole/go-ole"
+)
+
+const (
+	// EncryptionFlagDataOnly is the Encrypt flag encrypting only the space used by the data.
+	EncryptionFlagDataOnly = 0x00000001
+
+	// LockStatusLocked is the GetLockStatus status of a locked volume.
+	LockStatusLocked = 1
+)
+
+var (
+	// EncryptableVolumeSelectorList only selects the key of Win32_EncryptableVolume, its state is
+	// reported by its methods.
+	EncryptableVolumeSelectorList = []string{"DeviceID"}
+
+	// encryptableVolumeErrors describes the return values of the Win32_EncryptableVolume methods
+	// reporting the state of the volume or a wrong secret.
+	encryptableVolumeErrors = map[uint32]string{
+		0x80310000: "the volume is locked",
+		0x80310001: "the volume is not encrypted",
+		0x80310008: "BitLocker is not enabled on the volume",
+		0x80310027: "the secret doesn't match any key protector of the volume",
+		0x80310030: "the recovery password has an invalid format",
+	}
+)
+
+// newEncryptableVolumeError returns the error of a Win32_EncryptableVolume method's return value.
+func newEncryptableVolumeError(volume *COMDispatchObject, method string, result uint32) *WMIError {
+	wmiErr := NewWMIError(Win32EncryptableVolumeClass, method, volume.Dispatch(), result)
+	wmiErr.Details = encryptableVolumeErrors[result]
+	return wmiErr
+}
+
+// QueryEncryptableVolumeByDeviceID retrieves the BitLocker state of a volume by its device ID, e.g. \\?\Volume{...}\.
+//
+// The equivalent WMI query is:
+//
+//	SELECT [selectors] FROM Win32_EncryptableVolume WHERE DeviceID = '<volumeID>'
+//
+// Refer to https://learn.microsoft.com/en-us/windows/win32/secprov/win32-encryptablevolume
+// for the WMI class definition.
+func QueryEncryptableVolumeByDeviceID(scope *Scope, volumeID string, selectorList []string) (*COMDispatchObject, error) {
+	q := NewQuery(Win32EncryptableVolumeClass).
+		WithNamespace(WMINamespaceVolumeEncryption).
+		Select(selectorList...).
+		WithCondition("DeviceID", "=", volumeID)
+
+	volume, err := QueryFirstObjectWithBuilder(scope, q)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query encryptable volume %s: %w", volumeID, err)
+	}
+
+	return volume, nil
+}
+
+// GetConversionStatus returns the encryption status of a volume, 0 (Fully decrypted), 1 (Fully encrypted),
+// 2 (Encryption in progress), 3 (Decryption in progress), 4 (Encryption paused) or 5 (Decryption paused),
+// and the percentage of the volume which is encrypted.
+//
+// Refer to https://learn.microsoft.com/en-us/windows/win32/secprov/getconversionstatus-win32-encryptablevolume
+// for the WMI method definition.
+func GetConversionStatus(volume *COMDispatchObject) (conversionStatus, encryptionPercentage uint32, err error) {
+	var encryptionFlags, wipingStatus, wipingPercentage uint32
+	result, err := volume.CallUint32("GetConversionStatus", &conversionStatus, &encryptionPercentage, &encryptionFlags, &wipingStatus, &wipingPercentage, uint32(0))
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get conversion status of volume %v. error: %w", volume, err)
+	}
+	if result != 0 {
+		return 0, 0, newEncryptableVolumeError(volume, "GetConversionStatus", result)
+	}
+	return conversionStatus, encryptionPercentage, nil
+}
+
+// GetProtectionStatus returns whether the encryption key of a volume is protected, 0 (Unprotected),
+// 1 (Protected) or 2 (Unknown).
+//
+// Refer to https://learn.microsoft.com/en-us/windows/win32/secprov/getprotectionstatus-win32-encryptablevolume
+// for the WMI method definition.
+func GetProtectionStatus(volume *COMDispatchObject) (uint32, error) {
+	var protectionStatus uint32
+	result, err := volume.CallUint32("GetProtectionStatus", &protectionStatus)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get protection status of volume %v. error: %w", volume, err)
+	}
+	if result != 0 {
+		return 0, newEncryptableVolumeError(volume, "GetProtectionStatus", result)
+	}
+	return protectionStatus, nil
+}
+
+// IsVolumeLocked returns true if a volume is locked, i.e. its content is inaccessible.
+//
+// Refer to https://learn.microsoft.com/en-us/windows/win32/secprov/getlockstatus-win32-encryptablevolume
+// for the WMI method definition.
+func IsVolumeLocked(volume *COMDispatchObject) (bool, error) {
+	var lockStatus uint32
+	result, err := volume.CallUint32("GetLockStatus", &lockStatus)
+	if err != nil {
+		return false, fmt.Errorf("failed to get lock status of volume %v. error: %w", volume, err)
+	}
+	if result != 0 {
+		return false, newEncryptableVolumeError(volume, "GetLockStatus", result)
+	}
+	return lockStatus == LockStatusLocked, nil
+}
+
+// GetEncryptionMethod returns the encryption method of a volume, e.g. 0 (Not encrypted) or 6 (XTS-AES 128).
+//
+// Refer to https://learn.microsoft.com/en-us/windows/win32/secprov/getencryptionmethod-win32-encryptablevolume
+// for the WMI method definition.
+func GetEncryptionMethod(volume *COMDispatchObject) (uint32, error) {
+	var encryptionMethod uint32
+	var selfEncryptionDriveEncryptionMethod ole.VARIANT
+	defer selfEncryptionDriveEncryptionMethod.Clear()
+	result, err := volume.CallUint32("GetEncryptionMethod", &encryptionMethod, &selfEncryptionDriveEncryptionMethod)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get encryption method of volume %v. error: %w", volume, err)
+	}
+	if result != 0 {
+		return 0, newEncryptableVolumeError(volume, "GetEncryptionMethod", result)
+	}
+	return encryptionMethod, nil
+}
+
+// ProtectKeyWithNumericalPassword adds a 48-digit recovery password key protector to a volume,
+// and returns its ID.
+//
+// Refer to https://learn.microsoft.com/en-us/windows/win32/secprov/protectkeywithnumericalpassword-win32-encryptablevolume
+// for the WMI method definition.
+func ProtectKeyWithNumericalPassword(volume *COMDispatchObject, numericalPassword string) (string, error) {
+	return protectKey(volume, "ProtectKeyWithNumericalPassword", numericalPassword)
+}
+
+// ProtectKeyWithPassphrase adds a password key protector to a volume, and returns its ID.
+//
+// Refer to https://learn.microsoft.com/en-us/windows/win32/secprov/protectkeywithpassphrase-win32-encryptablevolume
+// for the WMI method definition.
+func ProtectKeyWithPassphrase(volume *COMDispatchObject, passphrase string) (string, error) {
+	return protectKey(volume, "ProtectKeyWithPassphrase", passphrase)
+}
+
+// protectKey calls a ProtectKeyWith* method taking a friendly name and a secret, never part of
+// the returned errors.
+func protectKey(volume *COMDispatchObject, method, secret string) (string, error) {
+	var keyProtectorID ole.VARIANT
+	defer keyProtectorID.Clear()
+	result, err := volume.CallUint32(method, "", secret, &keyProtectorID)
+	if err != nil {
+		return "", fmt.Errorf("failed to add key protector to volume %v. error: %w", volume, err)
+	}
+	if result != 0 {
+		return "", newEncryptableVolumeError(volume, method, result)
+	}
+	return NewSafeVariant(&keyProtectorID).String(), nil
+}
+
+// EncryptVolume starts encrypting a volume with an encryption method, e.g. 6 (XTS-AES 128), and flags,
+// e.g. EncryptionFlagDataOnly. The encryption goes on in the background.
+//
+// Refer to https://learn.microsoft.com/en-us/windows/win32/secprov/encrypt-win32-encryptablevolume
+// for the WMI method definition.
+func EncryptVolume(volume *COMDispatchObject, encryptionMethod, encryptionFlags uint32) error {
+	result, err := volume.CallUint32("Encrypt", encryptionMethod, encryptionFlags)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt volume %v. error: %w", volume, err)
+	}
+	if result != 0 {
+		return newEncryptableVolumeError(volume, "Encrypt", result)
+	}
+	return nil
+}
+
+// UnlockWithNumericalPassword unlocks a volume with its 48-digit recovery password.
+//
+// Refer to https://learn.microsoft.com/en-us/windows/win32/secprov/unlockwithnumericalpassword-win32-encryptablevolume
+// for the WMI method definition.
+func UnlockWithNumericalPassword(volume *COMDispatchObject, numericalPassword string) error {
+	return unlock(volume, "UnlockWithNumericalPassword", numericalPassword)
+}
+
+// UnlockWithPassphrase unlocks a volume with its password.
+//
+// Refer to https://learn.microsoft.com/en-us/windows/win32/secprov/unlockwithpassphrase-win32-encryptablevolume
+// for the WMI method definition.
+func UnlockWithPassphrase(volume *COMDispatchObject, passphrase string) error {
+	return unlock(volume, "UnlockWithPassphrase", passphrase)
+}
+
+// unlock calls an UnlockWith* method taking a secret, never part of the returned errors.
+func unlock(volume *COMDispatchObject, method, secret string) error {
+	result, err := volume.CallUint32(method, secret)
+	if err != nil {
+		return fmt.Errorf("failed to unlock volume %v. error: %w", volume, err)
+	}
+	if result != 0 {
+		return newEncryptableVolumeError(volume, method, result)
+	}
+	return nil
+}
+
+// LockVolume locks a volume, dismounting it even if it is in use with forceDismount.
+//
+// Refer to https://learn.microsoft.com/en-us/windows/win32/secprov/lock-win32-encryptablevolume
+// for the WMI method definition.
+func LockVolume(volume *COMDispatchObject, forceDismount bool) error {
+	result, err := volume.CallUint32("Lock", forceDismount)
+	if err != nil {
+		return fmt.Errorf("failed to lock volume %v. error: %w", volume, err)
+	}
+	if result != 0 {
+		return newEncryptableVolumeError(volume, "Lock", result)
+	}
+	return nil
+}
+
+// DecryptVolume starts decrypting a volume. The decryption goes on in the background.
+//
+// Refer to https://learn.microsoft.com/en-us/windows/win32/secprov/decrypt-win32-encryptablevolume
+// for the WMI method definition.
+func DecryptVolume(volume *COMDispatchObject) error {
+	result, err := volume.CallUint32("Decrypt")
+	if err != nil {
+		return fmt.Errorf("failed to decrypt volume %v. error: %w", volume, err)
+	}
+	if result != 0 {
+		return newEncryptableVolumeError(volume, "Decrypt", result)
+	}
+	return nil
+}
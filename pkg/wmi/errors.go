@@ -0,0 +1,74 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wmi
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-ole/go-ole"
+)
+
+var (
+	ErrNotFound      = errors.New("not found")
+	ErrStopIteration = errors.New("stop iteration")
+)
+
+// IsNotFound returns true if it's a "not found" error.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IgnoreNotFound returns nil if the error is nil or a "not found" error,
+// otherwise returns the original error.
+func IgnoreNotFound(err error) error {
+	if err == nil || IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// WMIError is an error for a WMI operation.
+type WMIError struct {
+	Method  string
+	Class   string
+	Target  string
+	Code    uint32
+	Details string
+}
+
+// NewWMIError creates a new WMIError.
+func NewWMIError(class, method string, target *ole.IDispatch, code uint32) *WMIError {
+	return &WMIError{
+		Class:  class,
+		Method: method,
+		Target: fmt.Sprintf("%v", target),
+		Code:   code,
+	}
+}
+
+// ErrorCode returns the return value of the WMI method.
+func (e *WMIError) ErrorCode() uint32 {
+	return e.Code
+}
+
+func (e *WMIError) Error() string {
+	if e.Details != "" {
+		return fmt.Sprintf("WMI %s.%s failed (target=%v): %s (code=%d)", e.Class, e.Method, e.Target, e.Details, e.Code)
+	}
+	return fmt.Sprintf("WMI %s.%s failed (target=%v): code=%d", e.Class, e.Method, e.Target, e.Code)
+}
@@ -0,0 +1,179 @@
+//go:build windows
+// +build windows
+
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wmi
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-ole/go-ole"
+)
+
+const (
+	// ShadowCopyContextClientAccessible is the context of the persistent shadow copies
+	// created without a requester, the only context Win32_ShadowCopy.Create supports.
+	ShadowCopyContextClientAccessible = "ClientAccessible"
+
+	// cimDatetimeLayout is the layout of a CIM_DATETIME, without its UTC offset in minutes, e.g. +060.
+	cimDatetimeLayout = "20060102150405.000000"
+)
+
+var (
+	ShadowCopySelectorList = []string{"ID", "VolumeName", "DeviceObject", "InstallDate"}
+
+	// shadowCopyCreateErrors describes the return values of Win32_ShadowCopy.Create.
+	shadowCopyCreateErrors = map[uint32]string{
+		1:  "access denied",
+		2:  "invalid argument",
+		3:  "the volume was not found",
+		4:  "the volume is not supported",
+		5:  "the shadow copy context is not supported",
+		6:  "insufficient storage",
+		7:  "the volume is in use",
+		8:  "the maximum number of shadow copies was reached",
+		9:  "another shadow copy operation is already in progress",
+		10: "the shadow copy provider vetoed the operation",
+		11: "the shadow copy provider is not registered",
+		12: "the shadow copy provider failed",
+	}
+)
+
+// CreateShadowCopy creates a persistent shadow copy of a volume, e.g. \\?\Volume{...}\, and returns its ID.
+//
+// Refer to https://learn.microsoft.com/en-us/previous-versions/windows/desktop/vsswmi/create-method-in-class-win32-shadowcopy
+// for the WMI method definition.
+func CreateShadowCopy(volume string) (string, error) {
+	var shadowID string
+	params := map[string]interface{}{
+		"Volume":  volume,
+		"Context": ShadowCopyContextClientAccessible,
+	}
+	result, _, err := CallMethodOnWMIClass(WMINamespaceCimV2, Win32ShadowCopyClass, "Create", params, func(name string, value *ole.VARIANT) (interface{}, error) {
+		if name == "ShadowID" {
+			shadowID = NewSafeVariant(value).String()
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create shadow copy of volume %s: %w", volume, err)
+	}
+	if result != 0 {
+		wmiErr := NewWMIError(Win32ShadowCopyClass, "Create", nil, result)
+		wmiErr.Details = shadowCopyCreateErrors[result]
+		return "", wmiErr
+	}
+	return shadowID, nil
+}
+
+// QueryShadowCopies retrieves the shadow copies of a volume, or of all the volumes if volume is empty.
+//
+// The equivalent WMI query is:
+//
+//	SELECT [selectors] FROM Win32_ShadowCopy [WHERE VolumeName = '<volume>']
+//
+// Refer to https://learn.microsoft.com/en-us/previous-versions/windows/desktop/vsswmi/win32-shadowcopy
+// for the WMI class definition.
+func QueryShadowCopies(scope *Scope, volume string, selectorList []string) ([]*COMDispatchObject, error) {
+	q := NewQuery(Win32ShadowCopyClass).Select(selectorList...)
+	if volume != "" {
+		q = q.WithCondition("VolumeName", "=", volume)
+	}
+
+	shadowCopies, err := QueryObjectsWithBuilder(scope, q)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query shadow copies: %w", err)
+	}
+
+	return shadowCopies, nil
+}
+
+// QueryShadowCopyByID retrieves a specific shadow copy by its ID.
+//
+// The equivalent WMI query is:
+//
+//	SELECT [selectors] FROM Win32_ShadowCopy WHERE ID = '<id>'
+//
+// Refer to https://learn.microsoft.com/en-us/previous-versions/windows/desktop/vsswmi/win32-shadowcopy
+// for the WMI class definition.
+func QueryShadowCopyByID(scope *Scope, id string, selectorList []string) (*COMDispatchObject, error) {
+	q := NewQuery(Win32ShadowCopyClass).
+		Select(selectorList...).
+		WithCondition("ID", "=", id)
+
+	shadowCopy, err := QueryFirstObjectWithBuilder(scope, q)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query shadow copy %s: %w", id, err)
+	}
+
+	return shadowCopy, nil
+}
+
+// DeleteShadowCopy deletes a shadow copy, which has no method of its own for it.
+//
+// Refer to https://learn.microsoft.com/en-us/windows/win32/wmisdk/swbemobject-delete-
+// for the WMI method definition.
+func DeleteShadowCopy(shadowCopy *COMDispatchObject) error {
+	if err := shadowCopy.CallVoid("Delete_"); err != nil {
+		return fmt.Errorf("failed to delete shadow copy: %w", err)
+	}
+	return nil
+}
+
+// GetShadowCopyID returns the ID of a shadow copy, e.g. {1fb1a4b6-7b1a-4b27-9a0a-cb5a9d8f1f5e}.
+func GetShadowCopyID(shadowCopy *COMDispatchObject) (string, error) {
+	return shadowCopy.GetStringProperty("ID")
+}
+
+// GetShadowCopyVolumeName returns the name of the volume of a shadow copy, e.g. \\?\Volume{...}\.
+func GetShadowCopyVolumeName(shadowCopy *COMDispatchObject) (string, error) {
+	return shadowCopy.GetStringProperty("VolumeName")
+}
+
+// GetShadowCopyDeviceObject returns the device object of a shadow copy,
+// e.g. \\?\GLOBALROOT\Device\HarddiskVolumeShadowCopy1.
+func GetShadowCopyDeviceObject(shadowCopy *COMDispatchObject) (string, error) {
+	return shadowCopy.GetStringProperty("DeviceObject")
+}
+
+// GetShadowCopyInstallDate returns the creation time of a shadow copy.
+func GetShadowCopyInstallDate(shadowCopy *COMDispatchObject) (time.Time, error) {
+	installDate, err := shadowCopy.GetStringProperty("InstallDate")
+	if err != nil {
+		return time.Time{}, err
+	}
+	return ParseCIMDatetime(installDate)
+}
+
+// ParseCIMDatetime parses a CIM_DATETIME, e.g. 20250102150405.000000+060.
+//
+// Refer to https://learn.microsoft.com/en-us/windows/win32/wmisdk/cim-datetime
+// for the format definition.
+func ParseCIMDatetime(value string) (time.Time, error) {
+	if len(value) != len(cimDatetimeLayout)+4 {
+		return time.Time{}, fmt.Errorf("invalid CIM datetime %q", value)
+	}
+	offset, err := strconv.Atoi(value[len(cimDatetimeLayout):])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid UTC offset in CIM datetime %q: %w", value, err)
+	}
+	location := time.FixedZone("", offset*60)
+	return time.ParseInLocation(cimDatetimeLayout, value[:len(cimDatetimeLayout)], location)
+}
@@ -0,0 +1,66 @@
+//go:build windows
+// +build windows
+
+package wmi
+
+import (
+	"context"
+	"runtime"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sys/windows"
+)
+
+const tracerName = "github.com/kubernetes-csi/csi-proxy/pkg/wmi"
+
+// traceContexts holds the context of the traced operation running on each OS thread, by thread ID.
+// The host APIs don't take a context, the WMI calls find their parent span through the OS thread
+// they run on instead, which stays locked by SetTraceContext and WithCOMThread for the whole operation.
+var traceContexts sync.Map
+
+// SetTraceContext locks the calling goroutine to its OS thread, and makes the span in ctx
+// the parent of the spans of the WMI queries and method calls made on that thread
+// until the returned function is called.
+func SetTraceContext(ctx context.Context) func() {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return func() {}
+	}
+
+	runtime.LockOSThread()
+	threadID := windows.GetCurrentThreadId()
+	previous, hadPrevious := traceContexts.Load(threadID)
+	traceContexts.Store(threadID, ctx)
+
+	return func() {
+		if hadPrevious {
+			traceContexts.Store(threadID, previous)
+		} else {
+			traceContexts.Delete(threadID)
+		}
+		runtime.UnlockOSThread()
+	}
+}
+
+// startSpan starts a span for a WMI call if it's made after SetTraceContext,
+// otherwise it returns a span which isn't recorded.
+func startSpan(name string, attributes ...attribute.KeyValue) trace.Span {
+	ctx, ok := traceContexts.Load(windows.GetCurrentThreadId())
+	if !ok {
+		return trace.SpanFromContext(context.Background())
+	}
+	_, span := otel.Tracer(tracerName).Start(ctx.(context.Context), name, trace.WithAttributes(attributes...))
+	return span
+}
+
+// endSpan ends span, recording err if it isn't nil.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -30,16 +30,25 @@ import (
 const (
 	MSFTVolumeClass    = "MSFT_Volume"
 	MSFTPartitionClass = "MSFT_Partition"
+	Win32VolumeClass   = "Win32_Volume"
 
 	FileSystemUnknown = 0
 )
 
 var (
 	VolumeSelectorListForFileSystemType = []string{"FileSystemType"}
-	VolumeSelectorListForStats          = []string{"UniqueId", "SizeRemaining", "Size"}
+	VolumeSelectorListForFileSystem     = []string{"FileSystemType", "FileSystem"}
+	VolumeSelectorListForStats          = []string{"UniqueId", "SizeRemaining", "Size", "HealthStatus", "OperationalStatus"}
+	VolumeSelectorListForHealth         = []string{"UniqueId", "HealthStatus", "OperationalStatus"}
+	Win32VolumeSelectorListForDirtyBit  = []string{"DeviceID", "DirtyBitSet"}
 	VolumeSelectorListUniqueID          = []string{"UniqueId"}
 
 	PartitionSelectorListObjectID = []string{"ObjectId"}
+	PartitionSelectorListNumber   = []string{"PartitionNumber"}
+	PartitionSelectorListForInfo  = []string{
+		"DiskNumber", "PartitionNumber", "Offset", "Size", "MbrType", "GptType", "Guid", "DriveLetter",
+		"IsBoot", "IsSystem", "IsHidden", "IsReadOnly", "IsOffline",
+	}
 )
 
 // QueryVolumeByUniqueID retrieves a specific volume by its unique identifier.
@@ -121,6 +130,11 @@ func GetVolumeFileSystemType(volume *COMDispatchObject) (uint16, error) {
 	return volume.GetUint16Property("FileSystemType")
 }
 
+// GetVolumeFileSystem returns the name of the file system of a volume, e.g. NTFS.
+func GetVolumeFileSystem(volume *COMDispatchObject) (string, error) {
+	return volume.GetStringProperty("FileSystem")
+}
+
 // GetVolumeSize returns the size of a volume.
 func GetVolumeSize(volume *COMDispatchObject) (uint64, error) {
 	return volume.GetStringPropertyAsUint64("Size")
@@ -131,6 +145,62 @@ func GetVolumeSizeRemaining(volume *COMDispatchObject) (uint64, error) {
 	return volume.GetStringPropertyAsUint64("SizeRemaining")
 }
 
+// GetVolumeHealthStatus returns the health status of a volume, 0 (Healthy), 1 (Warning), 2 (Unhealthy) or 5 (Unknown).
+func GetVolumeHealthStatus(volume *COMDispatchObject) (uint16, error) {
+	return volume.GetUint16Property("HealthStatus")
+}
+
+// GetVolumeOperationalStatus returns the operational statuses of a volume, e.g. 2 (OK) or 0xD00D (Scan Needed).
+func GetVolumeOperationalStatus(volume *COMDispatchObject) ([]uint16, error) {
+	return volume.GetUint16ArrayProperty("OperationalStatus")
+}
+
+// RepairVolume scans the file system of a volume for errors with scan, and fixes them with spotFix, briefly
+// taking the volume offline, or offlineScanAndFix, taking it offline for the scan. It returns the output of
+// the repair, 0 (No errors found), 1 (Errors fixed), 2 (Spot fix needed) or 3 (Full repair needed).
+//
+// Refer to https://learn.microsoft.com/en-us/windows-hardware/drivers/storage/msft-volume-repair
+// for the WMI method definition.
+func RepairVolume(volume *COMDispatchObject, offlineScanAndFix, scan, spotFix bool) (uint32, error) {
+	var output uint32
+	result, err := volume.CallUint32("Repair", offlineScanAndFix, scan, spotFix, &output)
+	if err != nil {
+		return 0, fmt.Errorf("failed to repair volume %v. error: %w", volume, err)
+	}
+	if result != 0 {
+		return 0, NewWMIError(MSFTVolumeClass, "Repair", volume.Dispatch(), result)
+	}
+	return output, nil
+}
+
+// QueryWin32VolumeByDeviceID retrieves a specific volume by its device ID, e.g. \\?\Volume{...}\, from the
+// Win32_Volume class, which reports properties MSFT_Volume doesn't, e.g. the dirty bit.
+//
+// The equivalent WMI query is:
+//
+//	SELECT [selectors] FROM Win32_Volume WHERE DeviceID = "<volumeID>"
+//
+// Refer to https://learn.microsoft.com/en-us/previous-versions/windows/desktop/legacy/aa394515(v=vs.85)
+// for the WMI class definition.
+func QueryWin32VolumeByDeviceID(scope *Scope, volumeID string, selectorList []string) (*COMDispatchObject, error) {
+	q := NewQuery(Win32VolumeClass).
+		Select(selectorList...).
+		WithCondition("DeviceID", "=", volumeID)
+
+	result, err := QueryFirstObjectWithBuilder(scope, q)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query volume %s: %w", volumeID, err)
+	}
+
+	return result, nil
+}
+
+// IsVolumeDirty returns true if the dirty bit of a Win32_Volume is set, i.e. its file system
+// must be checked, e.g. after the host crashed while it was mounted.
+func IsVolumeDirty(volume *COMDispatchObject) (bool, error) {
+	return volume.GetBoolProperty("DirtyBitSet")
+}
+
 // ListPartitionsOnDisk retrieves all partitions or a partition with the specified number on a disk.
 //
 // The equivalent WMI query is:
@@ -357,6 +427,77 @@ func GetPartitionSize(part *COMDispatchObject) (uint64, error) {
 	return part.GetStringPropertyAsUint64("Size")
 }
 
+// DeletePartition deletes a partition and the data on it.
+//
+// Refer to https://learn.microsoft.com/en-us/windows-hardware/drivers/storage/msft-partition-deletepartition
+// for the WMI method definition.
+func DeletePartition(part *COMDispatchObject) (string, error) {
+	var status string
+	result, err := part.CallUint32("DeletePartition", &status)
+	if err != nil {
+		return "", fmt.Errorf("failed to delete partition %v. error: %w", part, err)
+	}
+	if result != 0 {
+		return status, NewWMIError(MSFTPartitionClass, "DeletePartition", part.Dispatch(), result)
+	}
+	return status, nil
+}
+
+// GetPartitionNumber returns the number of a partition on its disk.
+func GetPartitionNumber(part *COMDispatchObject) (uint32, error) {
+	return part.GetUint32Property("PartitionNumber")
+}
+
+// GetPartitionOffset returns the offset of a partition from the beginning of its disk in bytes.
+func GetPartitionOffset(part *COMDispatchObject) (uint64, error) {
+	return part.GetStringPropertyAsUint64("Offset")
+}
+
+// GetPartitionMbrType returns the MBR partition type of a partition, 0 on GPT disks.
+func GetPartitionMbrType(part *COMDispatchObject) (uint16, error) {
+	return part.GetUint16Property("MbrType")
+}
+
+// GetPartitionGptType returns the GPT partition type GUID of a partition, empty on MBR disks.
+func GetPartitionGptType(part *COMDispatchObject) (string, error) {
+	return part.GetStringProperty("GptType")
+}
+
+// GetPartitionGUID returns the unique GUID of a partition, empty on MBR disks.
+func GetPartitionGUID(part *COMDispatchObject) (string, error) {
+	return part.GetStringProperty("Guid")
+}
+
+// GetPartitionDriveLetter returns the drive letter of a partition, 0 if it has none.
+func GetPartitionDriveLetter(part *COMDispatchObject) (uint16, error) {
+	return part.GetUint16Property("DriveLetter")
+}
+
+// IsPartitionBoot returns true if the partition is the boot partition.
+func IsPartitionBoot(part *COMDispatchObject) (bool, error) {
+	return part.GetBoolProperty("IsBoot")
+}
+
+// IsPartitionSystem returns true if the partition is the system partition.
+func IsPartitionSystem(part *COMDispatchObject) (bool, error) {
+	return part.GetBoolProperty("IsSystem")
+}
+
+// IsPartitionHidden returns true if the partition is hidden.
+func IsPartitionHidden(part *COMDispatchObject) (bool, error) {
+	return part.GetBoolProperty("IsHidden")
+}
+
+// IsPartitionReadOnly returns true if the partition is read only.
+func IsPartitionReadOnly(part *COMDispatchObject) (bool, error) {
+	return part.GetBoolProperty("IsReadOnly")
+}
+
+// IsPartitionOffline returns true if the partition is offline.
+func IsPartitionOffline(part *COMDispatchObject) (bool, error) {
+	return part.GetBoolProperty("IsOffline")
+}
+
 // FilterForPartitionOnDisk creates a WMI query filter to query a disk by its number.
 func FilterForPartitionOnDisk(diskNumber uint32) Condition {
 	return WithCondition("DiskNumber", "=", diskNumber)
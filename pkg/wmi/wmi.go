@@ -28,9 +28,11 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 
 	"github.com/go-ole/go-ole"
 	"github.com/go-ole/go-ole/oleutil"
+	"go.opentelemetry.io/otel/attribute"
 	"golang.org/x/sys/windows"
 	"k8s.io/klog/v2"
 )
@@ -40,6 +42,10 @@ const (
 	WMINamespaceStorage = "Root\\Microsoft\\Windows\\Storage"
 	WMINamespaceSmb     = "Root\\Microsoft\\Windows\\Smb"
 
+	// WMINamespaceVolumeEncryption is the namespace of the BitLocker classes, which requires
+	// administrator privileges and packet privacy.
+	WMINamespaceVolumeEncryption = "Root\\CIMV2\\Security\\MicrosoftVolumeEncryption"
+
 	WBEM_S_FALSE = 0x00000001
 
 	// COM security defaults used by the previous WMI implementation.
@@ -55,9 +61,18 @@ const (
 var initCOMSecurity sync.Once
 
 var (
-	ErrNotFound      = errors.New("not found")
-	ErrStopIteration = errors.New("stop iteration")
+	// comThreadsInUse and comThreadsTotal count the calls to WithCOMThread, see COMThreadStats.
+	comThreadsInUse atomic.Int64
+	comThreadsTotal atomic.Uint64
+)
+
+// COMThreadStats returns the number of OS threads currently locked by WithCOMThread,
+// and the total number of WithCOMThread calls.
+func COMThreadStats() (inUse int64, total uint64) {
+	return comThreadsInUse.Load(), comThreadsTotal.Load()
+}
 
+var (
 	DiscardOutputParameter = func(_ string, _ *ole.VARIANT) (interface{}, error) {
 		return nil, nil
 	}
@@ -66,20 +81,6 @@ var (
 	}
 )
 
-// IsNotFound returns true if it's a "not found" error.
-func IsNotFound(err error) bool {
-	return errors.Is(err, ErrNotFound)
-}
-
-// IgnoreNotFound returns nil if the error is nil or a "not found" error,
-// otherwise returns the original error.
-func IgnoreNotFound(err error) error {
-	if err == nil || IsNotFound(err) {
-		return nil
-	}
-	return err
-}
-
 func IsEndOfEnum(err error) bool {
 	var oleErr *ole.OleError
 	return errors.As(err, &oleErr) && oleErr.Code() == WBEM_S_FALSE
@@ -258,6 +259,10 @@ func WithCOMThread(fn func() error) error {
 	runtime.LockOSThread()
 	defer runtime.UnlockOSThread()
 
+	comThreadsInUse.Add(1)
+	defer comThreadsInUse.Add(-1)
+	comThreadsTotal.Add(1)
+
 	if err := ole.CoInitializeEx(0, ole.COINIT_MULTITHREADED); err != nil {
 		var oleError *ole.OleError
 		if errors.As(err, &oleError) && oleError != nil && oleError.Code() == uintptr(windows.S_FALSE) {
@@ -309,8 +314,11 @@ func WithWMIService(namespace string, fn func(*ole.IDispatch) error) error {
 // Callers must ensure COM is initialized on the current OS thread (e.g., via WithCOMThread).
 // The callback fn receives an *ole.IDispatch that is only valid for the duration
 // of the callback; callers must NOT store or use it after fn returns.
-func Query(namespace, query string, fn func(item *ole.IDispatch) error) error {
-	err := WithWMIService(namespace, func(service *ole.IDispatch) error {
+func Query(namespace, query string, fn func(item *ole.IDispatch) error) (err error) {
+	span := startSpan("wmi.Query", attribute.String("wmi.namespace", namespace), attribute.String("wmi.query", query))
+	defer func() { endSpan(span, err) }()
+
+	err = WithWMIService(namespace, func(service *ole.IDispatch) error {
 		resultRaw, err := oleutil.CallMethod(service, "ExecQuery", query)
 		if err != nil {
 			klog.V(4).Infof("ExecQuery: (namespace: %s, query: %s), error: %v", namespace, query, err)
@@ -339,6 +347,21 @@ func Query(namespace, query string, fn func(item *ole.IDispatch) error) error {
 	return err
 }
 
+// CheckClass returns an error if the definition of a WMI class can't be retrieved, e.g. because
+// the WMI service or the class' provider is unavailable. Unlike a query it enumerates no instances,
+// which makes it cheap enough to run periodically as a health check.
+func CheckClass(namespace, class string) error {
+	return WithCOMThread(func() error {
+		return WithWMIService(namespace, func(service *ole.IDispatch) error {
+			classObject, err := oleutil.CallMethod(service, "Get", class)
+			if err != nil {
+				return fmt.Errorf("failed to get WMI class %s in namespace %s: %w", class, namespace, err)
+			}
+			return classObject.Clear()
+		})
+	})
+}
+
 func QueryWithBuilder(builder *QueryBuilder, fn func(item *ole.IDispatch) error) error {
 	return Query(builder.Namespace, builder.Build(), fn)
 }
@@ -415,10 +438,14 @@ func Enumerate(obj *ole.IDispatch, fn func(item *ole.VARIANT) error) error {
 }
 
 // CallMethodOnWMIClass calls a method on a WMI class and returns the return value.
-func CallMethodOnWMIClass(namespace, class, methodName string, input map[string]interface{}, handler func(string, *ole.VARIANT) (interface{}, error)) (uint32, map[string]interface{}, error) {
-	var returnValue uint32
-	var output map[string]interface{}
-	err := WithWMIService(namespace, func(service *ole.IDispatch) error {
+func CallMethodOnWMIClass(namespace, class, methodName string, input map[string]interface{}, handler func(string, *ole.VARIANT) (interface{}, error)) (returnValue uint32, output map[string]interface{}, err error) {
+	span := startSpan("wmi.CallMethod", attribute.String("wmi.namespace", namespace), attribute.String("wmi.class", class), attribute.String("wmi.method", methodName))
+	defer func() {
+		span.SetAttributes(attribute.Int64("wmi.return_value", int64(returnValue)))
+		endSpan(span, err)
+	}()
+
+	err = WithWMIService(namespace, func(service *ole.IDispatch) error {
 		classRaw, err := oleutil.CallMethod(service, "Get", class)
 		if err != nil {
 			return fmt.Errorf("get class %s failed: %w", class, err)
@@ -590,6 +617,36 @@ func (c *COMDispatchObject) GetUint16Property(name string) (uint16, error) {
 	return result, nil
 }
 
+// GetUint16ArrayProperty returns an array property of uint16 values, which WMI returns as an array
+// of VT_I4 values, or nil if the property is null.
+func (c *COMDispatchObject) GetUint16ArrayProperty(name string) ([]uint16, error) {
+	var result []uint16
+	err := c.GetPropertyWithHandler(name, func(v *ole.VARIANT) error {
+		if v.VT&ole.VT_ARRAY == 0 {
+			return nil
+		}
+		for _, value := range v.ToArray().ToValueArray() {
+			switch x := value.(type) {
+			case int32:
+				result = append(result, uint16(x))
+			case uint16:
+				result = append(result, x)
+			case int16:
+				result = append(result, uint16(x))
+			case uint32:
+				result = append(result, uint16(x))
+			default:
+				return fmt.Errorf("unexpected type %T of an element of property %s", value, name)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *COMDispatchObject) GetBoolProperty(name string) (bool, error) {
 	var result bool
 	err := c.GetPropertyWithHandler(name, func(v *ole.VARIANT) error {
@@ -617,7 +674,10 @@ func (c *COMDispatchObject) GetStringPropertyAsUint64(name string) (uint64, erro
 	return result, nil
 }
 
-func (c *COMDispatchObject) CallMethod(name string, fn func(*ole.VARIANT) error, params ...interface{}) error {
+func (c *COMDispatchObject) CallMethod(name string, fn func(*ole.VARIANT) error, params ...interface{}) (err error) {
+	span := startSpan("wmi.CallMethod", attribute.String("wmi.method", name))
+	defer func() { endSpan(span, err) }()
+
 	v, err := oleutil.CallMethod(c.Dispatch(), name, params...)
 	if err != nil {
 		return err
@@ -875,29 +935,3 @@ func WithScope(fn func(*Scope) error) error {
 
 	return fn(scope)
 }
-
-// WMIError is an error for a WMI operation.
-type WMIError struct {
-	Method  string
-	Class   string
-	Target  string
-	Code    uint32
-	Details string
-}
-
-// NewWMIError creates a new WMIError.
-func NewWMIError(class, method string, target *ole.IDispatch, code uint32) *WMIError {
-	return &WMIError{
-		Class:  class,
-		Method: method,
-		Target: fmt.Sprintf("%v", target),
-		Code:   code,
-	}
-}
-
-func (e *WMIError) Error() string {
-	if e.Details != "" {
-		return fmt.Sprintf("WMI %s.%s failed (target=%v): %s (code=%d)", e.Class, e.Method, e.Target, e.Details, e.Code)
-	}
-	return fmt.Sprintf("WMI %s.%s failed (target=%v): code=%d", e.Class, e.Method, e.Target, e.Code)
-}
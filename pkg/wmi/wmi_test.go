@@ -21,6 +21,7 @@ package wmi
 
 import (
 	"testing"
+	"time"
 )
 
 func TestComSecurityEnabled(t *testing.T) {
@@ -125,3 +126,35 @@ func TestQueryBuilderBuild(t *testing.T) {
 		})
 	}
 }
+
+func TestParseCIMDatetime(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected time.Time
+		wantErr  bool
+	}{
+		{value: "20250102150405.000000+000", expected: time.Date(2025, 1, 2, 15, 4, 5, 0, time.UTC)},
+		{value: "20250102150405.500000+060", expected: time.Date(2025, 1, 2, 14, 4, 5, 500000000, time.UTC)},
+		{value: "20250102150405.000000-300", expected: time.Date(2025, 1, 2, 20, 4, 5, 0, time.UTC)},
+		{value: "20250102150405", wantErr: true},
+		{value: "20250102150405.000000+abc", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			actual, err := ParseCIMDatetime(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Expected an error, got %v", actual)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !actual.Equal(tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, actual)
+			}
+		})
+	}
+}